@@ -13,6 +13,7 @@ import (
 	"testing"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -171,6 +172,12 @@ var (
 	SecretsManagerPrivateCertificateConfigurationCryptoKeyProviderPrivateKeystoreId string
 	SecretsManagerSecretType                                                        string
 	SecretsManagerSecretID                                                          string
+	SecretsManagerCustomCredentialsApiKeyRef                                        string
+	SecretsManagerCustomCredentialsCodeEngineProjectId                              string
+	SecretsManagerCustomCredentialsCodeEngineJobName                                string
+	SecretsManagerCustomCredentialsCodeEngineRegion                                 string
+	SecretsManagerReplicaInstanceID                                                 string
+	SecretsManagerReplicaInstanceRegion                                             string
 )
 
 var (
@@ -206,6 +213,7 @@ var (
 	Pi_auxiliary_volume_name        string
 	Pi_cloud_instance_id            string
 	Pi_dhcp_id                      string
+	Pi_dr_target_cloud_instance_id  string
 	Pi_host_group_id                string
 	Pi_host_id                      string
 	Pi_image                        string
@@ -1091,6 +1099,12 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable PI_VOLUME_GROUP_ID for testing ibm_pi_volume_group_storage_details data source else it is set to default value 'terraform-test-power'")
 	}
 
+	Pi_dr_target_cloud_instance_id = os.Getenv("PI_DR_TARGET_CLOUDINSTANCE_ID")
+	if Pi_dr_target_cloud_instance_id == "" {
+		Pi_dr_target_cloud_instance_id = "terraform-test-power"
+		fmt.Println("[INFO] Set the environment variable PI_DR_TARGET_CLOUDINSTANCE_ID for testing ibm_pi_dr_failover resource else it is set to default value 'terraform-test-power'")
+	}
+
 	Pi_volume_onboarding_id = os.Getenv("PI_VOLUME_ONBOARDING_ID")
 	if Pi_volume_onboarding_id == "" {
 		Pi_volume_onboarding_id = "terraform-test-power"
@@ -1365,6 +1379,37 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable SECRETS_MANAGER_SERVICE_CREDENTIALS_COS_CRN for testing service credentials' tests, else tests fail if not set correctly")
 	}
 
+	SecretsManagerCustomCredentialsApiKeyRef = os.Getenv("SECRETS_MANAGER_CUSTOM_CREDENTIALS_API_KEY_REF")
+	if SecretsManagerCustomCredentialsApiKeyRef == "" {
+		fmt.Println("[INFO] Set the environment variable SECRETS_MANAGER_CUSTOM_CREDENTIALS_API_KEY_REF for testing custom credentials' tests, else tests fail if not set correctly")
+	}
+
+	SecretsManagerCustomCredentialsCodeEngineProjectId = os.Getenv("SECRETS_MANAGER_CUSTOM_CREDENTIALS_CODE_ENGINE_PROJECT_ID")
+	if SecretsManagerCustomCredentialsCodeEngineProjectId == "" {
+		fmt.Println("[INFO] Set the environment variable SECRETS_MANAGER_CUSTOM_CREDENTIALS_CODE_ENGINE_PROJECT_ID for testing custom credentials' tests, else tests fail if not set correctly")
+	}
+
+	SecretsManagerCustomCredentialsCodeEngineJobName = os.Getenv("SECRETS_MANAGER_CUSTOM_CREDENTIALS_CODE_ENGINE_JOB_NAME")
+	if SecretsManagerCustomCredentialsCodeEngineJobName == "" {
+		fmt.Println("[INFO] Set the environment variable SECRETS_MANAGER_CUSTOM_CREDENTIALS_CODE_ENGINE_JOB_NAME for testing custom credentials' tests, else tests fail if not set correctly")
+	}
+
+	SecretsManagerCustomCredentialsCodeEngineRegion = os.Getenv("SECRETS_MANAGER_CUSTOM_CREDENTIALS_CODE_ENGINE_REGION")
+	if SecretsManagerCustomCredentialsCodeEngineRegion == "" {
+		SecretsManagerCustomCredentialsCodeEngineRegion = "us-south"
+		fmt.Println("[INFO] Set the environment variable SECRETS_MANAGER_CUSTOM_CREDENTIALS_CODE_ENGINE_REGION for testing custom credentials' tests, else it is set to default value 'us-south'")
+	}
+
+	SecretsManagerReplicaInstanceID = os.Getenv("SECRETS_MANAGER_REPLICA_INSTANCE_ID")
+	if SecretsManagerReplicaInstanceID == "" {
+		fmt.Println("[INFO] Set the environment variable SECRETS_MANAGER_REPLICA_INSTANCE_ID for testing secret replica's tests else tests will fail if this is not set correctly")
+	}
+
+	SecretsManagerReplicaInstanceRegion = os.Getenv("SECRETS_MANAGER_REPLICA_INSTANCE_REGION")
+	if SecretsManagerReplicaInstanceRegion == "" {
+		fmt.Println("[INFO] Set the environment variable SECRETS_MANAGER_REPLICA_INSTANCE_REGION for testing secret replica's tests else tests will fail if this is not set correctly")
+	}
+
 	SecretsManagerPrivateCertificateConfigurationCryptoKeyIAMSecretServiceId = os.Getenv("SECRETS_MANAGER_PRIVATE_CERTIFICATE_CONFIGURATION_CRYPTO_KEY_IAM_SECRET_SERVICE_ID")
 	if SecretsManagerPrivateCertificateConfigurationCryptoKeyIAMSecretServiceId == "" {
 		fmt.Println("[INFO] Set the environment variable SECRETS_MANAGER_PRIVATE_CERTIFICATE_CONFIGURATION_CRYPTO_KEY_IAM_SECRET_SERVICE_ID for testing private certificate's configuration with crypto key tests, else tests fail if not set correctly")
@@ -2167,6 +2212,20 @@ func TestAccProviderFactories() map[string]func() (*schema.Provider, error) {
 	}
 }
 
+// TestAccProtoV5ProviderFactories returns the muxed provider, for the tests of the ephemeral
+// resources served by the framework provider
+func TestAccProtoV5ProviderFactories() map[string]func() (tfprotov5.ProviderServer, error) {
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		ProviderName: func() (tfprotov5.ProviderServer, error) {
+			providerServer, err := provider.ProviderServerFactory(context.Background())
+			if err != nil {
+				return nil, err
+			}
+			return providerServer(), nil
+		},
+	}
+}
+
 func Region() string {
 	region, _ := schema.MultiEnvDefaultFunc([]string{"IC_REGION", "IBMCLOUD_REGION", "BM_REGION", "BLUEMIX_REGION"}, "us-south")()
 
@@ -1113,6 +1113,30 @@ func RoutingRuleRedirectGet(in *s3.Redirect) []map[string]interface{} {
 	return redirect
 }
 
+func CorsRulesGet(in []*s3.CORSRule) []map[string]interface{} {
+	rules := make([]map[string]interface{}, 0, len(in))
+	for _, rule := range in {
+		ruleConfig := make(map[string]interface{})
+		if rule.AllowedHeaders != nil {
+			ruleConfig["allowed_headers"] = FlattenStringList(aws.StringValueSlice(rule.AllowedHeaders))
+		}
+		if rule.AllowedMethods != nil {
+			ruleConfig["allowed_methods"] = FlattenStringList(aws.StringValueSlice(rule.AllowedMethods))
+		}
+		if rule.AllowedOrigins != nil {
+			ruleConfig["allowed_origins"] = FlattenStringList(aws.StringValueSlice(rule.AllowedOrigins))
+		}
+		if rule.ExposeHeaders != nil {
+			ruleConfig["expose_headers"] = FlattenStringList(aws.StringValueSlice(rule.ExposeHeaders))
+		}
+		if rule.MaxAgeSeconds != nil {
+			ruleConfig["max_age_seconds"] = int(aws.Int64Value(rule.MaxAgeSeconds))
+		}
+		rules = append(rules, ruleConfig)
+	}
+	return rules
+}
+
 func FlattenLimits(in *whisk.Limits) []interface{} {
 	att := make(map[string]interface{})
 	if in.Timeout != nil {
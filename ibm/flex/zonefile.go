@@ -0,0 +1,600 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ZoneRecord is a resource record of a DNS zone. The name is fully qualified, in lower case and without the trailing
+// dot. The data is in presentation format, with fully qualified names and the text of TXT and CAA records quoted.
+type ZoneRecord struct {
+	ID   string
+	Name string
+	Type string
+	TTL  int
+	Data string
+}
+
+func (r ZoneRecord) key() string {
+	return r.Name + " " + r.Type + " " + r.Data
+}
+
+// ZoneRecordChanges are the changes which make the live records of a zone match the desired ones
+type ZoneRecordChanges struct {
+	Create []ZoneRecord
+	// The desired records, with the ID of the live records they replace
+	Update []ZoneRecord
+	Delete []ZoneRecord
+}
+
+func (c ZoneRecordChanges) Empty() bool {
+	return len(c.Create) == 0 && len(c.Update) == 0 && len(c.Delete) == 0
+}
+
+type zoneToken struct {
+	value  string
+	quoted bool
+}
+
+type zoneLine struct {
+	indented bool
+	tokens   []zoneToken
+}
+
+// zoneFileLines splits a zone file in logical lines, without the comments and with the lines in parentheses joined
+func zoneFileLines(content string) ([]zoneLine, error) {
+	var lines []zoneLine
+	var line zoneLine
+	var token strings.Builder
+	inToken, quoted, inQuotes, depth, startOfLine := false, false, false, 0, true
+
+	endToken := func() {
+		if inToken {
+			line.tokens = append(line.tokens, zoneToken{value: token.String(), quoted: quoted})
+			token.Reset()
+			inToken, quoted = false, false
+		}
+	}
+	endLine := func() {
+		endToken()
+		if len(line.tokens) > 0 {
+			lines = append(lines, line)
+		}
+		line = zoneLine{}
+		startOfLine = true
+	}
+
+	runes := []rune(content)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		if inQuotes {
+			switch c {
+			case '\\':
+				if i+1 < len(runes) && (runes[i+1] == '"' || runes[i+1] == '\\') {
+					i++
+					token.WriteRune(runes[i])
+				} else {
+					token.WriteRune(c)
+				}
+			case '"':
+				inQuotes = false
+			case '\n':
+				return nil, fmt.Errorf("unterminated quoted string %q", token.String())
+			default:
+				token.WriteRune(c)
+			}
+			continue
+		}
+		if startOfLine && (c == ' ' || c == '\t') && depth == 0 {
+			line.indented = true
+		}
+		startOfLine = false
+		switch c {
+		case ';':
+			for i+1 < len(runes) && runes[i+1] != '\n' {
+				i++
+			}
+		case '"':
+			endToken()
+			inToken, quoted, inQuotes = true, true, true
+		case '(':
+			endToken()
+			depth++
+		case ')':
+			endToken()
+			if depth == 0 {
+				return nil, fmt.Errorf("unbalanced parenthesis")
+			}
+			depth--
+		case '\n':
+			if depth > 0 {
+				endToken()
+			} else {
+				endLine()
+			}
+		case ' ', '\t', '\r':
+			endToken()
+		default:
+			inToken = true
+			token.WriteRune(c)
+		}
+	}
+	if inQuotes {
+		return nil, fmt.Errorf("unterminated quoted string %q", token.String())
+	}
+	if depth > 0 {
+		return nil, fmt.Errorf("unbalanced parenthesis")
+	}
+	endLine()
+	return lines, nil
+}
+
+// parseZoneTTL parses a TTL in seconds or with units, such as 1h30m
+func parseZoneTTL(value string) (int, bool) {
+	if value == "" {
+		return 0, false
+	}
+	ttl, number, hasNumber := 0, 0, false
+	for _, c := range strings.ToLower(value) {
+		if c >= '0' && c <= '9' {
+			number = number*10 + int(c-'0')
+			hasNumber = true
+			continue
+		}
+		if !hasNumber {
+			return 0, false
+		}
+		switch c {
+		case 's':
+		case 'm':
+			number *= 60
+		case 'h':
+			number *= 3600
+		case 'd':
+			number *= 86400
+		case 'w':
+			number *= 604800
+		default:
+			return 0, false
+		}
+		ttl, number, hasNumber = ttl+number, 0, false
+	}
+	return ttl + number, true
+}
+
+func qualifyZoneName(name, origin string) string {
+	name = strings.ToLower(name)
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.TrimSuffix(name, ".")
+	case origin == "":
+		return name
+	default:
+		return name + "." + origin
+	}
+}
+
+// QuoteZoneText quotes a text for the data of a TXT or CAA record
+func QuoteZoneText(text string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(text) + `"`
+}
+
+// zoneRecordData normalizes the data of a record, given as tokens in presentation format
+func zoneRecordData(recordType string, tokens []zoneToken, origin string) (string, error) {
+	values := make([]string, len(tokens))
+	for i, token := range tokens {
+		values[i] = token.value
+	}
+	expect := func(count int) error {
+		if len(tokens) != count {
+			return fmt.Errorf("%s record expects %d values, got %d", recordType, count, len(tokens))
+		}
+		return nil
+	}
+	number := func(value string) (string, error) {
+		number, err := strconv.ParseUint(value, 10, 16)
+		if err != nil {
+			return "", fmt.Errorf("invalid number %q in %s record", value, recordType)
+		}
+		return strconv.FormatUint(number, 10), nil
+	}
+
+	switch recordType {
+	case "A", "AAAA":
+		if err := expect(1); err != nil {
+			return "", err
+		}
+		ip := net.ParseIP(values[0])
+		if ip == nil || (recordType == "A") != (ip.To4() != nil) {
+			return "", fmt.Errorf("invalid address %q in %s record", values[0], recordType)
+		}
+		return ip.String(), nil
+	case "CNAME", "NS", "PTR", "DNAME":
+		if err := expect(1); err != nil {
+			return "", err
+		}
+		return qualifyZoneName(values[0], origin), nil
+	case "MX":
+		if err := expect(2); err != nil {
+			return "", err
+		}
+		preference, err := number(values[0])
+		if err != nil {
+			return "", err
+		}
+		return preference + " " + qualifyZoneName(values[1], origin), nil
+	case "SRV":
+		if err := expect(4); err != nil {
+			return "", err
+		}
+		fields := make([]string, 0, 4)
+		for _, value := range values[:3] {
+			field, err := number(value)
+			if err != nil {
+				return "", err
+			}
+			fields = append(fields, field)
+		}
+		return strings.Join(append(fields, qualifyZoneName(values[3], origin)), " "), nil
+	case "SOA":
+		if err := expect(7); err != nil {
+			return "", err
+		}
+		fields := []string{qualifyZoneName(values[0], origin), qualifyZoneName(values[1], origin)}
+		for _, value := range values[2:] {
+			field, ok := parseZoneTTL(value)
+			if !ok {
+				return "", fmt.Errorf("invalid number %q in %s record", value, recordType)
+			}
+			fields = append(fields, strconv.Itoa(field))
+		}
+		return strings.Join(fields, " "), nil
+	case "TXT", "SPF":
+		if len(tokens) == 0 {
+			return "", fmt.Errorf("%s record expects a text", recordType)
+		}
+		// The strings of a record are concatenated
+		return QuoteZoneText(strings.Join(values, "")), nil
+	case "CAA":
+		if err := expect(3); err != nil {
+			return "", err
+		}
+		flags, err := number(values[0])
+		if err != nil {
+			return "", err
+		}
+		return flags + " " + strings.ToLower(values[1]) + " " + QuoteZoneText(values[2]), nil
+	default:
+		fields := make([]string, 0, len(tokens))
+		for _, token := range tokens {
+			if token.quoted {
+				fields = append(fields, QuoteZoneText(token.value))
+			} else {
+				fields = append(fields, token.value)
+			}
+		}
+		return strings.Join(fields, " "), nil
+	}
+}
+
+// NewZoneRecord normalizes a record which data is in presentation format. Relative names are qualified with origin.
+func NewZoneRecord(name, recordType string, ttl int, data, origin string) (ZoneRecord, error) {
+	origin = qualifyZoneName(origin, "")
+	recordType = strings.ToUpper(recordType)
+	lines, err := zoneFileLines(data)
+	if err != nil {
+		return ZoneRecord{}, fmt.Errorf("invalid data %q of %s record %s: %s", data, recordType, name, err)
+	}
+	var tokens []zoneToken
+	for _, line := range lines {
+		tokens = append(tokens, line.tokens...)
+	}
+	normalized, err := zoneRecordData(recordType, tokens, origin)
+	if err != nil {
+		return ZoneRecord{}, fmt.Errorf("invalid record %s: %s", name, err)
+	}
+	return ZoneRecord{
+		Name: qualifyZoneName(name, origin),
+		Type: recordType,
+		TTL:  ttl,
+		Data: normalized,
+	}, nil
+}
+
+// ZoneRecordFields returns the fields of the data of a record, with the quoted text unquoted
+func ZoneRecordFields(record ZoneRecord) []string {
+	lines, _ := zoneFileLines(record.Data)
+	var fields []string
+	for _, line := range lines {
+		for _, token := range line.tokens {
+			fields = append(fields, token.value)
+		}
+	}
+	return fields
+}
+
+var zoneClasses = map[string]bool{"IN": true, "CH": true, "HS": true, "CS": true}
+
+// ParseZoneFile parses the resource records of a zone file in BIND format. Relative names are qualified with origin,
+// until the file sets another origin with $ORIGIN. The TTL of the records without TTL is the $TTL of the file, or 0.
+func ParseZoneFile(content, origin string) ([]ZoneRecord, error) {
+	lines, err := zoneFileLines(content)
+	if err != nil {
+		return nil, err
+	}
+
+	origin = qualifyZoneName(origin, "")
+	var records []ZoneRecord
+	defaultTTL, owner := 0, ""
+	for n, line := range lines {
+		tokens := line.tokens
+		if !line.indented && !tokens[0].quoted && strings.HasPrefix(tokens[0].value, "$") {
+			directive := strings.ToUpper(tokens[0].value)
+			switch {
+			case directive == "$ORIGIN" && len(tokens) == 2:
+				origin = qualifyZoneName(tokens[1].value, origin)
+			case directive == "$TTL" && len(tokens) == 2:
+				ttl, ok := parseZoneTTL(tokens[1].value)
+				if !ok {
+					return nil, fmt.Errorf("invalid $TTL %q", tokens[1].value)
+				}
+				defaultTTL = ttl
+			default:
+				return nil, fmt.Errorf("unsupported directive %s", tokens[0].value)
+			}
+			continue
+		}
+
+		if !line.indented {
+			owner = qualifyZoneName(tokens[0].value, origin)
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, fmt.Errorf("record %d has no owner name", n+1)
+		}
+
+		ttl := defaultTTL
+		for len(tokens) > 0 {
+			if value, ok := parseZoneTTL(tokens[0].value); ok {
+				ttl = value
+			} else if !zoneClasses[strings.ToUpper(tokens[0].value)] {
+				break
+			}
+			tokens = tokens[1:]
+		}
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("record %s has no type", owner)
+		}
+
+		recordType := strings.ToUpper(tokens[0].value)
+		data, err := zoneRecordData(recordType, tokens[1:], origin)
+		if err != nil {
+			return nil, fmt.Errorf("invalid record %s: %s", owner, err)
+		}
+		records = append(records, ZoneRecord{
+			Name: owner,
+			Type: recordType,
+			TTL:  ttl,
+			Data: data,
+		})
+	}
+	return records, nil
+}
+
+// FormatZoneFile renders records in BIND format, sorted by name, type and data
+func FormatZoneFile(origin string, records []ZoneRecord) string {
+	records = append([]ZoneRecord{}, records...)
+	SortZoneRecords(records)
+
+	var zoneFile strings.Builder
+	fmt.Fprintf(&zoneFile, "$ORIGIN %s.\n", qualifyZoneName(origin, ""))
+	for _, record := range records {
+		fields := strings.Fields(record.Data)
+		var nameFields []int
+		switch record.Type {
+		case "CNAME", "NS", "PTR", "DNAME":
+			nameFields = []int{0}
+		case "MX":
+			nameFields = []int{1}
+		case "SRV":
+			nameFields = []int{3}
+		case "SOA":
+			nameFields = []int{0, 1}
+		}
+		data := record.Data
+		if len(nameFields) > 0 && nameFields[len(nameFields)-1] < len(fields) {
+			for _, field := range nameFields {
+				fields[field] += "."
+			}
+			data = strings.Join(fields, " ")
+		}
+		fmt.Fprintf(&zoneFile, "%s.\t%d\tIN\t%s\t%s\n", record.Name, record.TTL, record.Type, data)
+	}
+	return zoneFile.String()
+}
+
+func SortZoneRecords(records []ZoneRecord) {
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].key() < records[j].key()
+	})
+}
+
+// FilterZoneRecords returns the records which type is not ignored
+func FilterZoneRecords(records []ZoneRecord, ignoredTypes []string) []ZoneRecord {
+	ignored := map[string]bool{}
+	for _, recordType := range ignoredTypes {
+		ignored[strings.ToUpper(recordType)] = true
+	}
+	filtered := make([]ZoneRecord, 0, len(records))
+	for _, record := range records {
+		if !ignored[record.Type] {
+			filtered = append(filtered, record)
+		}
+	}
+	return filtered
+}
+
+// DiffZoneRecords computes the changes which make the live records match the desired ones. A desired record without
+// TTL expects defaultTTL. A removed record and an added record with the same name and type are changed to an update
+// of the removed record.
+func DiffZoneRecords(desired, live []ZoneRecord, defaultTTL int) ZoneRecordChanges {
+	liveByKey := map[string][]ZoneRecord{}
+	for _, record := range live {
+		liveByKey[record.key()] = append(liveByKey[record.key()], record)
+	}
+
+	var changes ZoneRecordChanges
+	var creates []ZoneRecord
+	for _, record := range desired {
+		if record.TTL == 0 {
+			record.TTL = defaultTTL
+		}
+		matches := liveByKey[record.key()]
+		if len(matches) == 0 {
+			creates = append(creates, record)
+			continue
+		}
+		liveByKey[record.key()] = matches[1:]
+		if matches[0].TTL != record.TTL {
+			record.ID = matches[0].ID
+			changes.Update = append(changes.Update, record)
+		}
+	}
+
+	var deletes []ZoneRecord
+	for _, record := range live {
+		if matches := liveByKey[record.key()]; len(matches) > 0 && matches[0].ID == record.ID {
+			liveByKey[record.key()] = matches[1:]
+			deletes = append(deletes, record)
+		}
+	}
+
+	for _, record := range creates {
+		replaced := false
+		for i, deleted := range deletes {
+			if deleted.Name == record.Name && deleted.Type == record.Type {
+				record.ID = deleted.ID
+				changes.Update = append(changes.Update, record)
+				deletes = append(deletes[:i], deletes[i+1:]...)
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			changes.Create = append(changes.Create, record)
+		}
+	}
+	changes.Delete = deletes
+
+	SortZoneRecords(changes.Create)
+	SortZoneRecords(changes.Update)
+	SortZoneRecords(changes.Delete)
+	return changes
+}
+
+// ApplyZoneRecordChanges deletes, updates, then creates the records, running up to batchSize requests at the same
+// time. It stops at the first batch with errors.
+func ApplyZoneRecordChanges(changes ZoneRecordChanges, batchSize int, create, update, delete func(ZoneRecord) error) error {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	phases := []struct {
+		records []ZoneRecord
+		apply   func(ZoneRecord) error
+	}{
+		{changes.Delete, delete},
+		{changes.Update, update},
+		{changes.Create, create},
+	}
+	for _, phase := range phases {
+		for start := 0; start < len(phase.records); start += batchSize {
+			end := start + batchSize
+			if end > len(phase.records) {
+				end = len(phase.records)
+			}
+			errs := make([]error, end-start)
+			var wg sync.WaitGroup
+			for i, record := range phase.records[start:end] {
+				wg.Add(1)
+				go func(i int, record ZoneRecord) {
+					defer wg.Done()
+					errs[i] = phase.apply(record)
+				}(i, record)
+			}
+			wg.Wait()
+
+			var messages []string
+			for _, err := range errs {
+				if err != nil {
+					messages = append(messages, err.Error())
+				}
+			}
+			if len(messages) > 0 {
+				return fmt.Errorf("%s", strings.Join(messages, "\n"))
+			}
+		}
+	}
+	return nil
+}
+
+// ExpandZoneRecords returns the records of a zone file, or of the record blocks of a zone records resource
+func ExpandZoneRecords(zoneFile string, recordSet *schema.Set, origin string) ([]ZoneRecord, error) {
+	if zoneFile != "" {
+		return ParseZoneFile(zoneFile, origin)
+	}
+	var records []ZoneRecord
+	if recordSet == nil {
+		return records, nil
+	}
+	for _, v := range recordSet.List() {
+		recordMap := v.(map[string]interface{})
+		record, err := NewZoneRecord(recordMap["name"].(string), recordMap["type"].(string), recordMap["ttl"].(int), recordMap["data"].(string), origin)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// ExpandZoneRecordsState returns the records attribute of a zone records resource
+func ExpandZoneRecordsState(list []interface{}) []ZoneRecord {
+	records := make([]ZoneRecord, 0, len(list))
+	for _, v := range list {
+		recordMap := v.(map[string]interface{})
+		records = append(records, ZoneRecord{
+			ID:   recordMap["id"].(string),
+			Name: recordMap["name"].(string),
+			Type: recordMap["type"].(string),
+			TTL:  recordMap["ttl"].(int),
+			Data: recordMap["data"].(string),
+		})
+	}
+	return records
+}
+
+func FlattenZoneRecords(records []ZoneRecord) []map[string]interface{} {
+	records = append([]ZoneRecord{}, records...)
+	SortZoneRecords(records)
+	result := make([]map[string]interface{}, 0, len(records))
+	for _, record := range records {
+		result = append(result, map[string]interface{}{
+			"id":   record.ID,
+			"name": record.Name,
+			"type": record.Type,
+			"ttl":  record.TTL,
+			"data": record.Data,
+		})
+	}
+	return result
+}
@@ -0,0 +1,153 @@
+package flex
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	zoneFile := `
+$TTL 1h
+$ORIGIN example.com.
+@	IN	SOA	ns1 admin (
+		2024010101 ; serial
+		3600 600 86400 300 )
+	IN	NS	ns1.example.net.
+www	300	IN	A	192.0.2.1
+	IN	AAAA	2001:DB8:0::1
+mail	MX	10 mx1
+_sip._udp	SRV	10 20 5060 sip ; comment
+txt	TXT	"v=spf1 " "-all"
+@	CAA	0 ISSUE "letsencrypt.org"
+alias.example.org.	CNAME	www
+`
+	records, err := ParseZoneFile(zoneFile, "ignored.org")
+	assert.NoError(t, err)
+	assert.Equal(t, []ZoneRecord{
+		{Name: "example.com", Type: "SOA", TTL: 3600, Data: "ns1.example.com admin.example.com 2024010101 3600 600 86400 300"},
+		{Name: "example.com", Type: "NS", TTL: 3600, Data: "ns1.example.net"},
+		{Name: "www.example.com", Type: "A", TTL: 300, Data: "192.0.2.1"},
+		{Name: "www.example.com", Type: "AAAA", TTL: 3600, Data: "2001:db8::1"},
+		{Name: "mail.example.com", Type: "MX", TTL: 3600, Data: "10 mx1.example.com"},
+		{Name: "_sip._udp.example.com", Type: "SRV", TTL: 3600, Data: "10 20 5060 sip.example.com"},
+		{Name: "txt.example.com", Type: "TXT", TTL: 3600, Data: `"v=spf1 -all"`},
+		{Name: "example.com", Type: "CAA", TTL: 3600, Data: `0 issue "letsencrypt.org"`},
+		{Name: "alias.example.org", Type: "CNAME", TTL: 3600, Data: "www.example.com"},
+	}, records)
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+	for _, zoneFile := range []string{
+		"$INCLUDE other.zone",
+		"$GENERATE 1-10 host$ A 192.0.2.$",
+		"\tA 192.0.2.1",
+		"www A 2001:db8::1",
+		"www MX mx1",
+		"www TXT \"unterminated",
+		"www SOA ( ns1 admin",
+	} {
+		_, err := ParseZoneFile(zoneFile, "example.com")
+		assert.Error(t, err, zoneFile)
+	}
+}
+
+func TestNewZoneRecord(t *testing.T) {
+	record, err := NewZoneRecord("@", "mx", 0, "010 mail", "Example.com.")
+	assert.NoError(t, err)
+	assert.Equal(t, ZoneRecord{Name: "example.com", Type: "MX", Data: "10 mail.example.com"}, record)
+
+	record, err = NewZoneRecord("txt.example.com", "TXT", 60, `"say \"hi\""`, "example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, `"say \"hi\""`, record.Data)
+	assert.Equal(t, []string{`say "hi"`}, ZoneRecordFields(record))
+}
+
+func TestFormatZoneFile(t *testing.T) {
+	records := []ZoneRecord{
+		{Name: "www.example.com", Type: "CNAME", TTL: 300, Data: "example.com"},
+		{Name: "example.com", Type: "MX", TTL: 3600, Data: "10 mail.example.com"},
+		{Name: "example.com", Type: "A", TTL: 60, Data: "192.0.2.1"},
+	}
+	zoneFile := FormatZoneFile("example.com", records)
+	assert.Equal(t, "$ORIGIN example.com.\n"+
+		"example.com.\t60\tIN\tA\t192.0.2.1\n"+
+		"example.com.\t3600\tIN\tMX\t10 mail.example.com.\n"+
+		"www.example.com.\t300\tIN\tCNAME\texample.com.\n", zoneFile)
+
+	parsed, err := ParseZoneFile(zoneFile, "")
+	assert.NoError(t, err)
+	SortZoneRecords(records)
+	assert.Equal(t, records, parsed)
+}
+
+func TestDiffZoneRecords(t *testing.T) {
+	live := []ZoneRecord{
+		{ID: "1", Name: "example.com", Type: "A", TTL: 300, Data: "192.0.2.1"},
+		{ID: "2", Name: "example.com", Type: "A", TTL: 300, Data: "192.0.2.2"},
+		{ID: "3", Name: "www.example.com", Type: "CNAME", TTL: 300, Data: "example.com"},
+		{ID: "4", Name: "old.example.com", Type: "TXT", TTL: 300, Data: `"old"`},
+		{ID: "5", Name: "mail.example.com", Type: "A", TTL: 300, Data: "192.0.2.5"},
+	}
+	desired := []ZoneRecord{
+		{Name: "example.com", Type: "A", TTL: 300, Data: "192.0.2.1"},
+		{Name: "example.com", Type: "A", TTL: 300, Data: "192.0.2.2"},
+		{Name: "www.example.com", Type: "CNAME", Data: "example.com"},
+		{Name: "mail.example.com", Type: "A", TTL: 300, Data: "192.0.2.6"},
+		{Name: "new.example.com", Type: "A", TTL: 300, Data: "192.0.2.7"},
+	}
+
+	changes := DiffZoneRecords(desired, live, 60)
+	assert.Equal(t, []ZoneRecord{
+		{Name: "new.example.com", Type: "A", TTL: 300, Data: "192.0.2.7"},
+	}, changes.Create)
+	assert.Equal(t, []ZoneRecord{
+		{ID: "5", Name: "mail.example.com", Type: "A", TTL: 300, Data: "192.0.2.6"},
+		{ID: "3", Name: "www.example.com", Type: "CNAME", TTL: 60, Data: "example.com"},
+	}, changes.Update)
+	assert.Equal(t, []ZoneRecord{
+		{ID: "4", Name: "old.example.com", Type: "TXT", TTL: 300, Data: `"old"`},
+	}, changes.Delete)
+
+	assert.True(t, DiffZoneRecords(desired[:2], live[:2], 60).Empty())
+}
+
+func TestFilterZoneRecords(t *testing.T) {
+	records := []ZoneRecord{
+		{Name: "example.com", Type: "SOA"},
+		{Name: "example.com", Type: "NS"},
+		{Name: "example.com", Type: "A"},
+	}
+	assert.Equal(t, records[2:], FilterZoneRecords(records, []string{"soa", "NS"}))
+}
+
+func TestApplyZoneRecordChanges(t *testing.T) {
+	changes := ZoneRecordChanges{
+		Create: []ZoneRecord{{Name: "c1"}, {Name: "c2"}, {Name: "c3"}},
+		Update: []ZoneRecord{{Name: "u1"}},
+		Delete: []ZoneRecord{{Name: "d1"}, {Name: "d2"}},
+	}
+	var lock sync.Mutex
+	var applied []string
+	apply := func(action string) func(ZoneRecord) error {
+		return func(record ZoneRecord) error {
+			lock.Lock()
+			defer lock.Unlock()
+			applied = append(applied, action)
+			return nil
+		}
+	}
+	err := ApplyZoneRecordChanges(changes, 2, apply("create"), apply("update"), apply("delete"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"delete", "delete", "update", "create", "create", "create"}, applied)
+
+	failed := func(record ZoneRecord) error {
+		return errors.New("failed " + record.Name)
+	}
+	applied = nil
+	err = ApplyZoneRecordChanges(changes, 10, apply("create"), apply("update"), failed)
+	assert.EqualError(t, err, "failed d1\nfailed d2")
+	assert.Empty(t, applied)
+}
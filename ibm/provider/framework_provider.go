@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/resourcecontroller"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+)
+
+// frameworkProvider serves the parts of the provider that are only available through
+// terraform-plugin-framework, such as ephemeral resources. It is muxed with the SDK
+// provider, which remains the owner of the provider configuration: the framework
+// provider mirrors its schema and hands the SDK provider to its resources, which use
+// the configured client session once Terraform has configured the provider.
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+}
+
+var _ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+
+// NewFrameworkProvider returns the framework provider muxed alongside the given SDK provider
+func NewFrameworkProvider(sdkProvider *schema.Provider) provider.Provider {
+	return &frameworkProvider{
+		sdkProvider: sdkProvider,
+	}
+}
+
+// ProviderServerFactory returns the muxed protocol version 5 server combining the SDK
+// provider and the framework provider
+func ProviderServerFactory(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	sdkProvider := Provider()
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		sdkProvider.GRPCProvider,
+		providerserver.NewProtocol5(NewFrameworkProvider(sdkProvider)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
+}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "ibm"
+}
+
+// Schema mirrors the SDK provider schema, as muxed providers must declare identical
+// provider schemas
+func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	attributes := make(map[string]providerschema.Attribute, len(p.sdkProvider.Schema))
+	for name, s := range p.sdkProvider.Schema {
+		switch s.Type {
+		case schema.TypeInt:
+			attributes[name] = providerschema.Int64Attribute{
+				Required:           s.Required,
+				Optional:           s.Optional,
+				Sensitive:          s.Sensitive,
+				Description:        s.Description,
+				DeprecationMessage: s.Deprecated,
+			}
+		default:
+			attributes[name] = providerschema.StringAttribute{
+				Required:           s.Required,
+				Optional:           s.Optional,
+				Sensitive:          s.Sensitive,
+				Description:        s.Description,
+				DeprecationMessage: s.Deprecated,
+			}
+		}
+	}
+	resp.Schema = providerschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// Configure passes the SDK provider to the framework resources. The client session is
+// built by the SDK provider configuration, so it is not created a second time here.
+func (p *frameworkProvider) Configure(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	resp.EphemeralResourceData = p.sdkProvider
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		// Resource Controller
+		resourcecontroller.EphemeralIBMResourceKey,
+
+		// Secrets Manager
+		secretsmanager.EphemeralIbmSmArbitrarySecret,
+		secretsmanager.EphemeralIbmSmCustomCredentialsSecret,
+		secretsmanager.EphemeralIbmSmIamCredentialsSecret,
+		secretsmanager.EphemeralIbmSmImportedCertificate,
+		secretsmanager.EphemeralIbmSmKvSecret,
+		secretsmanager.EphemeralIbmSmPrivateCertificate,
+		secretsmanager.EphemeralIbmSmPublicCertificate,
+		secretsmanager.EphemeralIbmSmServiceCredentialsSecret,
+		secretsmanager.EphemeralIbmSmUsernamePasswordSecret,
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+)
+
+func TestProviderServerFactory(t *testing.T) {
+	ctx := context.Background()
+	providerServer, err := provider.ProviderServerFactory(ctx)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// The muxed providers must declare identical provider schemas
+	resp, err := providerServer().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, diag := range resp.Diagnostics {
+		if diag.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("%s: %s", diag.Summary, diag.Detail)
+		}
+	}
+
+	for _, name := range []string{"ibm_resource_key", "ibm_sm_arbitrary_secret", "ibm_sm_kv_secret"} {
+		if _, ok := resp.EphemeralResourceSchemas[name]; !ok {
+			t.Errorf("ephemeral resource %s is not served", name)
+		}
+	}
+	if _, ok := resp.ResourceSchemas["ibm_sm_arbitrary_secret"]; !ok {
+		t.Errorf("resource ibm_sm_arbitrary_secret is not served")
+	}
+}
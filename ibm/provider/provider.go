@@ -300,6 +300,7 @@ func Provider() *schema.Provider {
 			"ibm_cis_edge_functions_triggers":              cis.DataSourceIBMCISEdgeFunctionsTriggers(),
 			"ibm_cis_custom_pages":                         cis.DataSourceIBMCISCustomPages(),
 			"ibm_cis_page_rules":                           cis.DataSourceIBMCISPageRules(),
+			"ibm_cis_ruleset_migration":                    cis.DataSourceIBMCISRulesetMigration(),
 			"ibm_cis_waf_rules":                            cis.DataSourceIBMCISWAFRules(),
 			"ibm_cis_filters":                              cis.DataSourceIBMCISFilters(),
 			"ibm_cis_firewall_rules":                       cis.DataSourceIBMCISFirewallRules(),
@@ -383,14 +384,17 @@ func Provider() *schema.Provider {
 			"ibm_iam_trusted_profiles":                     iamidentity.DataSourceIBMIamTrustedProfiles(),
 			"ibm_iam_trusted_profile_policy":               iampolicy.DataSourceIBMIAMTrustedProfilePolicy(),
 			"ibm_iam_user_mfa_enrollments":                 iamidentity.DataSourceIBMIamUserMfaEnrollments(),
+			"ibm_iam_identity_activity":                    iamidentity.DataSourceIBMIAMIdentityActivity(),
 			"ibm_iam_account_settings_template":            iamidentity.DataSourceIBMAccountSettingsTemplate(),
 			"ibm_iam_trusted_profile_template":             iamidentity.DataSourceIBMTrustedProfileTemplate(),
 			"ibm_iam_account_settings_template_assignment": iamidentity.DataSourceIBMAccountSettingsTemplateAssignment(),
 			"ibm_iam_trusted_profile_template_assignment":  iamidentity.DataSourceIBMTrustedProfileTemplateAssignment(),
+			"ibm_iam_template_assignment_drift":            iamidentity.DataSourceIBMIAMTemplateAssignmentDrift(),
 			"ibm_iam_policy_template":                      iampolicy.DataSourceIBMIAMPolicyTemplate(),
 			"ibm_iam_policy_template_version":              iampolicy.DataSourceIBMIAMPolicyTemplateVersion(),
 			"ibm_iam_policy_assignments":                   iampolicy.DataSourceIBMIAMPolicyAssignments(),
 			"ibm_iam_policy_assignment":                    iampolicy.DataSourceIBMIAMPolicyAssignment(),
+			"ibm_iam_effective_access":                     iampolicy.DataSourceIBMIAMEffectiveAccess(),
 
 			// backup as Service
 			"ibm_is_backup_policy":       vpc.DataSourceIBMIsBackupPolicy(),
@@ -577,6 +581,7 @@ func Provider() *schema.Provider {
 			"ibm_kms_key_policies":                   kms.DataSourceIBMKMSkeyPolicies(),
 			"ibm_kms_keys":                           kms.DataSourceIBMKMSkeys(),
 			"ibm_kms_key":                            kms.DataSourceIBMKMSkey(),
+			"ibm_kms_key_versions":                   kms.DataSourceIBMKMSKeyVersions(),
 			"ibm_kms_kmip_adapter":                   kms.DataSourceIBMKMSKmipAdapter(),
 			"ibm_kms_kmip_adapters":                  kms.DataSourceIBMKMSKmipAdapters(),
 			"ibm_kms_kmip_client_cert":               kms.DataSourceIBMKmsKMIPClientCertificate(),
@@ -742,6 +747,7 @@ func Provider() *schema.Provider {
 			"ibm_sm_public_certificate_configuration_dns_cis":                    secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmConfigurationPublicCertificateDNSCis()),
 			"ibm_sm_public_certificate_configuration_dns_classic_infrastructure": secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmPublicCertificateConfigurationDNSClassicInfrastructure()),
 			"ibm_sm_iam_credentials_configuration":                               secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmIamCredentialsConfiguration()),
+			"ibm_sm_custom_credentials_configuration":                            secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmCustomCredentialsConfiguration()),
 			"ibm_sm_configurations":                                              secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmConfigurations()),
 			"ibm_sm_secrets":                                                     secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmSecrets()),
 			"ibm_sm_arbitrary_secret_metadata":                                   secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmArbitrarySecretMetadata()),
@@ -750,6 +756,7 @@ func Provider() *schema.Provider {
 			"ibm_sm_private_certificate_metadata":                                secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmPrivateCertificateMetadata()),
 			"ibm_sm_iam_credentials_secret_metadata":                             secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmIamCredentialsSecretMetadata()),
 			"ibm_sm_service_credentials_secret_metadata":                         secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmServiceCredentialsSecretMetadata()),
+			"ibm_sm_custom_credentials_secret_metadata":                          secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmCustomCredentialsSecretMetadata()),
 			"ibm_sm_kv_secret_metadata":                                          secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmKvSecretMetadata()),
 			"ibm_sm_username_password_secret_metadata":                           secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmUsernamePasswordSecretMetadata()),
 			"ibm_sm_arbitrary_secret":                                            secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmArbitrarySecret()),
@@ -760,6 +767,7 @@ func Provider() *schema.Provider {
 			"ibm_sm_kv_secret":                                                   secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmKvSecret()),
 			"ibm_sm_username_password_secret":                                    secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmUsernamePasswordSecret()),
 			"ibm_sm_service_credentials_secret":                                  secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmServiceCredentialsSecret()),
+			"ibm_sm_custom_credentials_secret":                                   secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmCustomCredentialsSecret()),
 			"ibm_sm_en_registration":                                             secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmEnRegistration()),
 
 			// Added for Satellite
@@ -834,9 +842,10 @@ func Provider() *schema.Provider {
 			"ibm_pag_instance": pag.DataSourceIBMPag(),
 
 			// Added for Context Based Restrictions
-			"ibm_cbr_zone":           contextbasedrestrictions.DataSourceIBMCbrZone(),
-			"ibm_cbr_zone_addresses": contextbasedrestrictions.DataSourceIBMCbrZoneAddresses(),
-			"ibm_cbr_rule":           contextbasedrestrictions.DataSourceIBMCbrRule(),
+			"ibm_cbr_zone":                contextbasedrestrictions.DataSourceIBMCbrZone(),
+			"ibm_cbr_zone_addresses":      contextbasedrestrictions.DataSourceIBMCbrZoneAddresses(),
+			"ibm_cbr_rule":                contextbasedrestrictions.DataSourceIBMCbrRule(),
+			"ibm_cbr_rule_report_denials": contextbasedrestrictions.DataSourceIBMCbrRuleReportDenials(),
 
 			// Added for Event Notifications
 			"ibm_en_source":                    eventnotification.DataSourceIBMEnSource(),
@@ -1009,6 +1018,9 @@ func Provider() *schema.Provider {
 
 			"ibm_cis":                                 cis.ResourceIBMCISInstance(),
 			"ibm_database":                            database.ResourceIBMDatabaseInstance(),
+			"ibm_database_backup":                     database.ResourceIBMDatabaseBackup(),
+			"ibm_database_allowlist_entry":            database.ResourceIBMDatabaseAllowlistEntry(),
+			"ibm_database_user":                       database.ResourceIBMDatabaseUser(),
 			"ibm_cis_domain":                          cis.ResourceIBMCISDomain(),
 			"ibm_cis_domain_settings":                 cis.ResourceIBMCISSettings(),
 			"ibm_cis_firewall":                        cis.ResourceIBMCISFirewallRecord(),
@@ -1019,6 +1031,8 @@ func Provider() *schema.Provider {
 			"ibm_cis_certificate_upload":              cis.ResourceIBMCISCertificateUpload(),
 			"ibm_cis_dns_record":                      cis.ResourceIBMCISDnsRecord(),
 			"ibm_cis_dns_records_import":              cis.ResourceIBMCISDNSRecordsImport(),
+			"ibm_cis_dns_zone_records":                cis.ResourceIBMCISDNSZoneRecords(),
+			"ibm_cis_ruleset_migration":               cis.ResourceIBMCISRulesetMigration(),
 			"ibm_cis_rate_limit":                      cis.ResourceIBMCISRateLimit(),
 			"ibm_cis_page_rule":                       cis.ResourceIBMCISPageRule(),
 			"ibm_cis_edge_functions_action":           cis.ResourceIBMCISEdgeFunctionsAction(),
@@ -1069,6 +1083,7 @@ func Provider() *schema.Provider {
 			"ibm_container_vpc_alb":                        kubernetes.ResourceIBMContainerVpcALB(),
 			"ibm_container_vpc_alb_create":                 kubernetes.ResourceIBMContainerVpcAlbCreateNew(),
 			"ibm_container_vpc_worker_pool":                kubernetes.ResourceIBMContainerVpcWorkerPool(),
+			"ibm_container_vpc_worker_action":              kubernetes.ResourceIBMContainerVpcWorkerAction(),
 			"ibm_container_vpc_worker":                     kubernetes.ResourceIBMContainerVpcWorker(),
 			"ibm_container_vpc_cluster":                    kubernetes.ResourceIBMContainerVpcCluster(),
 			"ibm_container_alb_cert":                       kubernetes.ResourceIBMContainerALBCert(),
@@ -1091,8 +1106,11 @@ func Provider() *schema.Provider {
 			"ibm_cos_bucket":                               cos.ResourceIBMCOSBucket(),
 			"ibm_cos_bucket_replication_rule":              cos.ResourceIBMCOSBucketReplicationConfiguration(),
 			"ibm_cos_bucket_object":                        cos.ResourceIBMCOSBucketObject(),
+			"ibm_cos_bucket_objects_sync":                  cos.ResourceIBMCOSBucketObjectsSync(),
 			"ibm_cos_bucket_object_lock_configuration":     cos.ResourceIBMCOSBucketObjectlock(),
 			"ibm_cos_bucket_website_configuration":         cos.ResourceIBMCOSBucketWebsiteConfiguration(),
+			"ibm_cos_bucket_cors_configuration":            cos.ResourceIBMCOSBucketCorsConfiguration(),
+			"ibm_cos_bucket_lifecycle_configuration":       cos.ResourceIBMCOSBucketLifecycleConfiguration(),
 			"ibm_dns_domain":                               classicinfrastructure.ResourceIBMDNSDomain(),
 			"ibm_dns_domain_registration_nameservers":      classicinfrastructure.ResourceIBMDNSDomainRegistrationNameservers(),
 			"ibm_dns_secondary":                            classicinfrastructure.ResourceIBMDNSSecondary(),
@@ -1115,6 +1133,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_custom_role":                          iampolicy.ResourceIBMIAMCustomRole(),
 			"ibm_iam_access_group_dynamic_rule":            iamaccessgroup.ResourceIBMIAMDynamicRule(),
 			"ibm_iam_access_group_members":                 iamaccessgroup.ResourceIBMIAMAccessGroupMembers(),
+			"ibm_iam_access_group_member":                  iamaccessgroup.ResourceIBMIAMAccessGroupMember(),
 			"ibm_iam_access_group_policy":                  iampolicy.ResourceIBMIAMAccessGroupPolicy(),
 			"ibm_iam_authorization_policy":                 iampolicy.ResourceIBMIAMAuthorizationPolicy(),
 			"ibm_iam_authorization_policy_detach":          iampolicy.ResourceIBMIAMAuthorizationPolicyDetach(),
@@ -1125,6 +1144,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_service_policy":                       iampolicy.ResourceIBMIAMServicePolicy(),
 			"ibm_iam_user_invite":                          iampolicy.ResourceIBMIAMUserInvite(),
 			"ibm_iam_api_key":                              iamidentity.ResourceIBMIAMApiKey(),
+			"ibm_iam_rotating_api_key":                     iamidentity.ResourceIBMIAMRotatingAPIKey(),
 			"ibm_iam_trusted_profile":                      iamidentity.ResourceIBMIAMTrustedProfile(),
 			"ibm_iam_trusted_profile_identity":             iamidentity.ResourceIBMIamTrustedProfileIdentity(),
 			"ibm_iam_trusted_profile_claim_rule":           iamidentity.ResourceIBMIAMTrustedProfileClaimRule(),
@@ -1138,6 +1158,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_policy_template":                      iampolicy.ResourceIBMIAMPolicyTemplate(),
 			"ibm_iam_policy_template_version":              iampolicy.ResourceIBMIAMPolicyTemplateVersion(),
 			"ibm_iam_policy_assignment":                    iampolicy.ResourceIBMIAMPolicyAssignment(),
+			"ibm_iam_policy_bundle":                        iampolicy.ResourceIBMIAMPolicyBundle(),
 
 			"ibm_is_backup_policy":      vpc.ResourceIBMIsBackupPolicy(),
 			"ibm_is_backup_policy_plan": vpc.ResourceIBMIsBackupPolicyPlan(),
@@ -1250,6 +1271,7 @@ func Provider() *schema.Provider {
 			"ibm_kms_key_alias":                             kms.ResourceIBMKmskeyAlias(),
 			"ibm_kms_key_rings":                             kms.ResourceIBMKmskeyRings(),
 			"ibm_kms_key_policies":                          kms.ResourceIBMKmskeyPolicies(),
+			"ibm_kms_key_rotation":                          kms.ResourceIBMKmsKeyRotation(),
 			"ibm_kp_key":                                    kms.ResourceIBMkey(),
 			"ibm_kms_instance_policies":                     kms.ResourceIBMKmsInstancePolicy(),
 			"ibm_kms_kmip_adapter":                          kms.ResourceIBMKmsKMIPAdapter(),
@@ -1277,12 +1299,14 @@ func Provider() *schema.Provider {
 			"ibm_pi_cloud_connection":                power.ResourceIBMPICloudConnection(),
 			"ibm_pi_console_language":                power.ResourceIBMPIInstanceConsoleLanguage(),
 			"ibm_pi_dhcp":                            power.ResourceIBMPIDhcp(),
+			"ibm_pi_dr_failover":                     power.ResourceIBMPIDRFailover(),
 			"ibm_pi_host_group":                      power.ResourceIBMPIHostGroup(),
 			"ibm_pi_host":                            power.ResourceIBMPIHost(),
 			"ibm_pi_ike_policy":                      power.ResourceIBMPIIKEPolicy(),
 			"ibm_pi_image_export":                    power.ResourceIBMPIImageExport(),
 			"ibm_pi_image":                           power.ResourceIBMPIImage(),
 			"ibm_pi_instance_action":                 power.ResourceIBMPIInstanceAction(),
+			"ibm_pi_instance_backup_policy":          power.ResourceIBMPIInstanceBackupPolicy(),
 			"ibm_pi_instance":                        power.ResourceIBMPIInstance(),
 			"ibm_pi_ipsec_policy":                    power.ResourceIBMPIIPSecPolicy(),
 			"ibm_pi_key":                             power.ResourceIBMPIKey(),
@@ -1299,12 +1323,14 @@ func Provider() *schema.Provider {
 			"ibm_pi_volume_onboarding":               power.ResourceIBMPIVolumeOnboarding(),
 			"ibm_pi_volume":                          power.ResourceIBMPIVolume(),
 			"ibm_pi_vpn_connection":                  power.ResourceIBMPIVPNConnection(),
+			"ibm_pi_workspace_baseline":              power.ResourceIBMPIWorkspaceBaseline(),
 			"ibm_pi_workspace":                       power.ResourceIBMPIWorkspace(),
 
 			// Private DNS related resources
 			"ibm_dns_zone":              dnsservices.ResourceIBMPrivateDNSZone(),
 			"ibm_dns_permitted_network": dnsservices.ResourceIBMPrivateDNSPermittedNetwork(),
 			"ibm_dns_resource_record":   dnsservices.ResourceIBMPrivateDNSResourceRecord(),
+			"ibm_dns_zone_records":      dnsservices.ResourceIBMPrivateDNSZoneRecords(),
 			"ibm_dns_glb_monitor":       dnsservices.ResourceIBMPrivateDNSGLBMonitor(),
 			"ibm_dns_glb_pool":          dnsservices.ResourceIBMPrivateDNSGLBPool(),
 			"ibm_dns_glb":               dnsservices.ResourceIBMPrivateDNSGLB(),
@@ -1340,9 +1366,10 @@ func Provider() *schema.Provider {
 			"ibm_cm_object":            catalogmanagement.ResourceIBMCmObject(),
 
 			// Added for enterprise
-			"ibm_enterprise":               enterprise.ResourceIBMEnterprise(),
-			"ibm_enterprise_account_group": enterprise.ResourceIBMEnterpriseAccountGroup(),
-			"ibm_enterprise_account":       enterprise.ResourceIBMEnterpriseAccount(),
+			"ibm_enterprise":                 enterprise.ResourceIBMEnterprise(),
+			"ibm_enterprise_account_group":   enterprise.ResourceIBMEnterpriseAccountGroup(),
+			"ibm_enterprise_account":         enterprise.ResourceIBMEnterpriseAccount(),
+			"ibm_enterprise_account_factory": enterprise.ResourceIBMEnterpriseAccountFactory(),
 
 			// //Added for Usage Reports
 			"ibm_billing_report_snapshot": usagereports.ResourceIBMBillingReportSnapshot(),
@@ -1367,8 +1394,10 @@ func Provider() *schema.Provider {
 			"ibm_sm_private_certificate":                                         secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPrivateCertificate()),
 			"ibm_sm_iam_credentials_secret":                                      secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmIamCredentialsSecret()),
 			"ibm_sm_service_credentials_secret":                                  secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmServiceCredentialsSecret()),
+			"ibm_sm_custom_credentials_secret":                                   secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmCustomCredentialsSecret()),
 			"ibm_sm_username_password_secret":                                    secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmUsernamePasswordSecret()),
 			"ibm_sm_kv_secret":                                                   secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmKvSecret()),
+			"ibm_sm_secret_replica":                                              secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmSecretReplica()),
 			"ibm_sm_public_certificate_configuration_ca_lets_encrypt":            secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPublicCertificateConfigurationCALetsEncrypt()),
 			"ibm_sm_public_certificate_configuration_dns_cis":                    secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmConfigurationPublicCertificateDNSCis()),
 			"ibm_sm_public_certificate_configuration_dns_classic_infrastructure": secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPublicCertificateConfigurationDNSClassicInfrastructure()),
@@ -1376,6 +1405,7 @@ func Provider() *schema.Provider {
 			"ibm_sm_private_certificate_configuration_intermediate_ca":           secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPrivateCertificateConfigurationIntermediateCA()),
 			"ibm_sm_private_certificate_configuration_template":                  secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPrivateCertificateConfigurationTemplate()),
 			"ibm_sm_iam_credentials_configuration":                               secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmIamCredentialsConfiguration()),
+			"ibm_sm_custom_credentials_configuration":                            secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmCustomCredentialsConfiguration()),
 			"ibm_sm_public_certificate_action_validate_manual_dns":               secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPublicCertificateActionValidateManualDns()),
 			"ibm_sm_en_registration":                                             secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmEnRegistration()),
 			"ibm_sm_private_certificate_configuration_action_sign_csr":           secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPrivateCertificateConfigurationActionSignCsr()),
@@ -1738,6 +1768,8 @@ func Validator() validate.ValidatorDict {
 				"ibm_cis_alert":                                cis.ResourceIBMCISAlertValidator(),
 				"ibm_cis_dns_record":                           cis.ResourceIBMCISDnsRecordValidator(),
 				"ibm_cis_dns_records_import":                   cis.ResourceIBMCISDnsRecordsImportValidator(),
+				"ibm_cis_dns_zone_records":                     cis.ResourceIBMCISDNSZoneRecordsValidator(),
+				"ibm_cis_ruleset_migration":                    cis.ResourceIBMCISRulesetMigrationValidator(),
 				"ibm_cis_edge_functions_action":                cis.ResourceIBMCISEdgeFunctionsActionValidator(),
 				"ibm_cis_edge_functions_trigger":               cis.ResourceIBMCISEdgeFunctionsTriggerValidator(),
 				"ibm_cis_global_load_balancer":                 cis.ResourceIBMCISGlbValidator(),
@@ -1757,6 +1789,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_container_worker_pool":                    kubernetes.ResourceIBMContainerWorkerPoolValidator(),
 				"ibm_container_vpc_worker_pool":                kubernetes.ResourceIBMContainerVPCWorkerPoolValidator(),
 				"ibm_container_vpc_worker":                     kubernetes.ResourceIBMContainerVPCWorkerValidator(),
+				"ibm_container_vpc_worker_action":              kubernetes.ResourceIBMContainerVpcWorkerActionValidator(),
 				"ibm_container_vpc_cluster":                    kubernetes.ResourceIBMContainerVpcClusterValidator(),
 				"ibm_cos_bucket":                               cos.ResourceIBMCOSBucketValidator(),
 				"ibm_cr_namespace":                             registry.ResourceIBMCrNamespaceValidator(),
@@ -1936,6 +1969,7 @@ func Validator() validate.ValidatorDict {
 
 				"ibm_iam_access_group_dynamic_rule":        iamaccessgroup.ResourceIBMIAMDynamicRuleValidator(),
 				"ibm_iam_access_group_members":             iamaccessgroup.ResourceIBMIAMAccessGroupMembersValidator(),
+				"ibm_iam_access_group_member":              iamaccessgroup.ResourceIBMIAMAccessGroupMemberValidator(),
 				"ibm_iam_access_group_template":            iamaccessgroup.ResourceIBMIAMAccessGroupTemplateValidator(),
 				"ibm_iam_access_group_template_version":    iamaccessgroup.ResourceIBMIAMAccessGroupTemplateVersionValidator(),
 				"ibm_iam_access_group_template_assignment": iamaccessgroup.ResourceIBMIAMAccessGroupTemplateAssignmentValidator(),
@@ -2035,6 +2069,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_cis_origin_auths":                cis.DataSourceIBMCISOriginAuthPullValidator(),
 				"ibm_cis_origin_pools":                cis.DataSourceIBMCISOriginPoolsValidator(),
 				"ibm_cis_page_rules":                  cis.DataSourceIBMCISPageRulesValidator(),
+				"ibm_cis_ruleset_migration":           cis.DataSourceIBMCISRulesetMigrationValidator(),
 				"ibm_cis_range_apps":                  cis.DataSourceIBMCISRangeAppsValidator(),
 				"ibm_cis_rate_limit":                  cis.DataSourceIBMCISRateLimitValidator(),
 				"ibm_cis_rulesets":                    cis.DataSourceIBMCISRulesetsValidator(),
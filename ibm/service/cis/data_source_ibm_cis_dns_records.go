@@ -20,6 +20,7 @@ import (
 const (
 	cisDNSRecords           = "cis_dns_records"
 	cisDNSRecordsExportFile = "file"
+	cisDNSRecordsZoneFile   = "zone_file"
 )
 
 func DataSourceIBMCISDNSRecords() *schema.Resource {
@@ -50,6 +51,11 @@ func DataSourceIBMCISDNSRecords() *schema.Resource {
 				Optional:    true,
 				Description: "file to be exported",
 			},
+			cisDNSRecordsZoneFile: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DNS records of the zone in BIND zone file format",
+			},
 
 			cisDNSRecords: {
 				Type:        schema.TypeList,
@@ -192,17 +198,27 @@ func dataSourceIBMCISDNSRecordsRead(d *schema.ResourceData, meta interface{}) er
 		d.Set(cisDNSRecordsExportFile, file)
 	}
 
-	opt := sess.NewListAllDnsRecordsOptions()
-	opt.SetPage(1)
-	opt.SetPerPage(1000)
-	result, response, err := sess.ListAllDnsRecords(opt)
+	// The zone file is best-effort, the records are still listed when the zone name can not be read
+	zoneName, err := cisDNSZoneName(meta, crn, zoneID)
+	if err != nil {
+		log.Printf("[WARN] The zone file of zone %s is not exported, error getting the zone name: %s", zoneID, err)
+	}
+	result, err := cisDNSRecordsList(sess)
 	if err != nil {
-		log.Printf("Error reading dns records: %s", response)
 		return err
 	}
 
 	records = make([]map[string]interface{}, 0)
-	for _, instance := range result.Result {
+	zoneRecords := make([]flex.ZoneRecord, 0, len(result))
+	for _, instance := range result {
+		if zoneName != "" {
+			if zoneRecord, err := cisDNSZoneRecord(instance, zoneName); err == nil {
+				zoneRecords = append(zoneRecords, zoneRecord)
+			} else {
+				log.Printf("[WARN] Record %s is not exported to the zone file: %s", *instance.ID, err)
+			}
+		}
+
 		record := map[string]interface{}{}
 		record["id"] = flex.ConvertCisToTfThreeVar(*instance.ID, zoneID, crn)
 		record[cisDNSRecordID] = *instance.ID
@@ -230,6 +246,11 @@ func dataSourceIBMCISDNSRecordsRead(d *schema.ResourceData, meta interface{}) er
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
 	d.Set(cisDNSRecords, records)
+	if zoneName != "" {
+		d.Set(cisDNSRecordsZoneFile, flex.FormatZoneFile(zoneName, zoneRecords))
+	} else {
+		d.Set(cisDNSRecordsZoneFile, "")
+	}
 	return nil
 }
 
@@ -28,6 +28,7 @@ func TestAccIBMCisDNSRecordsDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(node, "cis_dns_records.0.record_id"),
 					resource.TestCheckResourceAttrSet(node, "cis_dns_records.0.name"),
 					resource.TestCheckResourceAttrSet(node, "file"),
+					resource.TestCheckResourceAttrSet(node, "zone_file"),
 					testAccCheckIBMCisDNSRecordsExportExists("/tmp/records.txt"),
 					testAccCheckIBMCisDNSRecordsExportedFileRemove("/tmp/records.txt"),
 				),
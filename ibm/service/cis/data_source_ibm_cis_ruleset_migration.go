@@ -0,0 +1,1054 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	cispagerulev1 "github.com/IBM/networking-go-sdk/pageruleapiv1"
+	cisuarulev1 "github.com/IBM/networking-go-sdk/useragentblockingrulesv1"
+	cisaccessrulev1 "github.com/IBM/networking-go-sdk/zonefirewallaccessrulesv1"
+	cislockdownv1 "github.com/IBM/networking-go-sdk/zonelockdownv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	cisRulesetMigrationLegacyTypes          = "legacy_types"
+	cisRulesetMigrationRules                = "rules"
+	cisRulesetMigrationRulePhase            = "phase"
+	cisRulesetMigrationRuleRef              = "ref"
+	cisRulesetMigrationRuleDescription      = "description"
+	cisRulesetMigrationRuleExpression       = "expression"
+	cisRulesetMigrationRuleAction           = "action"
+	cisRulesetMigrationRuleActionParameters = "action_parameters"
+	cisRulesetMigrationRuleEnabled          = "enabled"
+	cisRulesetMigrationRuleSource           = "source"
+	cisRulesetMigrationRuleSourceID         = "source_id"
+	cisRulesetMigrationUnmapped             = "unmapped"
+	cisRulesetMigrationUnmappedSetting      = "setting"
+	cisRulesetMigrationUnmappedReason       = "reason"
+
+	cisRulesetMigrationTypePageRules   = "page_rules"
+	cisRulesetMigrationTypeAccessRules = "access_rules"
+	cisRulesetMigrationTypeLockdowns   = "lockdowns"
+	cisRulesetMigrationTypeUARules     = "ua_rules"
+	cisRulesetMigrationTypeWAF         = "waf"
+
+	cisRulesetMigrationSourcePageRule   = "page_rule"
+	cisRulesetMigrationSourceAccessRule = "access_rule"
+	cisRulesetMigrationSourceLockdown   = "lockdown"
+	cisRulesetMigrationSourceUARule     = "ua_rule"
+	cisRulesetMigrationSourceWAFPackage = "waf_package"
+	cisRulesetMigrationSourceWAFGroup   = "waf_group"
+	cisRulesetMigrationSourceWAFRule    = "waf_rule"
+
+	cisRulesetPhaseFirewallCustom  = "http_request_firewall_custom"
+	cisRulesetPhaseFirewallManaged = "http_request_firewall_managed"
+	cisRulesetPhaseDynamicRedirect = "http_request_dynamic_redirect"
+	cisRulesetPhaseOrigin          = "http_request_origin"
+	cisRulesetPhaseConfigSettings  = "http_config_settings"
+	cisRulesetPhaseCacheSettings   = "http_request_cache_settings"
+
+	cisRulesetMigrationPageSize = 100
+)
+
+var cisRulesetMigrationLegacyTypesAll = []string{
+	cisRulesetMigrationTypePageRules, cisRulesetMigrationTypeAccessRules, cisRulesetMigrationTypeLockdowns,
+	cisRulesetMigrationTypeUARules, cisRulesetMigrationTypeWAF,
+}
+
+// The phases in the order in which the requests go through them
+var cisRulesetMigrationPhaseOrder = []string{
+	cisRulesetPhaseDynamicRedirect, cisRulesetPhaseOrigin, cisRulesetPhaseConfigSettings,
+	cisRulesetPhaseFirewallCustom, cisRulesetPhaseFirewallManaged, cisRulesetPhaseCacheSettings,
+}
+
+// cisMigrationRule is a ruleset entrypoint rule which replaces a legacy setting
+type cisMigrationRule struct {
+	Phase            string
+	Ref              string
+	Description      string
+	Expression       string
+	Action           string
+	ActionParameters map[string]interface{}
+	Enabled          bool
+	Source           string
+	SourceID         string
+}
+
+// cisMigrationUnmapped is a legacy setting which has no equivalent rule
+type cisMigrationUnmapped struct {
+	Source   string
+	SourceID string
+	Setting  string
+	Reason   string
+}
+
+type cisMigration struct {
+	Rules    []cisMigrationRule
+	Unmapped []cisMigrationUnmapped
+}
+
+func (m *cisMigration) rule(rule cisMigrationRule) {
+	m.Rules = append(m.Rules, rule)
+}
+
+func (m *cisMigration) unmapped(source, sourceID, setting, reason string) {
+	m.Unmapped = append(m.Unmapped, cisMigrationUnmapped{
+		Source:   source,
+		SourceID: sourceID,
+		Setting:  setting,
+		Reason:   reason,
+	})
+}
+
+// CISRulesetMigrationRuleObject is the schema of a migrated rule, shared by the data source and the resource
+var CISRulesetMigrationRuleObject = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		cisRulesetMigrationRulePhase: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(cisRulesetMigrationPhaseOrder, false),
+			Description:  "Phase of the entrypoint ruleset of the rule",
+		},
+		cisRulesetMigrationRuleRef: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Reference of the rule, unique in the entrypoint ruleset",
+		},
+		cisRulesetMigrationRuleDescription: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Description of the rule",
+		},
+		cisRulesetMigrationRuleExpression: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Expression of the rule",
+		},
+		cisRulesetMigrationRuleAction: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Action of the rule",
+		},
+		cisRulesetMigrationRuleActionParameters: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+			Description:  "Parameters of the action of the rule, in JSON",
+		},
+		cisRulesetMigrationRuleEnabled: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Whether the rule is enabled",
+		},
+		cisRulesetMigrationRuleSource: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Type of the legacy setting which the rule replaces",
+		},
+		cisRulesetMigrationRuleSourceID: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "ID of the legacy setting which the rule replaces",
+		},
+	},
+}
+
+func DataSourceIBMCISRulesetMigration() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMCISRulesetMigrationRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "CIS instance crn",
+				ValidateFunc: validate.InvokeDataSourceValidator(
+					"ibm_cis_ruleset_migration",
+					cisID),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+				Description:      "Associated CIS domain",
+			},
+			cisRulesetMigrationLegacyTypes: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(cisRulesetMigrationLegacyTypesAll, false),
+				},
+				Description: "Types of the legacy settings to migrate. Defaults to all the types.",
+			},
+			cisRulesetMigrationRules: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Entrypoint ruleset rules which replace the legacy settings",
+				Elem:        CISRulesetMigrationRuleObject,
+			},
+			cisRulesetMigrationUnmapped: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Legacy settings which have no equivalent rule",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						cisRulesetMigrationRuleSource: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the legacy setting",
+						},
+						cisRulesetMigrationRuleSourceID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the legacy setting",
+						},
+						cisRulesetMigrationUnmappedSetting: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Part of the legacy setting which is not migrated",
+						},
+						cisRulesetMigrationUnmappedReason: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Why the setting is not migrated",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceIBMCISRulesetMigrationValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisID,
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+
+	ibmCISRulesetMigrationValidator := validate.ResourceValidator{
+		ResourceName: "ibm_cis_ruleset_migration",
+		Schema:       validateSchema}
+	return &ibmCISRulesetMigrationValidator
+}
+
+func dataSourceIBMCISRulesetMigrationRead(d *schema.ResourceData, meta interface{}) error {
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+
+	legacyTypes := cisRulesetMigrationLegacyTypesAll
+	if v, ok := d.GetOk(cisRulesetMigrationLegacyTypes); ok {
+		legacyTypes = flex.ExpandStringList(v.(*schema.Set).List())
+	}
+	included := func(legacyType string) bool {
+		for _, t := range legacyTypes {
+			if t == legacyType {
+				return true
+			}
+		}
+		return false
+	}
+
+	m := &cisMigration{}
+
+	// The legacy firewall allows the requests of the allowlisted IP addresses before any other check, so the
+	// allowlist rules are first
+	var accessRules []cisaccessrulev1.ZoneAccessRuleObject
+	if included(cisRulesetMigrationTypeAccessRules) {
+		rules, err := cisRulesetMigrationAccessRules(meta, crn, zoneID)
+		if err != nil {
+			return err
+		}
+		accessRules = rules
+		cisMigrateAccessRules(m, accessRules, true)
+	}
+	if included(cisRulesetMigrationTypeLockdowns) {
+		lockdowns, err := cisRulesetMigrationLockdowns(meta, crn, zoneID)
+		if err != nil {
+			return err
+		}
+		cisMigrateLockdowns(m, lockdowns)
+	}
+	if included(cisRulesetMigrationTypeUARules) {
+		uaRules, err := cisRulesetMigrationUARules(meta, crn, zoneID)
+		if err != nil {
+			return err
+		}
+		cisMigrateUARules(m, uaRules)
+	}
+	cisMigrateAccessRules(m, accessRules, false)
+
+	// The rules which turn off the WAF for some page rules must be before the rules which execute the managed rulesets
+	if included(cisRulesetMigrationTypePageRules) {
+		pageRules, err := cisRulesetMigrationPageRules(meta, crn, zoneID)
+		if err != nil {
+			return err
+		}
+		cisMigratePageRules(m, pageRules)
+	}
+	if included(cisRulesetMigrationTypeWAF) {
+		if err := cisRulesetMigrationWAF(m, meta, crn, zoneID); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(dataSourceIBMCISRulesetMigrationID(d))
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisRulesetMigrationRules, flattenCISMigrationRules(m.Rules))
+	d.Set(cisRulesetMigrationUnmapped, flattenCISMigrationUnmapped(m.Unmapped))
+	return nil
+}
+
+func dataSourceIBMCISRulesetMigrationID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
+
+func cisRulesetMigrationPageRules(meta interface{}, crn, zoneID string) ([]cispagerulev1.PageRuleResult, error) {
+	sess, err := meta.(conns.ClientSession).CisPageRuleClientSession()
+	if err != nil {
+		return nil, err
+	}
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneID = core.StringPtr(zoneID)
+
+	result, resp, err := sess.ListPageRules(sess.NewListPageRulesOptions())
+	if err != nil {
+		log.Printf("Error listing page rules detail: %s", resp)
+		return nil, err
+	}
+	return result.Result, nil
+}
+
+func cisRulesetMigrationAccessRules(meta interface{}, crn, zoneID string) ([]cisaccessrulev1.ZoneAccessRuleObject, error) {
+	cisClient, err := meta.(conns.ClientSession).CisAccessRuleClientSession()
+	if err != nil {
+		return nil, err
+	}
+	cisClient.Crn = core.StringPtr(crn)
+	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
+
+	rules := []cisaccessrulev1.ZoneAccessRuleObject{}
+	for page := int64(1); ; page++ {
+		opt := cisClient.NewListAllZoneAccessRulesOptions()
+		opt.SetPage(page)
+		opt.SetPerPage(cisRulesetMigrationPageSize)
+		result, response, err := cisClient.ListAllZoneAccessRules(opt)
+		if err != nil {
+			log.Printf("List all zone access rules failed: %v", response)
+			return nil, err
+		}
+		rules = append(rules, result.Result...)
+		if len(result.Result) == 0 || result.ResultInfo == nil || result.ResultInfo.TotalCount == nil ||
+			int64(len(rules)) >= *result.ResultInfo.TotalCount {
+			return rules, nil
+		}
+	}
+}
+
+func cisRulesetMigrationLockdowns(meta interface{}, crn, zoneID string) ([]cislockdownv1.LockdownObject, error) {
+	cisClient, err := meta.(conns.ClientSession).CisLockdownClientSession()
+	if err != nil {
+		return nil, err
+	}
+	cisClient.Crn = core.StringPtr(crn)
+	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
+
+	lockdowns := []cislockdownv1.LockdownObject{}
+	for page := int64(1); ; page++ {
+		opt := cisClient.NewListAllZoneLockownRulesOptions()
+		opt.SetPage(page)
+		opt.SetPerPage(cisRulesetMigrationPageSize)
+		result, response, err := cisClient.ListAllZoneLockownRules(opt)
+		if err != nil {
+			log.Printf("List all zone lockdown rules failed: %v", response)
+			return nil, err
+		}
+		lockdowns = append(lockdowns, result.Result...)
+		if len(result.Result) == 0 || result.ResultInfo == nil || result.ResultInfo.TotalCount == nil ||
+			int64(len(lockdowns)) >= *result.ResultInfo.TotalCount {
+			return lockdowns, nil
+		}
+	}
+}
+
+func cisRulesetMigrationUARules(meta interface{}, crn, zoneID string) ([]cisuarulev1.UseragentRuleObject, error) {
+	cisClient, err := meta.(conns.ClientSession).CisUARuleClientSession()
+	if err != nil {
+		return nil, err
+	}
+	cisClient.Crn = core.StringPtr(crn)
+	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
+
+	rules := []cisuarulev1.UseragentRuleObject{}
+	for page := int64(1); ; page++ {
+		opt := cisClient.NewListAllZoneUserAgentRulesOptions()
+		opt.SetPage(page)
+		opt.SetPerPage(cisRulesetMigrationPageSize)
+		result, response, err := cisClient.ListAllZoneUserAgentRules(opt)
+		if err != nil {
+			log.Printf("List all zone ua rules failed: %v", response)
+			return nil, err
+		}
+		rules = append(rules, result.Result...)
+		if len(result.Result) == 0 || result.ResultInfo == nil || result.ResultInfo.TotalCount == nil ||
+			int64(len(rules)) >= *result.ResultInfo.TotalCount {
+			return rules, nil
+		}
+	}
+}
+
+// cisRulesetMigrationWAF migrates the WAF rule packages of the domain to rules which execute the managed rulesets.
+// The groups and the rules of the legacy packages do not exist in the managed rulesets, so their changes are
+// reported as unmapped.
+func cisRulesetMigrationWAF(m *cisMigration, meta interface{}, crn, zoneID string) error {
+	settingsClient, err := meta.(conns.ClientSession).CisDomainSettingsClientSession()
+	if err != nil {
+		return err
+	}
+	settingsClient.Crn = core.StringPtr(crn)
+	settingsClient.ZoneIdentifier = core.StringPtr(zoneID)
+	waf, resp, err := settingsClient.GetWebApplicationFirewall(settingsClient.NewGetWebApplicationFirewallOptions())
+	if err != nil {
+		log.Printf("Get web application firewall setting failed: %v", resp)
+		return err
+	}
+	if waf.Result == nil || waf.Result.Value == nil || *waf.Result.Value != "on" {
+		return nil
+	}
+
+	rulesetsSess, err := meta.(conns.ClientSession).CisRulesetsSession()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while getting the CisRulesetsSession %s", err)
+	}
+	rulesetsSess.Crn = core.StringPtr(crn)
+	rulesetsSess.ZoneIdentifier = core.StringPtr(zoneID)
+	rulesets, resp, err := rulesetsSess.GetZoneRulesets(rulesetsSess.NewGetZoneRulesetsOptions())
+	if err != nil {
+		log.Printf("[WARN] List all zone rulesets failed: %v\n", resp)
+		return err
+	}
+	var managedID, owaspID string
+	for _, ruleset := range rulesets.Result {
+		if ruleset.Kind == nil || *ruleset.Kind != "managed" || ruleset.Phase == nil || *ruleset.Phase != cisRulesetPhaseFirewallManaged {
+			continue
+		}
+		if strings.Contains(strings.ToLower(*ruleset.Name), "owasp") {
+			owaspID = *ruleset.ID
+		} else if managedID == "" {
+			managedID = *ruleset.ID
+		}
+	}
+
+	packageClient, err := meta.(conns.ClientSession).CisWAFPackageClientSession()
+	if err != nil {
+		return err
+	}
+	packageClient.Crn = core.StringPtr(crn)
+	packageClient.ZoneID = core.StringPtr(zoneID)
+	packages, resp, err := packageClient.ListWafPackages(packageClient.NewListWafPackagesOptions())
+	if err != nil {
+		log.Printf("Error listing waf packages detail: %s", resp)
+		return err
+	}
+
+	groupClient, err := meta.(conns.ClientSession).CisWAFGroupClientSession()
+	if err != nil {
+		return err
+	}
+	groupClient.Crn = core.StringPtr(crn)
+	groupClient.ZoneID = core.StringPtr(zoneID)
+
+	ruleClient, err := meta.(conns.ClientSession).CisWAFRuleClientSession()
+	if err != nil {
+		return err
+	}
+	ruleClient.Crn = core.StringPtr(crn)
+	ruleClient.ZoneID = core.StringPtr(zoneID)
+
+	for _, pkg := range packages.Result {
+		packageID := *pkg.ID
+		owasp := pkg.DetectionMode != nil && *pkg.DetectionMode == "anomaly"
+		rulesetID := managedID
+		if owasp {
+			rulesetID = owaspID
+		}
+		if rulesetID == "" {
+			m.unmapped(cisRulesetMigrationSourceWAFPackage, packageID, "package",
+				"No managed ruleset replaces the package")
+			continue
+		}
+
+		actionParameters := map[string]interface{}{"id": rulesetID}
+		if owasp {
+			detail, resp, err := packageClient.GetWafPackage(packageClient.NewGetWafPackageOptions(packageID))
+			if err != nil {
+				log.Printf("Get waf package failed: %v", resp)
+				return err
+			}
+			if detail.Result.ActionMode != nil {
+				// The OWASP package simulates, blocks or challenges the requests with a high anomaly score
+				action := *detail.Result.ActionMode
+				if action == "simulate" {
+					action = "log"
+				}
+				actionParameters["overrides"] = map[string]interface{}{"action": action}
+			}
+			if detail.Result.Sensitivity != nil && *detail.Result.Sensitivity != "high" {
+				m.unmapped(cisRulesetMigrationSourceWAFPackage, packageID, "sensitivity",
+					fmt.Sprintf("The sensitivity %s has no equivalent; set the anomaly score threshold of the managed ruleset", *detail.Result.Sensitivity))
+			}
+		}
+		description := fmt.Sprintf("WAF package %s", packageID)
+		if pkg.Name != nil {
+			description = fmt.Sprintf("WAF package %s", *pkg.Name)
+		}
+		m.rule(cisMigrationRule{
+			Phase:            cisRulesetPhaseFirewallManaged,
+			Ref:              "waf_package_" + packageID,
+			Description:      description,
+			Expression:       "true",
+			Action:           "execute",
+			ActionParameters: actionParameters,
+			Enabled:          true,
+			Source:           cisRulesetMigrationSourceWAFPackage,
+			SourceID:         packageID,
+		})
+
+		groupOpt := groupClient.NewListWafRuleGroupsOptions(packageID)
+		groupOpt.SetPage(1)
+		groupOpt.SetPerPage(100)
+		groups, resp, err := groupClient.ListWafRuleGroups(groupOpt)
+		if err != nil {
+			log.Printf("List waf rule groups failed: %s\n", resp)
+			return err
+		}
+		for _, group := range groups.Result {
+			if group.Mode != nil && *group.Mode == "off" {
+				m.unmapped(cisRulesetMigrationSourceWAFGroup, *group.ID, "mode",
+					fmt.Sprintf("The group %s is off; the groups of the packages do not exist in the managed rulesets", flex.StringValue(group.Name)))
+			}
+		}
+
+		for page := int64(1); ; page++ {
+			ruleOpt := ruleClient.NewListWafRulesOptions(packageID)
+			ruleOpt.SetPage(page)
+			ruleOpt.SetPerPage(cisRulesetMigrationPageSize)
+			rules, resp, err := ruleClient.ListWafRules(ruleOpt)
+			if err != nil {
+				log.Printf("List waf rules failed %s\n", resp)
+				return err
+			}
+			for _, rule := range rules.Result {
+				if rule.Mode != nil && *rule.Mode != "default" && *rule.Mode != "on" {
+					m.unmapped(cisRulesetMigrationSourceWAFRule, *rule.ID, "mode",
+						fmt.Sprintf("The mode of the rule %q is %s; the rules of the packages do not exist in the managed rulesets",
+							flex.StringValue(rule.Description), *rule.Mode))
+				}
+			}
+			if len(rules.Result) == 0 || rules.ResultInfo == nil || rules.ResultInfo.Page == nil ||
+				rules.ResultInfo.TotalCount == nil || *rules.ResultInfo.Page*cisRulesetMigrationPageSize >= *rules.ResultInfo.TotalCount {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// cisMigrationQuote quotes a string of the rules language
+func cisMigrationQuote(value string) string {
+	return `"` + strings.ReplaceAll(strings.ReplaceAll(value, `\`, `\\`), `"`, `\"`) + `"`
+}
+
+// cisMigrationURLPattern returns the wildcard pattern of the full URI for the URL pattern of a page rule or a
+// lockdown, which may have no scheme and no path
+func cisMigrationURLPattern(pattern string) string {
+	pattern = strings.TrimSpace(pattern)
+	rest := pattern
+	if i := strings.Index(pattern, "://"); i >= 0 {
+		rest = pattern[i+3:]
+	} else if !strings.HasPrefix(pattern, "*") {
+		pattern = "http*://" + pattern
+	}
+	if !strings.Contains(rest, "/") && !strings.HasSuffix(rest, "*") {
+		pattern += "/"
+	}
+	return pattern
+}
+
+func cisMigrationURLExpression(pattern string) string {
+	return "http.request.full_uri wildcard " + cisMigrationQuote(cisMigrationURLPattern(pattern))
+}
+
+var cisMigrationURLReference = regexp.MustCompile(`\$([0-9])`)
+
+// cisMigrationOn returns whether the value of a page rule action is on
+func cisMigrationOn(value interface{}) (bool, bool) {
+	switch fmt.Sprintf("%v", value) {
+	case "on":
+		return true, true
+	case "off":
+		return false, true
+	}
+	return false, false
+}
+
+// cisMigrationInt returns the number of a page rule action
+func cisMigrationInt(value interface{}) (int64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return int64(v), true
+	case int64:
+		return v, true
+	case int:
+		return int64(v), true
+	case string:
+		i, err := strconv.ParseInt(v, 10, 64)
+		return i, err == nil
+	}
+	return 0, false
+}
+
+// cisMigrationMerge merges the source map in the destination map, recursively
+func cisMigrationMerge(dst, src map[string]interface{}) {
+	for key, value := range src {
+		if srcMap, ok := value.(map[string]interface{}); ok {
+			if dstMap, ok := dst[key].(map[string]interface{}); ok {
+				cisMigrationMerge(dstMap, srcMap)
+				continue
+			}
+		}
+		dst[key] = value
+	}
+}
+
+// cisMigratePageRules migrates the page rules. All the matching rules of a phase apply and the last one wins, while
+// only the page rule with the highest priority applies, so the rules are sorted by ascending priority. In the
+// redirect phase the first matching rule wins, so the redirects are sorted by descending priority.
+func cisMigratePageRules(m *cisMigration, pageRules []cispagerulev1.PageRuleResult) {
+	sort.SliceStable(pageRules, func(i, j int) bool {
+		return flex.IntValue(pageRules[i].Priority) < flex.IntValue(pageRules[j].Priority)
+	})
+	redirects := &cisMigration{}
+	for _, pageRule := range pageRules {
+		cisMigratePageRule(m, redirects, pageRule)
+	}
+	for i := len(redirects.Rules) - 1; i >= 0; i-- {
+		m.rule(redirects.Rules[i])
+	}
+}
+
+func cisMigratePageRule(m, redirects *cisMigration, pageRule cispagerulev1.PageRuleResult) {
+	id := *pageRule.ID
+	if len(pageRule.Targets) != 1 || pageRule.Targets[0].Constraint == nil || pageRule.Targets[0].Target == nil ||
+		*pageRule.Targets[0].Target != "url" || flex.StringValue(pageRule.Targets[0].Constraint.Operator) != "matches" {
+		m.unmapped(cisRulesetMigrationSourcePageRule, id, cisPageRuleTargets, "Only the targets which match a URL are supported")
+		return
+	}
+	pattern := flex.StringValue(pageRule.Targets[0].Constraint.Value)
+	expression := cisMigrationURLExpression(pattern)
+	enabled := flex.StringValue(pageRule.Status) == "active"
+	description := fmt.Sprintf("Page rule %s", pattern)
+
+	cache := map[string]interface{}{}
+	config := map[string]interface{}{}
+	origin := map[string]interface{}{}
+	newRule := func(phase, suffix, expression, action string, actionParameters map[string]interface{}) cisMigrationRule {
+		return cisMigrationRule{
+			Phase:            phase,
+			Ref:              fmt.Sprintf("page_rule_%s_%s", id, suffix),
+			Description:      description,
+			Expression:       expression,
+			Action:           action,
+			ActionParameters: actionParameters,
+			Enabled:          enabled,
+			Source:           cisRulesetMigrationSourcePageRule,
+			SourceID:         id,
+		}
+	}
+	unmapped := func(setting, reason string) {
+		m.unmapped(cisRulesetMigrationSourcePageRule, id, setting, reason)
+	}
+
+	for _, item := range pageRule.Actions {
+		action, ok := item.(*cispagerulev1.PageRulesBodyActionsItem)
+		if !ok || action.ID == nil {
+			continue
+		}
+		value := action.Value
+		on, isOnOff := cisMigrationOn(value)
+		switch *action.ID {
+		case cisPageRuleActionsIDForwardingURL:
+			forward, _ := value.(map[string]interface{})
+			url, _ := forward[cisPageRuleActionsValueURL].(string)
+			statusCode, ok := cisMigrationInt(forward[cisPageRuleActionsValueStatusCode])
+			if url == "" || !ok {
+				unmapped(*action.ID, "The forwarding URL has no URL or status code")
+				continue
+			}
+			targetURL := map[string]interface{}{"value": url}
+			if cisMigrationURLReference.MatchString(url) {
+				replacement := cisMigrationURLReference.ReplaceAllStringFunc(url, func(ref string) string {
+					return "${" + ref[1:] + "}"
+				})
+				targetURL = map[string]interface{}{
+					"expression": fmt.Sprintf("wildcard_replace(http.request.full_uri, %s, %s)",
+						cisMigrationQuote(cisMigrationURLPattern(pattern)), cisMigrationQuote(replacement)),
+				}
+			}
+			redirects.rule(newRule(cisRulesetPhaseDynamicRedirect, "redirect", expression, "redirect", map[string]interface{}{
+				"from_value": map[string]interface{}{
+					"status_code":           statusCode,
+					"target_url":            targetURL,
+					"preserve_query_string": true,
+				},
+			}))
+		case cisPageRuleActionsIDAlwaysUseHTTPS:
+			redirects.rule(newRule(cisRulesetPhaseDynamicRedirect, "https", fmt.Sprintf("(%s) and not ssl", expression), "redirect", map[string]interface{}{
+				"from_value": map[string]interface{}{
+					"status_code": 301,
+					"target_url": map[string]interface{}{
+						"expression": `concat("https://", http.host, http.request.uri.path)`,
+					},
+					"preserve_query_string": true,
+				},
+			}))
+		case cisPageRuleActionsIDDisableSecurity:
+			unmapped(*action.ID, "The security features have separate settings; turn off each one")
+		case "waf":
+			if !isOnOff {
+				unmapped(*action.ID, "Unknown value")
+			} else if !on {
+				m.rule(newRule(cisRulesetPhaseFirewallManaged, "waf", expression, "skip", map[string]interface{}{
+					"ruleset": "current",
+				}))
+			}
+		case "cache_level":
+			switch fmt.Sprintf("%v", value) {
+			case "bypass":
+				cache["cache"] = false
+			case "cache_everything", "aggressive":
+				cache["cache"] = true
+			case "simplified":
+				cisMigrationMerge(cache, map[string]interface{}{
+					"cache": true,
+					"cache_key": map[string]interface{}{
+						"custom_key": map[string]interface{}{
+							"query_string": map[string]interface{}{"exclude": map[string]interface{}{"all": true}},
+						},
+					},
+				})
+			default:
+				unmapped(*action.ID, fmt.Sprintf("The cache level %v has no equivalent", value))
+			}
+		case cisPageRuleActionsIDEdgeCacheTTL:
+			ttl, ok := cisMigrationInt(value)
+			if !ok {
+				unmapped(*action.ID, "Unknown value")
+				continue
+			}
+			cache["edge_ttl"] = map[string]interface{}{"mode": "override_origin", "default": ttl}
+		case cisPageRuleActionsIDBrowserCacheTTL:
+			ttl, ok := cisMigrationInt(value)
+			if !ok {
+				unmapped(*action.ID, "Unknown value")
+			} else if ttl == 0 {
+				cache["browser_ttl"] = map[string]interface{}{"mode": "respect_origin"}
+			} else {
+				cache["browser_ttl"] = map[string]interface{}{"mode": "override_origin", "default": ttl}
+			}
+		case "cache_deception_armor":
+			if isOnOff {
+				cisMigrationMerge(cache, map[string]interface{}{"cache_key": map[string]interface{}{"cache_deception_armor": on}})
+			}
+		case "sort_query_string_for_cache":
+			if isOnOff {
+				cisMigrationMerge(cache, map[string]interface{}{"cache_key": map[string]interface{}{"ignore_query_strings_order": on}})
+			}
+		case "respect_strong_etag":
+			if isOnOff {
+				cache["respect_strong_etags"] = on
+			}
+		case "origin_error_page_pass_thru":
+			if isOnOff {
+				cache["origin_error_page_passthru"] = on
+			}
+		case "explicit_cache_control":
+			if isOnOff {
+				cache["origin_cache_control"] = on
+			}
+		case "automatic_https_rewrites", "email_obfuscation", "opportunistic_encryption":
+			if isOnOff {
+				config[*action.ID] = on
+			}
+		case "server_side_exclude":
+			if isOnOff {
+				config["server_side_excludes"] = on
+			}
+		case "browser_check":
+			if isOnOff {
+				config["bic"] = on
+			}
+		case "disable_apps":
+			config["disable_apps"] = true
+		case "security_level", "ssl":
+			config[*action.ID] = fmt.Sprintf("%v", value)
+		case cisPageRuleActionsIDMinify:
+			minify, _ := value.(map[string]interface{})
+			autominify := map[string]interface{}{}
+			for _, key := range []string{cisPageRuleActionsMinifyCSS, cisPageRuleActionsMinifyHTML, cisPageRuleActionsMinifyJS} {
+				if on, ok := cisMigrationOn(minify[key]); ok {
+					autominify[key] = on
+				}
+			}
+			config["autominify"] = autominify
+		case "host_header_override":
+			origin["host_header"] = fmt.Sprintf("%v", value)
+		case "resolve_override":
+			origin["origin"] = map[string]interface{}{"host": fmt.Sprintf("%v", value)}
+		default:
+			unmapped(*action.ID, "The setting has no equivalent rule")
+		}
+	}
+
+	if len(origin) > 0 {
+		m.rule(newRule(cisRulesetPhaseOrigin, "origin", expression, "route", origin))
+	}
+	if len(config) > 0 {
+		m.rule(newRule(cisRulesetPhaseConfigSettings, "config", expression, "set_config", config))
+	}
+	if len(cache) > 0 {
+		m.rule(newRule(cisRulesetPhaseCacheSettings, "cache", expression, "set_cache_settings", cache))
+	}
+}
+
+// cisMigrateAccessRules migrates either the allowlist access rules, or the other access rules
+func cisMigrateAccessRules(m *cisMigration, accessRules []cisaccessrulev1.ZoneAccessRuleObject, allowlist bool) {
+	for _, accessRule := range accessRules {
+		id := *accessRule.ID
+		mode := flex.StringValue(accessRule.Mode)
+		if (mode == cisFirewallAccessRuleModeWhitelist) != allowlist {
+			continue
+		}
+		if accessRule.Scope != nil && flex.StringValue(accessRule.Scope.Type) != "zone" {
+			m.unmapped(cisRulesetMigrationSourceAccessRule, id, "scope",
+				"The access rule is inherited from the instance and applies to all the domains")
+			continue
+		}
+		if accessRule.Configuration == nil {
+			continue
+		}
+		target := flex.StringValue(accessRule.Configuration.Target)
+		value := flex.StringValue(accessRule.Configuration.Value)
+		var expression string
+		switch target {
+		case cisFirewallLockdownConfigurationsTargetIP:
+			expression = "ip.src eq " + value
+		case cisFirewallLockdownConfigurationsTargetIPRange:
+			expression = "ip.src in {" + value + "}"
+		case "asn":
+			asn, err := strconv.ParseInt(strings.TrimPrefix(strings.ToUpper(value), "AS"), 10, 64)
+			if err != nil {
+				m.unmapped(cisRulesetMigrationSourceAccessRule, id, cisFirewallAccessRuleConfiguration,
+					fmt.Sprintf("The ASN %s is not valid", value))
+				continue
+			}
+			expression = fmt.Sprintf("ip.geoip.asnum eq %d", asn)
+		case "country":
+			expression = "ip.geoip.country eq " + cisMigrationQuote(value)
+		default:
+			m.unmapped(cisRulesetMigrationSourceAccessRule, id, cisFirewallAccessRuleConfiguration,
+				fmt.Sprintf("The target %s has no equivalent expression", target))
+			continue
+		}
+
+		rule := cisMigrationRule{
+			Phase:       cisRulesetPhaseFirewallCustom,
+			Ref:         "access_rule_" + id,
+			Description: flex.StringValue(accessRule.Notes),
+			Expression:  expression,
+			Action:      mode,
+			Enabled:     true,
+			Source:      cisRulesetMigrationSourceAccessRule,
+			SourceID:    id,
+		}
+		if rule.Description == "" {
+			rule.Description = fmt.Sprintf("Access rule %s %s", target, value)
+		}
+		if allowlist {
+			// The allowed requests skip the other custom rules and the managed rules
+			rule.Action = "skip"
+			rule.ActionParameters = map[string]interface{}{
+				"ruleset": "current",
+				"phases":  []interface{}{cisRulesetPhaseFirewallManaged},
+			}
+		}
+		m.rule(rule)
+	}
+}
+
+func cisMigrateLockdowns(m *cisMigration, lockdowns []cislockdownv1.LockdownObject) {
+	sort.SliceStable(lockdowns, func(i, j int) bool {
+		return flex.IntValue(lockdowns[i].Priority) < flex.IntValue(lockdowns[j].Priority)
+	})
+	for _, lockdown := range lockdowns {
+		id := *lockdown.ID
+		urls := make([]string, 0, len(lockdown.Urls))
+		for _, url := range lockdown.Urls {
+			urls = append(urls, cisMigrationURLExpression(url))
+		}
+		ips := make([]string, 0, len(lockdown.Configurations))
+		for _, configuration := range lockdown.Configurations {
+			ips = append(ips, flex.StringValue(configuration.Value))
+		}
+		if len(urls) == 0 {
+			continue
+		}
+		// The requests to the URLs are blocked, unless they come from the allowed IP addresses
+		expression := "(" + strings.Join(urls, " or ") + ")"
+		if len(ips) > 0 {
+			expression += " and not ip.src in {" + strings.Join(ips, " ") + "}"
+		}
+		description := flex.StringValue(lockdown.Description)
+		if description == "" {
+			description = fmt.Sprintf("Lockdown %s", id)
+		}
+		m.rule(cisMigrationRule{
+			Phase:       cisRulesetPhaseFirewallCustom,
+			Ref:         "lockdown_" + id,
+			Description: description,
+			Expression:  expression,
+			Action:      "block",
+			Enabled:     lockdown.Paused == nil || !*lockdown.Paused,
+			Source:      cisRulesetMigrationSourceLockdown,
+			SourceID:    id,
+		})
+	}
+}
+
+func cisMigrateUARules(m *cisMigration, uaRules []cisuarulev1.UseragentRuleObject) {
+	for _, uaRule := range uaRules {
+		id := *uaRule.ID
+		if uaRule.Configuration == nil {
+			continue
+		}
+		description := flex.StringValue(uaRule.Description)
+		if description == "" {
+			description = fmt.Sprintf("User agent rule %s", id)
+		}
+		m.rule(cisMigrationRule{
+			Phase:       cisRulesetPhaseFirewallCustom,
+			Ref:         "ua_rule_" + id,
+			Description: description,
+			Expression:  "http.user_agent eq " + cisMigrationQuote(flex.StringValue(uaRule.Configuration.Value)),
+			Action:      flex.StringValue(uaRule.Mode),
+			Enabled:     uaRule.Paused == nil || !*uaRule.Paused,
+			Source:      cisRulesetMigrationSourceUARule,
+			SourceID:    id,
+		})
+	}
+}
+
+// flattenCISMigrationRules flattens the rules, grouped by phase in the order of the phases
+func flattenCISMigrationRules(rules []cisMigrationRule) []interface{} {
+	phaseIndex := map[string]int{}
+	for i, phase := range cisRulesetMigrationPhaseOrder {
+		phaseIndex[phase] = i
+	}
+	sorted := make([]cisMigrationRule, len(rules))
+	copy(sorted, rules)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return phaseIndex[sorted[i].Phase] < phaseIndex[sorted[j].Phase]
+	})
+
+	rulesOutput := make([]interface{}, 0, len(sorted))
+	for _, rule := range sorted {
+		actionParameters := ""
+		if len(rule.ActionParameters) > 0 {
+			data, err := json.Marshal(rule.ActionParameters)
+			if err != nil {
+				log.Printf("[WARN] Error encoding the action parameters of the rule %s: %s", rule.Ref, err)
+				continue
+			}
+			actionParameters = string(data)
+		}
+		rulesOutput = append(rulesOutput, map[string]interface{}{
+			cisRulesetMigrationRulePhase:            rule.Phase,
+			cisRulesetMigrationRuleRef:              rule.Ref,
+			cisRulesetMigrationRuleDescription:      rule.Description,
+			cisRulesetMigrationRuleExpression:       rule.Expression,
+			cisRulesetMigrationRuleAction:           rule.Action,
+			cisRulesetMigrationRuleActionParameters: actionParameters,
+			cisRulesetMigrationRuleEnabled:          rule.Enabled,
+			cisRulesetMigrationRuleSource:           rule.Source,
+			cisRulesetMigrationRuleSourceID:         rule.SourceID,
+		})
+	}
+	return rulesOutput
+}
+
+func flattenCISMigrationUnmapped(unmapped []cisMigrationUnmapped) []interface{} {
+	unmappedOutput := make([]interface{}, 0, len(unmapped))
+	for _, u := range unmapped {
+		unmappedOutput = append(unmappedOutput, map[string]interface{}{
+			cisRulesetMigrationRuleSource:      u.Source,
+			cisRulesetMigrationRuleSourceID:    u.SourceID,
+			cisRulesetMigrationUnmappedSetting: u.Setting,
+			cisRulesetMigrationUnmappedReason:  u.Reason,
+		})
+	}
+	return unmappedOutput
+}
+
+// expandCISMigrationRules expands the rules of the schema
+func expandCISMigrationRules(rules []interface{}) ([]cisMigrationRule, error) {
+	result := make([]cisMigrationRule, 0, len(rules))
+	for _, r := range rules {
+		ruleMap, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rule := cisMigrationRule{
+			Phase:       ruleMap[cisRulesetMigrationRulePhase].(string),
+			Ref:         ruleMap[cisRulesetMigrationRuleRef].(string),
+			Description: ruleMap[cisRulesetMigrationRuleDescription].(string),
+			Expression:  ruleMap[cisRulesetMigrationRuleExpression].(string),
+			Action:      ruleMap[cisRulesetMigrationRuleAction].(string),
+			Enabled:     ruleMap[cisRulesetMigrationRuleEnabled].(bool),
+			Source:      ruleMap[cisRulesetMigrationRuleSource].(string),
+			SourceID:    ruleMap[cisRulesetMigrationRuleSourceID].(string),
+		}
+		if actionParameters := ruleMap[cisRulesetMigrationRuleActionParameters].(string); actionParameters != "" {
+			if err := json.Unmarshal([]byte(actionParameters), &rule.ActionParameters); err != nil {
+				return nil, fmt.Errorf("[ERROR] Error decoding the action parameters of the rule %s: %s", rule.Ref, err)
+			}
+		}
+		result = append(result, rule)
+	}
+	return result, nil
+}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisRulesetMigrationDataSource_basic(t *testing.T) {
+	node := "data.ibm_cis_ruleset_migration.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCisRulesetMigrationDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "rules.0.phase", "http_request_dynamic_redirect"),
+					resource.TestCheckResourceAttr(node, "rules.0.action", "redirect"),
+					resource.TestCheckResourceAttr(node, "rules.0.source", "page_rule"),
+					resource.TestCheckResourceAttrPair(node, "rules.0.source_id", "ibm_cis_page_rule.page_rule", "rule_id"),
+					resource.TestCheckResourceAttrSet(node, "rules.0.expression"),
+					resource.TestCheckResourceAttrSet(node, "rules.0.action_parameters"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCisRulesetMigrationDataSourceConfig() string {
+	return testAccCheckIBMCisPageRuleConfigBasic() + `
+	data "ibm_cis_ruleset_migration" "test" {
+		cis_id       = ibm_cis_page_rule.page_rule.cis_id
+		domain_id    = ibm_cis_page_rule.page_rule.domain_id
+		legacy_types = ["page_rules"]
+	}`
+}
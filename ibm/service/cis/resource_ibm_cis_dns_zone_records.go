@@ -0,0 +1,552 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	cisdnsrecordsv1 "github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	cisDNSZoneRecordsZoneFile        = "zone_file"
+	cisDNSZoneRecordsRecord          = "record"
+	cisDNSZoneRecordsIgnoredTypes    = "ignored_types"
+	cisDNSZoneRecordsBatchSize       = "batch_size"
+	cisDNSZoneRecordsRecords         = "records"
+	cisDNSZoneRecordsRecordsToCreate = "records_to_create"
+	cisDNSZoneRecordsRecordsToUpdate = "records_to_update"
+	cisDNSZoneRecordsRecordsToDelete = "records_to_delete"
+
+	// A TTL of 1 is automatic
+	cisDNSZoneRecordsDefaultTTL = 1
+)
+
+var cisDNSZoneRecordsDefaultIgnoredTypes = []string{"SOA", "NS"}
+
+var cisDNSZoneRecordsSupportedTypes = []string{
+	cisDNSRecordTypeA, cisDNSRecordTypeAAAA, cisDNSRecordTypeCAA, cisDNSRecordTypeCNAME, cisDNSRecordTypeMX,
+	cisDNSRecordTypeNS, cisDNSRecordTypePTR, cisDNSRecordTypeSPF, cisDNSRecordTypeSRV, cisDNSRecordTypeTXT,
+}
+
+func ResourceIBMCISDNSZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCISDNSZoneRecordsCreate,
+		Read:     resourceIBMCISDNSZoneRecordsRead,
+		Update:   resourceIBMCISDNSZoneRecordsUpdate,
+		Delete:   resourceIBMCISDNSZoneRecordsDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: resourceIBMCISDNSZoneRecordsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "CIS instance crn",
+				ValidateFunc: validate.InvokeValidator("ibm_cis_dns_zone_records",
+					cisID),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+				Description:      "Associated CIS domain",
+			},
+			cisZoneName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the zone",
+			},
+			cisDNSZoneRecordsZoneFile: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{cisDNSZoneRecordsZoneFile, cisDNSZoneRecordsRecord},
+				Description:  "Records of the zone in BIND zone file format. Relative names are relative to the zone.",
+			},
+			cisDNSZoneRecordsRecord: {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ExactlyOneOf: []string{cisDNSZoneRecordsZoneFile, cisDNSZoneRecordsRecord},
+				Description:  "Records of the zone",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the record, relative to the zone, or fully qualified with a trailing dot. `@` is the zone.",
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(cisDNSZoneRecordsSupportedTypes, false),
+							Description:  "Type of the record",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "TTL of the record. Defaults to automatic.",
+						},
+						"data": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Data of the record in zone file format, such as `10 mail` for a MX record",
+						},
+					},
+				},
+			},
+			cisDNSZoneRecordsIgnoredTypes: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Types of the records which are not managed. Defaults to SOA and NS.",
+			},
+			cisDNSZoneRecordsBatchSize: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 50),
+				Description:  "Number of records which are changed at the same time",
+			},
+			cisDNSZoneRecordsRecords: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Managed records of the zone",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS record id",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Fully qualified name of the record",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the record",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "TTL of the record",
+						},
+						"data": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Data of the record in zone file format",
+						},
+					},
+				},
+			},
+			cisDNSZoneRecordsRecordsToCreate: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of records created by the last change",
+			},
+			cisDNSZoneRecordsRecordsToUpdate: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of records updated by the last change",
+			},
+			cisDNSZoneRecordsRecordsToDelete: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of records deleted by the last change",
+			},
+		},
+	}
+}
+
+func ResourceIBMCISDNSZoneRecordsValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisID,
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+	ibmCISDNSZoneRecordsValidator := validate.ResourceValidator{
+		ResourceName: "ibm_cis_dns_zone_records",
+		Schema:       validateSchema}
+	return &ibmCISDNSZoneRecordsValidator
+}
+
+// resourceIBMCISDNSZoneRecordsCustomizeDiff shows the number of records to change, by comparing the desired records
+// with the records refreshed in the state
+func resourceIBMCISDNSZoneRecordsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	zoneName := diff.Get(cisZoneName).(string)
+	if !diff.NewValueKnown(cisDNSZoneRecordsZoneFile) || !diff.NewValueKnown(cisDNSZoneRecordsRecord) {
+		return nil
+	}
+	desired, err := cisDNSZoneRecordsDesired(diff, zoneName)
+	if err != nil {
+		return err
+	}
+	if diff.Id() == "" || zoneName == "" {
+		return nil
+	}
+
+	live := flex.ExpandZoneRecordsState(diff.Get(cisDNSZoneRecordsRecords).([]interface{}))
+	changes := flex.DiffZoneRecords(desired, flex.FilterZoneRecords(live, cisDNSZoneRecordsIgnored(diff)), cisDNSZoneRecordsDefaultTTL)
+	if changes.Empty() {
+		return nil
+	}
+	for attribute, count := range map[string]int{
+		cisDNSZoneRecordsRecordsToCreate: len(changes.Create),
+		cisDNSZoneRecordsRecordsToUpdate: len(changes.Update),
+		cisDNSZoneRecordsRecordsToDelete: len(changes.Delete),
+	} {
+		if err := diff.SetNew(attribute, count); err != nil {
+			return err
+		}
+	}
+	return diff.SetNewComputed(cisDNSZoneRecordsRecords)
+}
+
+type cisDNSZoneRecordsGetter interface {
+	Get(string) interface{}
+}
+
+// cisDNSZoneRecordsDesired returns the desired records, without the ignored types. Their name is not checked when the
+// zone name is not known yet.
+func cisDNSZoneRecordsDesired(d cisDNSZoneRecordsGetter, zoneName string) ([]flex.ZoneRecord, error) {
+	records, err := flex.ExpandZoneRecords(d.Get(cisDNSZoneRecordsZoneFile).(string), d.Get(cisDNSZoneRecordsRecord).(*schema.Set), zoneName)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing the records of zone %s: %s", zoneName, err)
+	}
+	records = flex.FilterZoneRecords(records, cisDNSZoneRecordsIgnored(d))
+	for _, record := range records {
+		supported := false
+		for _, recordType := range cisDNSZoneRecordsSupportedTypes {
+			supported = supported || record.Type == recordType
+		}
+		if !supported {
+			return nil, fmt.Errorf("[ERROR] Record %s has the unsupported type %s", record.Name, record.Type)
+		}
+		if zoneName != "" && record.Name != zoneName && !strings.HasSuffix(record.Name, "."+zoneName) {
+			return nil, fmt.Errorf("[ERROR] Record %s is not in zone %s", record.Name, zoneName)
+		}
+	}
+	return records, nil
+}
+
+func cisDNSZoneRecordsIgnored(d cisDNSZoneRecordsGetter) []string {
+	ignoredTypes := flex.ExpandStringList(d.Get(cisDNSZoneRecordsIgnoredTypes).(*schema.Set).List())
+	if len(ignoredTypes) == 0 {
+		return cisDNSZoneRecordsDefaultIgnoredTypes
+	}
+	return ignoredTypes
+}
+
+func resourceIBMCISDNSZoneRecordsCreate(d *schema.ResourceData, meta interface{}) error {
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
+
+	return resourceIBMCISDNSZoneRecordsUpdate(d, meta)
+}
+
+func resourceIBMCISDNSZoneRecordsUpdate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return err
+	}
+	zoneID, crn, _ := flex.ConvertTftoCisTwoVar(d.Id())
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	zoneName, err := cisDNSZoneName(meta, crn, zoneID)
+	if err != nil {
+		return err
+	}
+	desired, err := cisDNSZoneRecordsDesired(d, zoneName)
+	if err != nil {
+		return err
+	}
+	live, err := cisDNSZoneRecordsList(sess, zoneName)
+	if err != nil {
+		return err
+	}
+
+	changes := flex.DiffZoneRecords(desired, flex.FilterZoneRecords(live, cisDNSZoneRecordsIgnored(d)), cisDNSZoneRecordsDefaultTTL)
+	log.Printf("[INFO] Changing the records of zone %s: %d to create, %d to update, %d to delete",
+		zoneName, len(changes.Create), len(changes.Update), len(changes.Delete))
+	err = flex.ApplyZoneRecordChanges(changes, d.Get(cisDNSZoneRecordsBatchSize).(int),
+		func(record flex.ZoneRecord) error {
+			return cisDNSZoneRecordCreate(sess, record)
+		},
+		func(record flex.ZoneRecord) error {
+			return cisDNSZoneRecordUpdate(sess, record)
+		},
+		func(record flex.ZoneRecord) error {
+			return cisDNSZoneRecordDelete(sess, record)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error changing the records of zone %s: %s", zoneName, err)
+	}
+
+	if !changes.Empty() {
+		d.Set(cisDNSZoneRecordsRecordsToCreate, len(changes.Create))
+		d.Set(cisDNSZoneRecordsRecordsToUpdate, len(changes.Update))
+		d.Set(cisDNSZoneRecordsRecordsToDelete, len(changes.Delete))
+	}
+	return resourceIBMCISDNSZoneRecordsRead(d, meta)
+}
+
+func resourceIBMCISDNSZoneRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return err
+	}
+	zoneID, crn, _ := flex.ConvertTftoCisTwoVar(d.Id())
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	zoneName, err := cisDNSZoneName(meta, crn, zoneID)
+	if err != nil {
+		return err
+	}
+	live, err := cisDNSZoneRecordsList(sess, zoneName)
+	if err != nil {
+		return err
+	}
+
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisZoneName, zoneName)
+	d.Set(cisDNSZoneRecordsRecords, flex.FlattenZoneRecords(flex.FilterZoneRecords(live, cisDNSZoneRecordsIgnored(d))))
+	return nil
+}
+
+func resourceIBMCISDNSZoneRecordsDelete(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return err
+	}
+	zoneID, crn, _ := flex.ConvertTftoCisTwoVar(d.Id())
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	changes := flex.ZoneRecordChanges{
+		Delete: flex.ExpandZoneRecordsState(d.Get(cisDNSZoneRecordsRecords).([]interface{})),
+	}
+	err = flex.ApplyZoneRecordChanges(changes, d.Get(cisDNSZoneRecordsBatchSize).(int), nil, nil,
+		func(record flex.ZoneRecord) error {
+			return cisDNSZoneRecordDelete(sess, record)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error deleting the records of zone %s: %s", d.Get(cisZoneName).(string), err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func cisDNSZoneName(meta interface{}, crn, zoneID string) (string, error) {
+	cisClient, err := meta.(conns.ClientSession).CisZonesV1ClientSession()
+	if err != nil {
+		return "", err
+	}
+	cisClient.Crn = core.StringPtr(crn)
+	result, resp, err := cisClient.GetZone(cisClient.NewGetZoneOptions(zoneID))
+	if err != nil {
+		log.Printf("[WARN] Error getting zone %v\n", resp)
+		return "", err
+	}
+	return strings.ToLower(*result.Result.Name), nil
+}
+
+// cisDNSRecordsList lists all the records of the zone of the session
+func cisDNSRecordsList(sess *cisdnsrecordsv1.DnsRecordsV1) ([]cisdnsrecordsv1.DnsrecordDetails, error) {
+	var records []cisdnsrecordsv1.DnsrecordDetails
+	opt := sess.NewListAllDnsRecordsOptions()
+	opt.SetPerPage(1000)
+	for page := int64(1); ; page++ {
+		opt.SetPage(page)
+		result, response, err := sess.ListAllDnsRecords(opt)
+		if err != nil {
+			log.Printf("Error reading dns records: %s", response)
+			return nil, err
+		}
+		records = append(records, result.Result...)
+		if len(result.Result) == 0 || result.ResultInfo == nil || result.ResultInfo.TotalCount == nil ||
+			int64(len(records)) >= *result.ResultInfo.TotalCount {
+			return records, nil
+		}
+	}
+}
+
+func cisDNSZoneRecordsList(sess *cisdnsrecordsv1.DnsRecordsV1, zoneName string) ([]flex.ZoneRecord, error) {
+	records, err := cisDNSRecordsList(sess)
+	if err != nil {
+		return nil, err
+	}
+	zoneRecords := make([]flex.ZoneRecord, 0, len(records))
+	for _, record := range records {
+		zoneRecord, err := cisDNSZoneRecord(record, zoneName)
+		if err != nil {
+			return nil, err
+		}
+		zoneRecords = append(zoneRecords, zoneRecord)
+	}
+	return zoneRecords, nil
+}
+
+// cisDNSZoneRecord converts a record to the zone file format
+func cisDNSZoneRecord(record cisdnsrecordsv1.DnsrecordDetails, zoneName string) (flex.ZoneRecord, error) {
+	data := ""
+	dataMap, _ := record.Data.(map[string]interface{})
+	switch *record.Type {
+	case cisDNSRecordTypeCNAME, cisDNSRecordTypeNS, cisDNSRecordTypePTR:
+		data = *record.Content + "."
+	case cisDNSRecordTypeTXT, cisDNSRecordTypeSPF:
+		data = flex.QuoteZoneText(*record.Content)
+	case cisDNSRecordTypeMX:
+		priority := int64(0)
+		if record.Priority != nil {
+			priority = *record.Priority
+		}
+		data = fmt.Sprintf("%d %s.", priority, *record.Content)
+	case cisDNSRecordTypeSRV:
+		data = fmt.Sprintf("%v %v %v %v.", dataMap["priority"], dataMap["weight"], dataMap["port"], dataMap["target"])
+	case cisDNSRecordTypeCAA:
+		data = fmt.Sprintf("%v %v %s", dataMap["flags"], dataMap["tag"], flex.QuoteZoneText(fmt.Sprintf("%v", dataMap["value"])))
+	default:
+		if record.Content != nil {
+			data = *record.Content
+		}
+	}
+
+	zoneRecord, err := flex.NewZoneRecord(*record.Name+".", *record.Type, int(*record.TTL), data, zoneName)
+	if err != nil {
+		return zoneRecord, err
+	}
+	zoneRecord.ID = *record.ID
+	return zoneRecord, nil
+}
+
+// cisDNSRecordInput returns the name, content, priority and data of a record for the DNS records API
+func cisDNSRecordInput(record flex.ZoneRecord) (string, string, *int64, map[string]interface{}, error) {
+	fields := flex.ZoneRecordFields(record)
+	number := func(i int) int64 {
+		value, _ := strconv.ParseInt(fields[i], 10, 64)
+		return value
+	}
+	switch record.Type {
+	case cisDNSRecordTypeMX:
+		return record.Name, fields[1], core.Int64Ptr(number(0)), nil, nil
+	case cisDNSRecordTypeSRV:
+		labels := strings.SplitN(record.Name, ".", 3)
+		if len(labels) < 3 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+			return "", "", nil, nil, fmt.Errorf("[ERROR] SRV record %s is not named _service._protocol.name", record.Name)
+		}
+		return record.Name, "", nil, map[string]interface{}{
+			"service":  labels[0],
+			"proto":    labels[1],
+			"name":     labels[2],
+			"priority": number(0),
+			"weight":   number(1),
+			"port":     number(2),
+			"target":   fields[3],
+		}, nil
+	case cisDNSRecordTypeCAA:
+		return record.Name, "", nil, map[string]interface{}{
+			"flags": number(0),
+			"tag":   fields[1],
+			"value": fields[2],
+		}, nil
+	default:
+		return record.Name, fields[0], nil, nil, nil
+	}
+}
+
+func cisDNSZoneRecordCreate(sess *cisdnsrecordsv1.DnsRecordsV1, record flex.ZoneRecord) error {
+	name, content, priority, data, err := cisDNSRecordInput(record)
+	if err != nil {
+		return err
+	}
+	opt := sess.NewCreateDnsRecordOptions()
+	opt.SetName(name)
+	opt.SetType(record.Type)
+	opt.SetTTL(int64(record.TTL))
+	if content != "" {
+		opt.SetContent(content)
+	}
+	if priority != nil {
+		opt.SetPriority(*priority)
+	}
+	if data != nil {
+		opt.SetData(data)
+	}
+	_, response, err := sess.CreateDnsRecord(opt)
+	if err != nil {
+		log.Printf("Error creating dns record: %s, error %s", response, err)
+		return fmt.Errorf("[ERROR] Error creating %s record %s: %s", record.Type, record.Name, err)
+	}
+	return nil
+}
+
+func cisDNSZoneRecordUpdate(sess *cisdnsrecordsv1.DnsRecordsV1, record flex.ZoneRecord) error {
+	name, content, priority, data, err := cisDNSRecordInput(record)
+	if err != nil {
+		return err
+	}
+	opt := sess.NewUpdateDnsRecordOptions(record.ID)
+	opt.SetName(name)
+	opt.SetType(record.Type)
+	opt.SetTTL(int64(record.TTL))
+	if content != "" {
+		opt.SetContent(content)
+	}
+	if priority != nil {
+		opt.SetPriority(*priority)
+	}
+	if data != nil {
+		opt.SetData(data)
+	}
+	_, response, err := sess.UpdateDnsRecord(opt)
+	if err != nil {
+		log.Printf("Error updating dns record: %s, error %s", response, err)
+		return fmt.Errorf("[ERROR] Error updating %s record %s: %s", record.Type, record.Name, err)
+	}
+	return nil
+}
+
+func cisDNSZoneRecordDelete(sess *cisdnsrecordsv1.DnsRecordsV1, record flex.ZoneRecord) error {
+	_, response, err := sess.DeleteDnsRecord(sess.NewDeleteDnsRecordOptions(record.ID))
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		log.Printf("Error deleting dns record: %s, error %s", response, err)
+		return fmt.Errorf("[ERROR] Error deleting %s record %s: %s", record.Type, record.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisDNSZoneRecords_Basic(t *testing.T) {
+	name := "ibm_cis_dns_zone_records.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCisDNSZoneRecordsConfigBasic(
+					"tf-zone-records 300 IN A 192.0.2.10\n" +
+						"tf-zone-records-txt IN TXT \"tf zone records\"\n"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_name", acc.CisDomainStatic),
+					resource.TestCheckResourceAttr(name, "records_to_create", "2"),
+					resource.TestCheckResourceAttr(name, "records_to_update", "0"),
+					resource.TestCheckResourceAttr(name, "records.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCisDNSZoneRecordsConfigBasic(
+					"tf-zone-records 300 IN A 192.0.2.11\n" +
+						"tf-zone-records-mx IN MX 10 tf-zone-records\n"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "records_to_create", "1"),
+					resource.TestCheckResourceAttr(name, "records_to_update", "1"),
+					resource.TestCheckResourceAttr(name, "records_to_delete", "1"),
+					resource.TestCheckResourceAttr(name, "records.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+// The records of the test domain with a type which is not ignored are replaced by the records of the test
+func testAccCheckIBMCisDNSZoneRecordsConfigBasic(zoneFile string) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_dns_zone_records" "test" {
+		cis_id        = data.ibm_cis.cis.id
+		domain_id     = data.ibm_cis_domain.cis_domain.domain_id
+		ignored_types = ["SOA", "NS", "CNAME", "CAA", "SRV", "PTR", "SPF", "AAAA"]
+		zone_file     = %q
+	}
+	`, zoneFile)
+}
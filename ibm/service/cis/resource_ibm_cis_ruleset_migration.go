@@ -0,0 +1,472 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/rulesetsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	cisRulesetMigrationMode        = "mode"
+	cisRulesetMigrationModeLog     = "log"
+	cisRulesetMigrationModeEnforce = "enforce"
+	cisRulesetMigrationPhases      = "phases"
+)
+
+// The entrypoint rule fields which are sent back when the entrypoint ruleset is updated
+var cisRulesetMigrationRuleFields = []string{
+	"id", "action", "action_parameters", "description", "enabled", "expression", "ref", "logging",
+}
+
+func ResourceIBMCISRulesetMigration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMCISRulesetMigrationCreate,
+		Read:   resourceIBMCISRulesetMigrationRead,
+		Update: resourceIBMCISRulesetMigrationUpdate,
+		Delete: resourceIBMCISRulesetMigrationDelete,
+
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "CIS instance crn",
+				ValidateFunc: validate.InvokeValidator("ibm_cis_ruleset_migration",
+					cisID),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+				Description:      "Associated CIS domain",
+			},
+			cisRulesetMigrationMode: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      cisRulesetMigrationModeLog,
+				ValidateFunc: validation.StringInSlice([]string{cisRulesetMigrationModeLog, cisRulesetMigrationModeEnforce}, false),
+				Description:  "In log mode, the security rules only log the matching requests, and the other rules are disabled. The log action of the firewall rules requires an Enterprise plan. In enforce mode, the rules are applied as given.",
+			},
+			cisRulesetMigrationRules: {
+				Type:        schema.TypeList,
+				Required:    true,
+				ConfigMode:  schema.SchemaConfigModeAttr,
+				Description: "Entrypoint ruleset rules which replace the legacy settings",
+				Elem:        CISRulesetMigrationRuleObject,
+			},
+			cisRulesetMigrationPhases: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Phases of the entrypoint rulesets which have rules of the migration",
+			},
+		},
+	}
+}
+
+func ResourceIBMCISRulesetMigrationValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisID,
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+
+	ibmCISRulesetMigrationValidator := validate.ResourceValidator{
+		ResourceName: "ibm_cis_ruleset_migration",
+		Schema:       validateSchema}
+	return &ibmCISRulesetMigrationValidator
+}
+
+func resourceIBMCISRulesetMigrationCreate(d *schema.ResourceData, meta interface{}) error {
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+
+	rules, err := expandCISMigrationRules(d.Get(cisRulesetMigrationRules).([]interface{}))
+	if err != nil {
+		return err
+	}
+	if err := cisRulesetMigrationApply(meta, crn, zoneID, d.Get(cisRulesetMigrationMode).(string), nil, rules); err != nil {
+		return err
+	}
+
+	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
+	return resourceIBMCISRulesetMigrationRead(d, meta)
+}
+
+func resourceIBMCISRulesetMigrationRead(d *schema.ResourceData, meta interface{}) error {
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+	sess, err := meta.(conns.ClientSession).CisRulesetsSession()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while getting the CisRulesetsSession %s", err)
+	}
+	sess.Crn = core.StringPtr(crn)
+
+	// The rules which were removed from the entrypoint rulesets, or changed outside of Terraform, are removed from
+	// the state, so that they are written again by the next apply
+	rules := d.Get(cisRulesetMigrationRules).([]interface{})
+	mode := d.Get(cisRulesetMigrationMode).(string)
+	livePhases := map[string]map[string]map[string]interface{}{}
+	for _, phase := range cisRulesetMigrationRulePhases(rules) {
+		entrypoint, _, err := cisRulesetMigrationGetEntrypoint(sess, zoneID, phase)
+		if err != nil {
+			return err
+		}
+		refs := map[string]map[string]interface{}{}
+		for _, rule := range entrypoint.Rules {
+			if ref, ok := rule["ref"].(string); ok {
+				refs[ref] = rule
+			}
+		}
+		livePhases[phase] = refs
+	}
+
+	liveRules := make([]interface{}, 0, len(rules))
+	phases := []string{}
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+		phase := rule[cisRulesetMigrationRulePhase].(string)
+		live, ok := livePhases[phase][rule[cisRulesetMigrationRuleRef].(string)]
+		if !ok {
+			log.Printf("[WARN] The rule %s is not in the entrypoint ruleset of the phase %s", rule[cisRulesetMigrationRuleRef], phase)
+			continue
+		}
+		expanded, err := expandCISMigrationRules([]interface{}{rule})
+		if err != nil {
+			return err
+		}
+		expected, err := cisRulesetMigrationNormalize(cisRulesetMigrationRuleBody(cisRulesetMigrationModeRule(expanded[0], mode)))
+		if err != nil {
+			return err
+		}
+		if !cisRulesetMigrationRuleMatches(expected, live) {
+			log.Printf("[WARN] The rule %s of the entrypoint ruleset of the phase %s was changed outside of Terraform", rule[cisRulesetMigrationRuleRef], phase)
+			continue
+		}
+		liveRules = append(liveRules, rule)
+		phases = append(phases, phase)
+	}
+
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisRulesetMigrationRules, liveRules)
+	d.Set(cisRulesetMigrationPhases, phases)
+	return nil
+}
+
+func resourceIBMCISRulesetMigrationUpdate(d *schema.ResourceData, meta interface{}) error {
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange(cisRulesetMigrationRules) || d.HasChange(cisRulesetMigrationMode) {
+		old, new := d.GetChange(cisRulesetMigrationRules)
+		oldRules, err := expandCISMigrationRules(old.([]interface{}))
+		if err != nil {
+			return err
+		}
+		newRules, err := expandCISMigrationRules(new.([]interface{}))
+		if err != nil {
+			return err
+		}
+		if err := cisRulesetMigrationApply(meta, crn, zoneID, d.Get(cisRulesetMigrationMode).(string), oldRules, newRules); err != nil {
+			return err
+		}
+	}
+	return resourceIBMCISRulesetMigrationRead(d, meta)
+}
+
+func resourceIBMCISRulesetMigrationDelete(d *schema.ResourceData, meta interface{}) error {
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+
+	rules, err := expandCISMigrationRules(d.Get(cisRulesetMigrationRules).([]interface{}))
+	if err != nil {
+		return err
+	}
+	if err := cisRulesetMigrationApply(meta, crn, zoneID, d.Get(cisRulesetMigrationMode).(string), rules, nil); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// cisRulesetMigrationRulePhases returns the phases of the rules of the schema
+func cisRulesetMigrationRulePhases(rules []interface{}) []string {
+	seen := map[string]bool{}
+	for _, r := range rules {
+		seen[r.(map[string]interface{})[cisRulesetMigrationRulePhase].(string)] = true
+	}
+	phases := []string{}
+	for _, phase := range cisRulesetMigrationPhaseOrder {
+		if seen[phase] {
+			phases = append(phases, phase)
+		}
+	}
+	return phases
+}
+
+// cisRulesetMigrationApply replaces the old rules with the new rules in the entrypoint rulesets of the zone. The rules
+// are identified by their ref, and the other rules of the entrypoint rulesets are kept after the migrated rules.
+func cisRulesetMigrationApply(meta interface{}, crn, zoneID, mode string, oldRules, newRules []cisMigrationRule) error {
+	sess, err := meta.(conns.ClientSession).CisRulesetsSession()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while getting the CisRulesetsSession %s", err)
+	}
+	sess.Crn = core.StringPtr(crn)
+
+	refs := map[string]map[string]bool{}
+	desired := map[string][]interface{}{}
+	for _, rule := range oldRules {
+		if refs[rule.Phase] == nil {
+			refs[rule.Phase] = map[string]bool{}
+		}
+		refs[rule.Phase][rule.Ref] = true
+	}
+	for _, rule := range newRules {
+		if refs[rule.Phase] == nil {
+			refs[rule.Phase] = map[string]bool{}
+		}
+		if containsCISMigrationRef(desired[rule.Phase], rule.Ref) {
+			return fmt.Errorf("[ERROR] The ref %s of the phase %s is not unique", rule.Ref, rule.Phase)
+		}
+		refs[rule.Phase][rule.Ref] = true
+		desired[rule.Phase] = append(desired[rule.Phase], cisRulesetMigrationRuleBody(cisRulesetMigrationModeRule(rule, mode)))
+	}
+
+	for _, phase := range cisRulesetMigrationPhaseOrder {
+		if refs[phase] == nil {
+			continue
+		}
+		entrypoint, exists, err := cisRulesetMigrationGetEntrypoint(sess, zoneID, phase)
+		if err != nil {
+			return err
+		}
+
+		rules := desired[phase]
+		removed := false
+		for _, rule := range entrypoint.Rules {
+			if ref, ok := rule["ref"].(string); ok && refs[phase][ref] {
+				removed = true
+				continue
+			}
+			kept := map[string]interface{}{}
+			for _, field := range cisRulesetMigrationRuleFields {
+				if value, ok := rule[field]; ok {
+					kept[field] = value
+				}
+			}
+			rules = append(rules, kept)
+		}
+		if len(desired[phase]) == 0 && !removed {
+			continue
+		}
+		if rules == nil {
+			rules = []interface{}{}
+		}
+
+		body := map[string]interface{}{
+			"name":  "default",
+			"rules": rules,
+		}
+		if exists {
+			body["name"] = entrypoint.Name
+			body["description"] = entrypoint.Description
+		}
+		log.Printf("[DEBUG] Updating the entrypoint ruleset of the phase %s with %d migrated rules", phase, len(desired[phase]))
+		if _, resp, err := cisRulesetMigrationEntrypointRequest(sess, core.PUT, zoneID, phase, body); err != nil {
+			return fmt.Errorf("[ERROR] Error while Update Zone Entrypoint Rulesets of the phase %s %s %s", phase, err, resp)
+		}
+	}
+	return nil
+}
+
+func containsCISMigrationRef(rules []interface{}, ref string) bool {
+	for _, rule := range rules {
+		if rule.(map[string]interface{})["ref"] == ref {
+			return true
+		}
+	}
+	return false
+}
+
+// cisRulesetMigrationModeRule returns the rule which is applied in the mode. In log mode, the security rules log the
+// matching requests instead of blocking or challenging them, and the other rules are disabled.
+func cisRulesetMigrationModeRule(rule cisMigrationRule, mode string) cisMigrationRule {
+	if mode != cisRulesetMigrationModeLog {
+		return rule
+	}
+	switch rule.Phase {
+	case cisRulesetPhaseFirewallCustom:
+		switch rule.Action {
+		case "block", "challenge", "js_challenge", "managed_challenge":
+			rule.Action = cisRulesetMigrationModeLog
+			rule.ActionParameters = nil
+		}
+	case cisRulesetPhaseFirewallManaged:
+		if rule.Action == "execute" {
+			actionParameters := map[string]interface{}{}
+			for key, value := range rule.ActionParameters {
+				actionParameters[key] = value
+			}
+			overrides := map[string]interface{}{}
+			if o, ok := actionParameters["overrides"].(map[string]interface{}); ok {
+				for key, value := range o {
+					overrides[key] = value
+				}
+			}
+			overrides["action"] = cisRulesetMigrationModeLog
+			actionParameters["overrides"] = overrides
+			rule.ActionParameters = actionParameters
+		}
+	default:
+		rule.Enabled = false
+	}
+	return rule
+}
+
+func cisRulesetMigrationRuleBody(rule cisMigrationRule) map[string]interface{} {
+	body := map[string]interface{}{
+		"ref":         rule.Ref,
+		"description": rule.Description,
+		"expression":  rule.Expression,
+		"action":      rule.Action,
+		"enabled":     rule.Enabled,
+	}
+	if len(rule.ActionParameters) > 0 {
+		body["action_parameters"] = rule.ActionParameters
+	}
+	return body
+}
+
+// cisRulesetMigrationNormalize returns the rule body as it is decoded from the JSON of the API
+func cisRulesetMigrationNormalize(body map[string]interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	normalized := map[string]interface{}{}
+	err = json.Unmarshal(data, &normalized)
+	return normalized, err
+}
+
+// cisRulesetMigrationRuleMatches reports whether the live rule has the values of the expected rule body. The live
+// rule may have additional fields set by the service, and omits the fields which are empty.
+func cisRulesetMigrationRuleMatches(expected, live interface{}) bool {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, value := range e {
+			liveValue, ok := l[key]
+			if !ok {
+				if value == nil || value == "" || value == false {
+					continue
+				}
+				return false
+			}
+			if !cisRulesetMigrationRuleMatches(value, liveValue) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok || len(l) != len(e) {
+			return false
+		}
+		for i := range e {
+			if !cisRulesetMigrationRuleMatches(e[i], l[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(expected, live)
+	}
+}
+
+type cisRulesetMigrationEntrypoint struct {
+	Name        string                   `json:"name"`
+	Description string                   `json:"description"`
+	Rules       []map[string]interface{} `json:"rules"`
+}
+
+// cisRulesetMigrationGetEntrypoint returns the entrypoint ruleset of the phase, which is empty when it does not exist
+func cisRulesetMigrationGetEntrypoint(sess *rulesetsv1.RulesetsV1, zoneID, phase string) (*cisRulesetMigrationEntrypoint, bool, error) {
+	entrypoint, resp, err := cisRulesetMigrationEntrypointRequest(sess, core.GET, zoneID, phase, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return &cisRulesetMigrationEntrypoint{}, false, nil
+		}
+		return nil, false, fmt.Errorf("[ERROR] Get zone ruleset of the phase %s failed: %s %v", phase, err, resp)
+	}
+	return entrypoint, true, nil
+}
+
+// cisRulesetMigrationEntrypointRequest sends a request for the entrypoint ruleset of a phase. The SDK models only the
+// action parameters of the firewall rules, so the rules are sent and received as generic JSON.
+func cisRulesetMigrationEntrypointRequest(sess *rulesetsv1.RulesetsV1, method, zoneID, phase string, body interface{}) (*cisRulesetMigrationEntrypoint, *core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context.Background())
+	pathParams := map[string]string{
+		"crn":             *sess.Crn,
+		"zone_identifier": zoneID,
+		"ruleset_phase":   phase,
+	}
+	_, err := builder.ResolveRequestURL(sess.Service.Options.URL, `/v1/{crn}/zones/{zone_identifier}/rulesets/phases/{ruleset_phase}/entrypoint`, pathParams)
+	if err != nil {
+		return nil, nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rawResponse map[string]json.RawMessage
+	resp, err := sess.Service.Request(request, &rawResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+	entrypoint := &cisRulesetMigrationEntrypoint{}
+	if result, ok := rawResponse["result"]; ok {
+		if err := json.Unmarshal(result, entrypoint); err != nil {
+			return nil, resp, err
+		}
+	}
+	return entrypoint, resp, nil
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisRulesetMigration_Basic(t *testing.T) {
+	name := "ibm_cis_ruleset_migration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCisRulesetMigrationConfigBasic("log"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "mode", "log"),
+					resource.TestCheckResourceAttr(name, "rules.#", "1"),
+					resource.TestCheckResourceAttr(name, "phases.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCisRulesetMigrationConfigBasic("enforce"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "mode", "enforce"),
+					resource.TestCheckResourceAttr(name, "rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCisRulesetMigrationConfigBasic(mode string) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_ruleset_migration" "test" {
+		cis_id    = data.ibm_cis.cis.id
+		domain_id = data.ibm_cis_domain.cis_domain.domain_id
+		mode      = "%[1]s"
+		rules = [
+			{
+				phase       = "http_request_firewall_custom"
+				ref         = "ua_rule_tf_test"
+				description = "User agent rule tf test"
+				expression  = "http.user_agent eq \"tf-test-bot\""
+				action      = "block"
+			},
+		]
+	}
+	`, mode)
+}
@@ -0,0 +1,377 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+// The layouts of the eventTime field of the Activity Tracker events
+var cbrEventTimeLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05.000-0700", "2006-01-02T15:04:05-0700"}
+
+// cbrReportDenial is a group of report-mode denials sharing the same initiator, source address and target
+type cbrReportDenial struct {
+	InitiatorID string
+	IPAddress   string
+	TargetID    string
+	Action      string
+	Count       int
+	FirstSeen   time.Time
+	LastSeen    time.Time
+	Expected    bool
+}
+
+func DataSourceIBMCbrRuleReportDenials() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCbrRuleReportDenialsRead,
+
+		Schema: map[string]*schema.Schema{
+			"rule_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the rule in report mode.",
+			},
+			"events": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The Activity Tracker or Cloud Logs exports to analyze. Each export is a JSON array, a JSON object or newline-delimited JSON objects.",
+			},
+			"lookback_hours": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      168,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of hours before now that the denials are summarized over.",
+			},
+			"expected_ip_addresses": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IP addresses or CIDR ranges whose denials are expected.",
+			},
+			"expected_initiator_ids": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IAM IDs of the initiators whose denials are expected.",
+			},
+			"total_denials": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of denials of the rule within the lookback window.",
+			},
+			"unexpected_denials": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of denials of the rule within the lookback window that are not expected.",
+			},
+			"denials": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The denials of the rule, grouped by initiator, IP address and target.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"initiator_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IAM ID of the initiator of the request.",
+						},
+						"ip_address": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address the request came from.",
+						},
+						"target_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the target of the request.",
+						},
+						"action": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The action of the event.",
+						},
+						"count": &schema.Schema{
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of denials.",
+						},
+						"first_seen": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time of the first denial.",
+						},
+						"last_seen": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time of the last denial.",
+						},
+						"expected": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the denials are expected.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMCbrRuleReportDenialsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ruleID := d.Get("rule_id").(string)
+	lookbackHours := d.Get("lookback_hours").(int)
+
+	denials, err := summarizeCbrReportDenials(ruleID,
+		flex.ExpandStringList(d.Get("events").([]interface{})),
+		time.Duration(lookbackHours)*time.Hour,
+		flex.ExpandStringList(d.Get("expected_ip_addresses").([]interface{})),
+		flex.ExpandStringList(d.Get("expected_initiator_ids").([]interface{})),
+		time.Now())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	total, unexpected := 0, 0
+	denialList := []map[string]interface{}{}
+	for _, denial := range denials {
+		total += denial.Count
+		if !denial.Expected {
+			unexpected += denial.Count
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Unexpected report-mode denial of CBR rule %s", ruleID),
+				Detail:   denial.String(),
+			})
+		}
+		denialList = append(denialList, map[string]interface{}{
+			"initiator_id": denial.InitiatorID,
+			"ip_address":   denial.IPAddress,
+			"target_id":    denial.TargetID,
+			"action":       denial.Action,
+			"count":        denial.Count,
+			"first_seen":   denial.FirstSeen.UTC().Format(time.RFC3339),
+			"last_seen":    denial.LastSeen.UTC().Format(time.RFC3339),
+			"expected":     denial.Expected,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", ruleID, lookbackHours))
+	if err = d.Set("total_denials", total); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting total_denials: %s", err))
+	}
+	if err = d.Set("unexpected_denials", unexpected); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting unexpected_denials: %s", err))
+	}
+	if err = d.Set("denials", denialList); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting denials: %s", err))
+	}
+
+	return diags
+}
+
+func (denial cbrReportDenial) String() string {
+	return fmt.Sprintf("%d denials of %s from %s (%s) on %s between %s and %s", denial.Count, denial.Action,
+		denial.InitiatorID, denial.IPAddress, denial.TargetID,
+		denial.FirstSeen.UTC().Format(time.RFC3339), denial.LastSeen.UTC().Format(time.RFC3339))
+}
+
+// summarizeCbrReportDenials groups the denials of the rule found in the exported events that happened within
+// the lookback window, and flags the ones coming from an expected IP address or initiator
+func summarizeCbrReportDenials(ruleID string, exports []string, lookback time.Duration, expectedIPs, expectedInitiators []string, now time.Time) ([]cbrReportDenial, error) {
+	var expectedNetworks []*net.IPNet
+	for _, ip := range expectedIPs {
+		if !strings.Contains(ip, "/") {
+			if strings.Contains(ip, ":") {
+				ip += "/128"
+			} else {
+				ip += "/32"
+			}
+		}
+		_, network, err := net.ParseCIDR(ip)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid expected IP address %s: %s", ip, err)
+		}
+		expectedNetworks = append(expectedNetworks, network)
+	}
+
+	groups := map[string]*cbrReportDenial{}
+	for i, export := range exports {
+		events, err := parseCbrEvents(export)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing the events export %d: %s", i, err)
+		}
+		for _, event := range events {
+			if !cbrEventDeniesRule(event, ruleID) {
+				continue
+			}
+			eventTime, ok := cbrEventTime(event)
+			if !ok || eventTime.Before(now.Add(-lookback)) || eventTime.After(now) {
+				continue
+			}
+
+			denial := cbrReportDenial{
+				InitiatorID: cbrEventString(event, "initiator", "id"),
+				IPAddress:   cbrEventString(event, "initiator", "host", "address"),
+				TargetID:    cbrEventString(event, "target", "id"),
+				Action:      cbrEventString(event, "action"),
+			}
+			key := strings.Join([]string{denial.InitiatorID, denial.IPAddress, denial.TargetID, denial.Action}, "|")
+			group, ok := groups[key]
+			if !ok {
+				denial.FirstSeen = eventTime
+				denial.LastSeen = eventTime
+				denial.Expected = cbrDenialExpected(denial, expectedNetworks, expectedInitiators)
+				group = &denial
+				groups[key] = group
+			}
+			group.Count++
+			if eventTime.Before(group.FirstSeen) {
+				group.FirstSeen = eventTime
+			}
+			if eventTime.After(group.LastSeen) {
+				group.LastSeen = eventTime
+			}
+		}
+	}
+
+	denials := make([]cbrReportDenial, 0, len(groups))
+	for _, group := range groups {
+		denials = append(denials, *group)
+	}
+	sort.Slice(denials, func(i, j int) bool {
+		if denials[i].Count != denials[j].Count {
+			return denials[i].Count > denials[j].Count
+		}
+		return denials[i].LastSeen.After(denials[j].LastSeen)
+	})
+	return denials, nil
+}
+
+// parseCbrEvents decodes an export made of JSON arrays, JSON objects or newline-delimited JSON objects. The
+// events wrapped by Cloud Logs into a _source object or a line string are unwrapped.
+func parseCbrEvents(export string) ([]map[string]interface{}, error) {
+	events := []map[string]interface{}{}
+	decoder := json.NewDecoder(strings.NewReader(export))
+	for {
+		var value interface{}
+		if err := decoder.Decode(&value); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		values := []interface{}{value}
+		if list, ok := value.([]interface{}); ok {
+			values = list
+		}
+		for _, v := range values {
+			if event, ok := unwrapCbrEvent(v); ok {
+				events = append(events, event)
+			}
+		}
+	}
+	return events, nil
+}
+
+func unwrapCbrEvent(value interface{}) (map[string]interface{}, bool) {
+	event, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	if source, ok := event["_source"].(map[string]interface{}); ok {
+		return unwrapCbrEvent(source)
+	}
+	if line, ok := event["line"].(string); ok {
+		var inner interface{}
+		if err := json.Unmarshal([]byte(line), &inner); err == nil {
+			return unwrapCbrEvent(inner)
+		}
+	}
+	return event, true
+}
+
+// cbrEventDeniesRule reports whether the event is a non enforced denial of the rule
+func cbrEventDeniesRule(event map[string]interface{}, ruleID string) bool {
+	responseData, ok := event["responseData"].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	if enforced, ok := responseData["isEnforced"].(bool); ok && enforced {
+		return false
+	}
+	if !strings.EqualFold(fmt.Sprint(responseData["decision"]), "deny") {
+		return false
+	}
+	if id, ok := responseData["ruleId"].(string); ok && id == ruleID {
+		return true
+	}
+	rules, _ := responseData["evaluatedRules"].([]interface{})
+	for _, r := range rules {
+		rule, ok := r.(map[string]interface{})
+		if !ok || rule["ruleId"] != ruleID {
+			continue
+		}
+		if decision, ok := rule["decision"].(string); ok && !strings.EqualFold(decision, "deny") {
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+func cbrEventTime(event map[string]interface{}) (time.Time, bool) {
+	value := cbrEventString(event, "eventTime")
+	for _, layout := range cbrEventTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func cbrEventString(event map[string]interface{}, path ...string) string {
+	var value interface{} = event
+	for _, key := range path {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return ""
+		}
+		value = m[key]
+	}
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return ""
+}
+
+func cbrDenialExpected(denial cbrReportDenial, expectedNetworks []*net.IPNet, expectedInitiators []string) bool {
+	for _, initiator := range expectedInitiators {
+		if initiator == denial.InitiatorID {
+			return true
+		}
+	}
+	if ip := net.ParseIP(denial.IPAddress); ip != nil {
+		for _, network := range expectedNetworks {
+			if network.Contains(ip) {
+				return true
+			}
+		}
+	}
+	return false
+}
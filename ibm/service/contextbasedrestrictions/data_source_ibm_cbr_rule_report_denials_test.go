@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMCbrRuleReportDenialsDataSourceBasic(t *testing.T) {
+	now := time.Now().UTC()
+	recent := now.Add(-2 * time.Hour).Format(time.RFC3339)
+	old := now.Add(-30 * 24 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCbrRuleReportDenialsDataSourceConfig(recent, old),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_report_denials.denials", "total_denials", "3"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_report_denials.denials", "unexpected_denials", "2"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_report_denials.denials", "denials.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_report_denials.denials", "denials.0.initiator_id", "iam-ServiceId-unexpected"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_report_denials.denials", "denials.0.count", "2"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_report_denials.denials", "denials.0.expected", "false"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_report_denials.denials", "denials.1.expected", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCbrRuleReportDenialsDataSourceConfig(recent, old string) string {
+	return fmt.Sprintf(`
+		locals {
+			denial = {
+				action       = "cloud-object-storage.object.read"
+				responseData = { decision = "Deny", isEnforced = false, ruleId = "rule-1" }
+				target       = { id = "crn:v1:bluemix:public:cloud-object-storage:global:a/1234::" }
+			}
+		}
+
+		data "ibm_cbr_rule_report_denials" "denials" {
+			rule_id = "rule-1"
+			events  = [
+				jsonencode([
+					merge(local.denial, { eventTime = "%[1]s", initiator = { id = "iam-ServiceId-unexpected", host = { address = "192.0.2.10" } } }),
+					merge(local.denial, { eventTime = "%[1]s", initiator = { id = "iam-ServiceId-unexpected", host = { address = "192.0.2.10" } } }),
+					merge(local.denial, { eventTime = "%[2]s", initiator = { id = "iam-ServiceId-unexpected", host = { address = "192.0.2.10" } } }),
+				]),
+				jsonencode(merge(local.denial, { eventTime = "%[1]s", initiator = { id = "iam-ServiceId-expected", host = { address = "10.0.0.5" } } })),
+			]
+			lookback_hours        = 24
+			expected_ip_addresses = ["10.0.0.0/8"]
+		}
+	`, recent, old)
+}
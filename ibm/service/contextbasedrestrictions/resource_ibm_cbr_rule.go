@@ -7,11 +7,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -33,6 +35,7 @@ func ResourceIBMCbrRule() *schema.Resource {
 		UpdateContext: resourceIBMCbrRuleUpdate,
 		DeleteContext: resourceIBMCbrRuleDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMCbrRuleEnforcementCheckCustomizeDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Minute),
@@ -163,6 +166,53 @@ func ResourceIBMCbrRule() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator("ibm_cbr_rule", "enforcement_mode"),
 				Description:  "The rule enforcement mode: * `enabled` - The restrictions are enforced and reported. This is the default. * `disabled` - The restrictions are disabled. Nothing is enforced or reported. * `report` - The restrictions are evaluated and reported, but not enforced.",
 			},
+			"enforcement_check": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Refuses to move the rule from `report` to `enabled` enforcement mode while unexpected report-mode denials exist within the lookback window.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"events": &schema.Schema{
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The Activity Tracker or Cloud Logs exports to analyze. Each export is a JSON array, a JSON object or newline-delimited JSON objects.",
+						},
+						"lookback_hours": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      168,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The number of hours before now that the denials are looked for.",
+						},
+						"expected_ip_addresses": &schema.Schema{
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The IP addresses or CIDR ranges whose denials are expected.",
+						},
+						"expected_initiator_ids": &schema.Schema{
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The IAM IDs of the initiators whose denials are expected.",
+						},
+						"allow_unexpected_denials": &schema.Schema{
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Enforce the rule even when unexpected report-mode denials exist. The denials are still reported in enforcement_warnings.",
+						},
+					},
+				},
+			},
+			"enforcement_warnings": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The unexpected report-mode denials that allow_unexpected_denials let through when the rule was last moved from `report` to `enabled` enforcement mode. Informational only.",
+			},
 			"x_correlation_id": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -256,6 +306,48 @@ func ResourceIBMCbrRuleValidator() *validate.ResourceValidator {
 	return &resourceValidator
 }
 
+// resourceIBMCbrRuleEnforcementCheckCustomizeDiff fails the plan that enforces a rule in report mode while the
+// enforcement check finds unexpected denials of the rule, unless they are allowed explicitly. The allowed denials
+// are planned in enforcement_warnings.
+func resourceIBMCbrRuleEnforcementCheckCustomizeDiff(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("enforcement_mode") || !d.NewValueKnown("enforcement_mode") || !d.NewValueKnown("enforcement_check") {
+		return nil
+	}
+	oldMode, newMode := d.GetChange("enforcement_mode")
+	if oldMode.(string) != "report" || newMode.(string) != "enabled" {
+		return nil
+	}
+	checks := d.Get("enforcement_check").([]interface{})
+	if len(checks) == 0 || checks[0] == nil {
+		return nil
+	}
+	check := checks[0].(map[string]interface{})
+
+	lookbackHours := check["lookback_hours"].(int)
+	denials, err := summarizeCbrReportDenials(d.Id(),
+		flex.ExpandStringList(check["events"].([]interface{})),
+		time.Duration(lookbackHours)*time.Hour,
+		flex.ExpandStringList(check["expected_ip_addresses"].([]interface{})),
+		flex.ExpandStringList(check["expected_initiator_ids"].([]interface{})),
+		time.Now())
+	if err != nil {
+		return err
+	}
+
+	unexpected := []string{}
+	for _, denial := range denials {
+		if !denial.Expected {
+			log.Printf("[WARN] Unexpected report-mode denial of CBR rule %s: %s", d.Id(), denial)
+			unexpected = append(unexpected, denial.String())
+		}
+	}
+	if len(unexpected) > 0 && !check["allow_unexpected_denials"].(bool) {
+		return fmt.Errorf("Refusing to enforce CBR rule %s: %d groups of unexpected report-mode denials within the last %d hours:\n  %s\nAdd the expected sources to enforcement_check, set allow_unexpected_denials, or keep the rule in report mode",
+			d.Id(), len(unexpected), lookbackHours, strings.Join(unexpected, "\n  "))
+	}
+	return d.SetNew("enforcement_warnings", unexpected)
+}
+
 // waitForCbrRuleRead will leverage use retry.StateChangeConf due to the service's eventual consistency
 func waitForCbrRuleRead(cbrClient *contextbasedrestrictionsv1.ContextBasedRestrictionsV1, context context.Context, id string) (interface{}, error) {
 	stateConf := &retry.StateChangeConf{
@@ -821,6 +821,15 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 			if expire_ok {
 				rules = append(rules, expireRuleList(expire.([]interface{}))...)
 			}
+			// Do not overwrite the rules of an ibm_cos_bucket_lifecycle_configuration
+			if !inlineLifecycleRulesSet(d) {
+				existing, err := s3Client.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+					Bucket: aws.String(bucketName),
+				})
+				if err == nil && len(existing.Rules) > 0 {
+					return fmt.Errorf("the lifecycle of COS bucket %s is already configured, possibly by an ibm_cos_bucket_lifecycle_configuration; the inline lifecycle rules and ibm_cos_bucket_lifecycle_configuration can not be used together", bucketName)
+				}
+			}
 			lInput := &s3.PutBucketLifecycleConfigurationInput{
 				Bucket: aws.String(bucketName),
 				LifecycleConfiguration: &s3.LifecycleConfiguration{
@@ -1097,6 +1106,7 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	apiType := parseBucketId(d.Id(), "apiType")
 	bLocation := parseBucketId(d.Id(), "bLocation")
+	importing := d.Get("bucket_name").(string) == ""
 
 	if _, ok := d.GetOk("key_protect"); ok {
 		keyProtectFlag = true
@@ -1286,7 +1296,9 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 	if (err != nil && !strings.Contains(err.Error(), "NoSuchLifecycleConfiguration: The lifecycle configuration does not exist")) && (err != nil && bucketPtr != nil && bucketPtr.Firewall != nil && !strings.Contains(err.Error(), "AccessDenied: Access Denied")) {
 		return err
 	}
-	if lifecycleptr != nil {
+	// When the bucket has no inline lifecycle rules, its lifecycle is left to
+	// ibm_cos_bucket_lifecycle_configuration, except on import
+	if lifecycleptr != nil && (inlineLifecycleRulesSet(d) || importing) {
 		archiveRules := flex.ArchiveRuleGet(lifecycleptr.Rules)
 		expireRules := flex.ExpireRuleGet(lifecycleptr.Rules)
 		nc_expRules := flex.Nc_exp_RuleGet(lifecycleptr.Rules)
@@ -1770,6 +1782,17 @@ func parseBucketId(id string, info string) string {
 	return ""
 }
 
+// inlineLifecycleRulesSet tells whether the lifecycle rules of the bucket were set
+// with its inline attributes before the current operation
+func inlineLifecycleRulesSet(d *schema.ResourceData) bool {
+	for _, attr := range []string{"archive_rule", "expire_rule", "noncurrent_version_expiration", "abort_incomplete_multipart_upload_days"} {
+		if old, _ := d.GetChange(attr); len(old.([]interface{})) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func resourceExpiryValidate(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	if expire, ok := diff.GetOk("expire_rule"); ok {
 		expire_list := expire.([]interface{})
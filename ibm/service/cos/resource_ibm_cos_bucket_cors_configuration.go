@@ -0,0 +1,257 @@
+package cos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMCOSBucketCorsConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCOSBucketCorsConfigurationCreate,
+		Read:     resourceIBMCOSBucketCorsConfigurationRead,
+		Update:   resourceIBMCOSBucketCorsConfigurationUpdate,
+		Delete:   resourceIBMCOSBucketCorsConfigurationDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"cors_rule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    100,
+				Description: "Cross-origin resource sharing rules of the bucket.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_headers": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Headers that are allowed in a preflight request through the Access-Control-Request-Headers header.",
+						},
+						"allowed_methods": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice([]string{"GET", "PUT", "POST", "DELETE", "HEAD"}, false)},
+							Description: "HTTP methods that the origin is allowed to execute: GET, PUT, POST, DELETE, HEAD.",
+						},
+						"allowed_origins": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Origins that are allowed to access the bucket.",
+						},
+						"expose_headers": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Headers in the response that customers are able to access from their applications.",
+						},
+						"max_age_seconds": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The time in seconds that the browser caches the response for a preflight request.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func corsRuleSetFunction(corsRuleList []interface{}) []*s3.CORSRule {
+	var rules []*s3.CORSRule
+	for _, l := range corsRuleList {
+		ruleMap, ok := l.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		cors_rule := s3.CORSRule{}
+		if allowedHeaders, ok := ruleMap["allowed_headers"].(*schema.Set); ok && allowedHeaders.Len() > 0 {
+			cors_rule.AllowedHeaders = aws.StringSlice(flex.ExpandStringList(allowedHeaders.List()))
+		}
+		if allowedMethods, ok := ruleMap["allowed_methods"].(*schema.Set); ok {
+			cors_rule.AllowedMethods = aws.StringSlice(flex.ExpandStringList(allowedMethods.List()))
+		}
+		if allowedOrigins, ok := ruleMap["allowed_origins"].(*schema.Set); ok {
+			cors_rule.AllowedOrigins = aws.StringSlice(flex.ExpandStringList(allowedOrigins.List()))
+		}
+		if exposeHeaders, ok := ruleMap["expose_headers"].(*schema.Set); ok && exposeHeaders.Len() > 0 {
+			cors_rule.ExposeHeaders = aws.StringSlice(flex.ExpandStringList(exposeHeaders.List()))
+		}
+		if maxAgeSeconds, ok := ruleMap["max_age_seconds"].(int); ok && maxAgeSeconds > 0 {
+			cors_rule.MaxAgeSeconds = aws.Int64(int64(maxAgeSeconds))
+		}
+		rules = append(rules, &cors_rule)
+	}
+	return rules
+}
+
+func resourceIBMCOSBucketCorsConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	putBucketCorsInput := s3.PutBucketCorsInput{
+		Bucket: aws.String(bucketName),
+		CORSConfiguration: &s3.CORSConfiguration{
+			CORSRules: corsRuleSetFunction(d.Get("cors_rule").([]interface{})),
+		},
+	}
+	_, err = s3Client.PutBucketCors(&putBucketCorsInput)
+	if err != nil {
+		return fmt.Errorf("failed to put cors configuration on the COS bucket %s, %v", bucketName, err)
+	}
+	bktID := fmt.Sprintf("%s:%s:%s:meta:%s:%s", strings.Replace(instanceCRN, "::", "", -1), "bucket", bucketName, bucketLocation, endpointType)
+	d.SetId(bktID)
+	return resourceIBMCOSBucketCorsConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketCorsConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	bucketName := parseBucketConfigId(d.Id(), "bucketName")
+	bucketLocation := parseBucketConfigId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketConfigId(d.Id(), "instanceCRN")
+	endpointType := d.Get("endpoint_type").(string)
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	if d.HasChange("cors_rule") {
+		putBucketCorsInput := s3.PutBucketCorsInput{
+			Bucket: aws.String(bucketName),
+			CORSConfiguration: &s3.CORSConfiguration{
+				CORSRules: corsRuleSetFunction(d.Get("cors_rule").([]interface{})),
+			},
+		}
+		_, err = s3Client.PutBucketCors(&putBucketCorsInput)
+		if err != nil {
+			return fmt.Errorf("failed to update cors configuration on the COS bucket %s, %v", bucketName, err)
+		}
+	}
+	if d.HasChange("endpoint_type") {
+		d.SetId(fmt.Sprintf("%s:meta:%s:%s", parseBucketConfigId(d.Id(), "bucketCRN"), bucketLocation, endpointType))
+	}
+	return resourceIBMCOSBucketCorsConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketCorsConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := parseBucketConfigId(d.Id(), "bucketCRN")
+	bucketName := parseBucketConfigId(d.Id(), "bucketName")
+	bucketLocation := parseBucketConfigId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketConfigId(d.Id(), "instanceCRN")
+	endpointType := parseBucketConfigId(d.Id(), "endpointType")
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	if endpointType != "" {
+		d.Set("endpoint_type", endpointType)
+	}
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	getBucketCorsInput := &s3.GetBucketCorsInput{
+		Bucket: aws.String(bucketName),
+	}
+	output, err := s3Client.GetBucketCors(getBucketCorsInput)
+	if err != nil {
+		// The configuration was removed outside of terraform
+		if strings.Contains(err.Error(), "NoSuchCORSConfiguration") || strings.Contains(err.Error(), "NoSuchBucket") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("failed to read cors configuration of the COS bucket %s, %v", bucketName, err)
+	}
+	d.Set("cors_rule", flex.CorsRulesGet(output.CORSRules))
+	return nil
+}
+
+func resourceIBMCOSBucketCorsConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	bucketName := parseBucketConfigId(d.Id(), "bucketName")
+	bucketLocation := parseBucketConfigId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketConfigId(d.Id(), "instanceCRN")
+	endpointType := parseBucketConfigId(d.Id(), "endpointType")
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	deleteBucketCorsInput := &s3.DeleteBucketCorsInput{
+		Bucket: aws.String(bucketName),
+	}
+	_, err = s3Client.DeleteBucketCors(deleteBucketCorsInput)
+	if err != nil {
+		return fmt.Errorf("failed to delete the cors configuration on the COS bucket %s, %v", bucketName, err)
+	}
+	return nil
+}
+
+// parseBucketConfigId parses the ID shared by the bucket configuration resources,
+// <instance CRN>:bucket:<bucket name>:meta:<bucket location>:<endpoint type>
+func parseBucketConfigId(id string, info string) string {
+	bucketCRN := strings.Split(id, ":meta:")[0]
+	meta := strings.Split(id, ":meta:")[1]
+	switch info {
+	case "bucketName":
+		return strings.Split(bucketCRN, ":bucket:")[1]
+	case "instanceCRN":
+		return fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	case "bucketCRN":
+		return bucketCRN
+	case "bucketLocation":
+		return strings.Split(meta, ":")[0]
+	case "endpointType":
+		return strings.Split(meta, ":")[1]
+	}
+	return parseBucketId(bucketCRN, info)
+}
@@ -0,0 +1,608 @@
+package cos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/private/checksum"
+	"github.com/IBM/ibm-cos-sdk-go/private/protocol"
+	"github.com/IBM/ibm-cos-sdk-go/private/protocol/restxml"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The COS SDK only models the prefix filter of the lifecycle rules, the requests
+// below are built with the S3 client handlers the same way the SDK builds its own
+// so that the tag and size filters can be sent.
+type lifecycleRule struct {
+	_ struct{} `type:"structure"`
+
+	AbortIncompleteMultipartUpload *s3.AbortIncompleteMultipartUpload `type:"structure"`
+
+	Expiration *s3.LifecycleExpiration `type:"structure"`
+
+	Filter *lifecycleRuleFilter `type:"structure" required:"true"`
+
+	ID *string `type:"string"`
+
+	NoncurrentVersionExpiration *s3.NoncurrentVersionExpiration `type:"structure"`
+
+	Status *string `type:"string" required:"true"`
+
+	Transitions []*s3.Transition `locationName:"Transition" type:"list" flattened:"true"`
+}
+
+type lifecycleRuleFilter struct {
+	_ struct{} `type:"structure"`
+
+	And *lifecycleRuleAndOperator `type:"structure"`
+
+	ObjectSizeGreaterThan *int64 `type:"long"`
+
+	ObjectSizeLessThan *int64 `type:"long"`
+
+	Prefix *string `type:"string"`
+
+	Tag *s3.Tag `type:"structure"`
+}
+
+type lifecycleRuleAndOperator struct {
+	_ struct{} `type:"structure"`
+
+	ObjectSizeGreaterThan *int64 `type:"long"`
+
+	ObjectSizeLessThan *int64 `type:"long"`
+
+	Prefix *string `type:"string"`
+
+	Tags []*s3.Tag `locationName:"Tag" locationNameList:"Tag" type:"list" flattened:"true"`
+}
+
+type lifecycleConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	Rules []*lifecycleRule `locationName:"Rule" type:"list" flattened:"true" required:"true"`
+}
+
+type putBucketLifecycleConfigurationInput struct {
+	_ struct{} `locationName:"PutBucketLifecycleConfigurationRequest" type:"structure" payload:"LifecycleConfiguration"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	LifecycleConfiguration *lifecycleConfiguration `locationName:"LifecycleConfiguration" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+type getBucketLifecycleConfigurationInput struct {
+	_ struct{} `locationName:"GetBucketLifecycleConfigurationRequest" type:"structure"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+}
+
+func ResourceIBMCOSBucketLifecycleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCOSBucketLifecycleConfigurationCreate,
+		Read:     resourceIBMCOSBucketLifecycleConfigurationRead,
+		Update:   resourceIBMCOSBucketLifecycleConfigurationUpdate,
+		Delete:   resourceIBMCOSBucketLifecycleConfigurationDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"lifecycle_rule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1000,
+				Description: "Lifecycle rules of the bucket.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+							Description:  "Unique identifier of the rule.",
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "Enabled",
+							ValidateFunc: validation.StringInSlice([]string{"Enabled", "Disabled"}, false),
+							Description:  "Whether the rule is applied: Enabled or Disabled.",
+						},
+						"filter": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "The objects that the rule applies to. The rule applies to all the objects of the bucket when not set.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prefix": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The rule applies to the objects whose key starts with this prefix.",
+									},
+									"object_size_greater_than": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+										Description:  "The rule applies to the objects larger than this size, in bytes.",
+									},
+									"object_size_less_than": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+										Description:  "The rule applies to the objects smaller than this size, in bytes.",
+									},
+									"tag": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "The rule applies to the objects that have all these tags.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: "The key of the tag.",
+												},
+												"value": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: "The value of the tag.",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"transition": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Transitions of the objects to an archive storage class.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.ValidBucketLifecycleTimestamp,
+										Description:  "The date, in YYYY-MM-DD format, after which the objects are transitioned.",
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.ValidateAllowedRangeInt(0, 3650),
+										Description:  "The number of days after the creation of the objects after which they are transitioned.",
+									},
+									"storage_class": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     validation.StringInSlice([]string{"GLACIER", "ACCELERATED"}, true),
+										DiffSuppressFunc: caseDiffSuppress,
+										Description:      "The storage class to which the objects are transitioned: GLACIER or ACCELERATED.",
+									},
+								},
+							},
+						},
+						"expiration": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Expiration of the current version of the objects.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.ValidBucketLifecycleTimestamp,
+										Description:  "The date, in YYYY-MM-DD format, after which the objects expire.",
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.ValidateAllowedRangeInt(1, 3650),
+										Description:  "The number of days after the creation of the objects after which they expire.",
+									},
+									"expired_object_delete_marker": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Remove the delete markers that have no noncurrent version.",
+									},
+								},
+							},
+						},
+						"noncurrent_version_expiration": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Expiration of the noncurrent versions of the objects.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validate.ValidateAllowedRangeInt(1, 3650),
+										Description:  "The number of days after which a version is noncurrent that it expires.",
+									},
+								},
+							},
+						},
+						"abort_incomplete_multipart_upload": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Abort of the incomplete multipart uploads.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days_after_initiation": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validate.ValidateAllowedRangeInt(1, 3650),
+										Description:  "The number of days after the initiation of the uploads after which they are aborted.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func lifecycleDate(date string) *time.Time {
+	t, _ := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", date))
+	return &t
+}
+
+func lifecycleRuleSetFunction(lifecycleRuleList []interface{}) []*lifecycleRule {
+	var rules []*lifecycleRule
+	for _, l := range lifecycleRuleList {
+		ruleMap, ok := l.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		lifecycle_rule := lifecycleRule{
+			ID:     aws.String(ruleMap["id"].(string)),
+			Status: aws.String(ruleMap["status"].(string)),
+			Filter: &lifecycleRuleFilter{},
+		}
+
+		// A filter has exactly one condition, several conditions are combined with And
+		and := &lifecycleRuleAndOperator{}
+		conditions := 0
+		if filterList, ok := ruleMap["filter"].([]interface{}); ok && len(filterList) > 0 && filterList[0] != nil {
+			filterMap := filterList[0].(map[string]interface{})
+			if prefix, ok := filterMap["prefix"].(string); ok && prefix != "" {
+				and.Prefix = aws.String(prefix)
+				conditions++
+			}
+			if size, ok := filterMap["object_size_greater_than"].(int); ok && size > 0 {
+				and.ObjectSizeGreaterThan = aws.Int64(int64(size))
+				conditions++
+			}
+			if size, ok := filterMap["object_size_less_than"].(int); ok && size > 0 {
+				and.ObjectSizeLessThan = aws.Int64(int64(size))
+				conditions++
+			}
+			for _, t := range filterMap["tag"].([]interface{}) {
+				tagMap := t.(map[string]interface{})
+				and.Tags = append(and.Tags, &s3.Tag{
+					Key:   aws.String(tagMap["key"].(string)),
+					Value: aws.String(tagMap["value"].(string)),
+				})
+				conditions++
+			}
+		}
+		switch {
+		case conditions > 1:
+			lifecycle_rule.Filter.And = and
+		case len(and.Tags) == 1:
+			lifecycle_rule.Filter.Tag = and.Tags[0]
+		case and.ObjectSizeGreaterThan != nil:
+			lifecycle_rule.Filter.ObjectSizeGreaterThan = and.ObjectSizeGreaterThan
+		case and.ObjectSizeLessThan != nil:
+			lifecycle_rule.Filter.ObjectSizeLessThan = and.ObjectSizeLessThan
+		default:
+			lifecycle_rule.Filter.Prefix = aws.String(aws.StringValue(and.Prefix))
+		}
+
+		for _, t := range ruleMap["transition"].([]interface{}) {
+			transitionMap := t.(map[string]interface{})
+			transition := &s3.Transition{
+				StorageClass: aws.String(strings.ToUpper(transitionMap["storage_class"].(string))),
+			}
+			if date, ok := transitionMap["date"].(string); ok && date != "" {
+				transition.Date = lifecycleDate(date)
+			} else {
+				transition.Days = aws.Int64(int64(transitionMap["days"].(int)))
+			}
+			lifecycle_rule.Transitions = append(lifecycle_rule.Transitions, transition)
+		}
+		if expirationList, ok := ruleMap["expiration"].([]interface{}); ok && len(expirationList) > 0 && expirationList[0] != nil {
+			expirationMap := expirationList[0].(map[string]interface{})
+			expiration := &s3.LifecycleExpiration{}
+			if date, ok := expirationMap["date"].(string); ok && date != "" {
+				expiration.Date = lifecycleDate(date)
+			}
+			if days, ok := expirationMap["days"].(int); ok && days > 0 {
+				expiration.Days = aws.Int64(int64(days))
+			}
+			if marker, ok := expirationMap["expired_object_delete_marker"].(bool); ok && marker {
+				expiration.ExpiredObjectDeleteMarker = aws.Bool(true)
+			}
+			lifecycle_rule.Expiration = expiration
+		}
+		if ncList, ok := ruleMap["noncurrent_version_expiration"].([]interface{}); ok && len(ncList) > 0 && ncList[0] != nil {
+			ncMap := ncList[0].(map[string]interface{})
+			lifecycle_rule.NoncurrentVersionExpiration = &s3.NoncurrentVersionExpiration{
+				NoncurrentDays: aws.Int64(int64(ncMap["noncurrent_days"].(int))),
+			}
+		}
+		if abortList, ok := ruleMap["abort_incomplete_multipart_upload"].([]interface{}); ok && len(abortList) > 0 && abortList[0] != nil {
+			abortMap := abortList[0].(map[string]interface{})
+			lifecycle_rule.AbortIncompleteMultipartUpload = &s3.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: aws.Int64(int64(abortMap["days_after_initiation"].(int))),
+			}
+		}
+		rules = append(rules, &lifecycle_rule)
+	}
+	return rules
+}
+
+func lifecycleRuleGet(in []*lifecycleRule) []map[string]interface{} {
+	rules := make([]map[string]interface{}, 0, len(in))
+	for _, r := range in {
+		rule := map[string]interface{}{
+			"id":     aws.StringValue(r.ID),
+			"status": aws.StringValue(r.Status),
+		}
+		if r.Filter != nil {
+			filter := map[string]interface{}{}
+			prefix, greaterThan, lessThan, tags := r.Filter.Prefix, r.Filter.ObjectSizeGreaterThan, r.Filter.ObjectSizeLessThan, []*s3.Tag{}
+			if r.Filter.Tag != nil {
+				tags = append(tags, r.Filter.Tag)
+			}
+			if r.Filter.And != nil {
+				prefix, greaterThan, lessThan, tags = r.Filter.And.Prefix, r.Filter.And.ObjectSizeGreaterThan, r.Filter.And.ObjectSizeLessThan, r.Filter.And.Tags
+			}
+			if aws.StringValue(prefix) != "" {
+				filter["prefix"] = aws.StringValue(prefix)
+			}
+			if greaterThan != nil {
+				filter["object_size_greater_than"] = int(*greaterThan)
+			}
+			if lessThan != nil {
+				filter["object_size_less_than"] = int(*lessThan)
+			}
+			if len(tags) > 0 {
+				tagList := make([]map[string]interface{}, 0, len(tags))
+				for _, tag := range tags {
+					tagList = append(tagList, map[string]interface{}{
+						"key":   aws.StringValue(tag.Key),
+						"value": aws.StringValue(tag.Value),
+					})
+				}
+				filter["tag"] = tagList
+			}
+			if len(filter) > 0 {
+				rule["filter"] = []map[string]interface{}{filter}
+			}
+		}
+		if len(r.Transitions) > 0 {
+			transitions := make([]map[string]interface{}, 0, len(r.Transitions))
+			for _, t := range r.Transitions {
+				transition := map[string]interface{}{
+					"storage_class": aws.StringValue(t.StorageClass),
+				}
+				if t.Date != nil {
+					transition["date"] = t.Date.UTC().Format("2006-01-02")
+				}
+				if t.Days != nil {
+					transition["days"] = int(*t.Days)
+				}
+				transitions = append(transitions, transition)
+			}
+			rule["transition"] = transitions
+		}
+		if r.Expiration != nil {
+			expiration := map[string]interface{}{}
+			if r.Expiration.Date != nil {
+				expiration["date"] = r.Expiration.Date.UTC().Format("2006-01-02")
+			}
+			if r.Expiration.Days != nil {
+				expiration["days"] = int(*r.Expiration.Days)
+			}
+			if r.Expiration.ExpiredObjectDeleteMarker != nil {
+				expiration["expired_object_delete_marker"] = *r.Expiration.ExpiredObjectDeleteMarker
+			}
+			rule["expiration"] = []map[string]interface{}{expiration}
+		}
+		if r.NoncurrentVersionExpiration != nil {
+			rule["noncurrent_version_expiration"] = []map[string]interface{}{{
+				"noncurrent_days": int(aws.Int64Value(r.NoncurrentVersionExpiration.NoncurrentDays)),
+			}}
+		}
+		if r.AbortIncompleteMultipartUpload != nil {
+			rule["abort_incomplete_multipart_upload"] = []map[string]interface{}{{
+				"days_after_initiation": int(aws.Int64Value(r.AbortIncompleteMultipartUpload.DaysAfterInitiation)),
+			}}
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func putBucketLifecycleConfiguration(s3Client *s3.S3, bucketName string, rules []*lifecycleRule) error {
+	op := &request.Operation{
+		Name:       "PutBucketLifecycleConfiguration",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}?lifecycle",
+	}
+	input := &putBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucketName),
+		LifecycleConfiguration: &lifecycleConfiguration{
+			Rules: rules,
+		},
+	}
+	req := s3Client.NewRequest(op, input, &struct{}{})
+	req.Handlers.Unmarshal.Swap(restxml.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	req.Handlers.Build.PushBackNamed(request.NamedHandler{
+		Name: "contentMd5Handler",
+		Fn:   checksum.AddBodyContentMD5Handler,
+	})
+	return req.Send()
+}
+
+func getBucketLifecycleConfiguration(s3Client *s3.S3, bucketName string) ([]*lifecycleRule, error) {
+	op := &request.Operation{
+		Name:       "GetBucketLifecycleConfiguration",
+		HTTPMethod: "GET",
+		HTTPPath:   "/{Bucket}?lifecycle",
+	}
+	output := &lifecycleConfiguration{}
+	req := s3Client.NewRequest(op, &getBucketLifecycleConfigurationInput{Bucket: aws.String(bucketName)}, output)
+	if err := req.Send(); err != nil {
+		return nil, err
+	}
+	return output.Rules, nil
+}
+
+func resourceIBMCOSBucketLifecycleConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	// The lifecycle configuration is a single document, it is not taken over silently
+	// from the inline rules of ibm_cos_bucket or from another lifecycle configuration
+	existing, err := getBucketLifecycleConfiguration(s3Client, bucketName)
+	if err != nil && !strings.Contains(err.Error(), "NoSuchLifecycleConfiguration") {
+		return fmt.Errorf("failed to read lifecycle configuration of the COS bucket %s, %v", bucketName, err)
+	}
+	if len(existing) > 0 {
+		return fmt.Errorf("the COS bucket %s already has a lifecycle configuration, remove the archive_rule, expire_rule, noncurrent_version_expiration and abort_incomplete_multipart_upload_days of its ibm_cos_bucket or import the configuration", bucketName)
+	}
+	err = putBucketLifecycleConfiguration(s3Client, bucketName, lifecycleRuleSetFunction(d.Get("lifecycle_rule").([]interface{})))
+	if err != nil {
+		return fmt.Errorf("failed to put lifecycle configuration on the COS bucket %s, %v", bucketName, err)
+	}
+	bktID := fmt.Sprintf("%s:%s:%s:meta:%s:%s", strings.Replace(instanceCRN, "::", "", -1), "bucket", bucketName, bucketLocation, endpointType)
+	d.SetId(bktID)
+	return resourceIBMCOSBucketLifecycleConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketLifecycleConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	bucketName := parseBucketConfigId(d.Id(), "bucketName")
+	bucketLocation := parseBucketConfigId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketConfigId(d.Id(), "instanceCRN")
+	endpointType := d.Get("endpoint_type").(string)
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	if d.HasChange("lifecycle_rule") {
+		err = putBucketLifecycleConfiguration(s3Client, bucketName, lifecycleRuleSetFunction(d.Get("lifecycle_rule").([]interface{})))
+		if err != nil {
+			return fmt.Errorf("failed to update lifecycle configuration on the COS bucket %s, %v", bucketName, err)
+		}
+	}
+	if d.HasChange("endpoint_type") {
+		d.SetId(fmt.Sprintf("%s:meta:%s:%s", parseBucketConfigId(d.Id(), "bucketCRN"), bucketLocation, endpointType))
+	}
+	return resourceIBMCOSBucketLifecycleConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketLifecycleConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := parseBucketConfigId(d.Id(), "bucketCRN")
+	bucketName := parseBucketConfigId(d.Id(), "bucketName")
+	bucketLocation := parseBucketConfigId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketConfigId(d.Id(), "instanceCRN")
+	endpointType := parseBucketConfigId(d.Id(), "endpointType")
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	if endpointType != "" {
+		d.Set("endpoint_type", endpointType)
+	}
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	rules, err := getBucketLifecycleConfiguration(s3Client, bucketName)
+	if err != nil {
+		// The configuration was removed outside of terraform
+		if strings.Contains(err.Error(), "NoSuchLifecycleConfiguration") || strings.Contains(err.Error(), "NoSuchBucket") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("failed to read lifecycle configuration of the COS bucket %s, %v", bucketName, err)
+	}
+	d.Set("lifecycle_rule", lifecycleRuleGet(rules))
+	return nil
+}
+
+func resourceIBMCOSBucketLifecycleConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	bucketName := parseBucketConfigId(d.Id(), "bucketName")
+	bucketLocation := parseBucketConfigId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketConfigId(d.Id(), "instanceCRN")
+	endpointType := parseBucketConfigId(d.Id(), "endpointType")
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	deleteBucketLifecycleInput := &s3.DeleteBucketLifecycleInput{
+		Bucket: aws.String(bucketName),
+	}
+	_, err = s3Client.DeleteBucketLifecycle(deleteBucketLifecycleInput)
+	if err != nil {
+		return fmt.Errorf("failed to delete the lifecycle configuration on the COS bucket %s, %v", bucketName, err)
+	}
+	return nil
+}
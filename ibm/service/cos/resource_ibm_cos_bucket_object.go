@@ -6,7 +6,9 @@ package cos
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
@@ -24,6 +26,7 @@ import (
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -96,10 +99,11 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				Default:      "public",
 			},
 			"etag": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				Description: "COS object MD5 hexdigest",
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				DiffSuppressFunc: suppressCOSObjectMultipartETagDiff,
+				Description:      "COS object MD5 hexdigest",
 			},
 			"key": {
 				Type:        schema.TypeString,
@@ -151,7 +155,22 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				Optional:    true,
 				Description: "Redirect a request to another object or an URL",
 			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntAtLeast(5),
+				Description:  "Size in MiB of the parts of a multipart upload. Objects larger than one part are uploaded in parts",
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of parts of a multipart upload that are uploaded in parallel",
+			},
 		},
+		CustomizeDiff: resourceIBMCOSBucketObjectCustomizeDiff,
 	}
 }
 
@@ -203,17 +222,17 @@ func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceDat
 		}()
 	}
 
-	putInput := &s3.PutObjectInput{
+	uploadInput := &s3manager.UploadInput{
 		Bucket: aws.String(bucketName),
 		Key:    aws.String(objectKey),
 		Body:   body,
 	}
 	//if website redirect location if given for a an object
 	if v, ok := d.GetOk("website_redirect"); ok {
-		putInput.WebsiteRedirectLocation = aws.String(v.(string))
+		uploadInput.WebsiteRedirectLocation = aws.String(v.(string))
 	}
 
-	if _, err := s3Client.PutObject(putInput); err != nil {
+	if err := uploadCOSObject(ctx, s3Client, uploadInput, d.Get("part_size").(int), d.Get("upload_concurrency").(int)); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
 	}
 	if v, ok := d.GetOk("object_lock_mode"); ok {
@@ -394,18 +413,18 @@ func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceDat
 
 		objectKey := d.Get("key").(string)
 
-		putInput := &s3.PutObjectInput{
+		uploadInput := &s3manager.UploadInput{
 			Bucket: aws.String(bucketName),
 			Key:    aws.String(objectKey),
 			Body:   body,
 		}
 		if d.HasChange("website_redirect") {
 			if v, ok := d.GetOk("website_redirect"); ok {
-				putInput.WebsiteRedirectLocation = aws.String(v.(string))
+				uploadInput.WebsiteRedirectLocation = aws.String(v.(string))
 			}
 		}
 
-		if _, err := s3Client.PutObject(putInput); err != nil {
+		if err := uploadCOSObject(ctx, s3Client, uploadInput, d.Get("part_size").(int), d.Get("upload_concurrency").(int)); err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
 		}
 
@@ -476,6 +495,120 @@ func resourceIBMCOSBucketObjectDelete(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
+// resourceIBMCOSBucketObjectCustomizeDiff plans a new upload when the content
+// file changed and no etag is configured to track it.
+func resourceIBMCOSBucketObjectCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	path, ok := diff.GetOk("content_file")
+	if !ok || diff.Id() == "" || !diff.GetRawConfig().GetAttr("etag").IsNull() {
+		return nil
+	}
+	localETag, err := computeCOSObjectETag(path.(string), diff.Get("part_size").(int))
+	if err != nil {
+		return err
+	}
+	old, _ := diff.GetChange("etag")
+	if old.(string) == localETag {
+		return nil
+	}
+	// An object uploaded in a single request, for example outside of Terraform or with a
+	// larger part size, has the MD5 of the content as its ETag
+	if strings.Contains(localETag, "-") {
+		localMD5, err := computeCOSObjectMD5(path.(string))
+		if err != nil {
+			return err
+		}
+		if old.(string) == localMD5 {
+			return nil
+		}
+	}
+	return diff.SetNew("etag", localETag)
+}
+
+// suppressCOSObjectMultipartETagDiff ignores the difference between the MD5 of
+// the content file, as given by filemd5(), and the ETag of the object when the
+// object was uploaded in parts from the same content.
+func suppressCOSObjectMultipartETagDiff(k, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+	if !strings.Contains(old, "-") {
+		return false
+	}
+	path, ok := d.GetOk("content_file")
+	if !ok {
+		return false
+	}
+	localETag, err := computeCOSObjectETag(path.(string), d.Get("part_size").(int))
+	if err != nil {
+		return false
+	}
+	return localETag == old
+}
+
+// uploadCOSObject uploads an object in a single request, or in parts of
+// partSize MiB when it is larger than one part.
+func uploadCOSObject(ctx context.Context, s3Client *s3.S3, input *s3manager.UploadInput, partSize, concurrency int) error {
+	uploader := s3manager.NewUploaderWithClient(s3Client, func(u *s3manager.Uploader) {
+		u.PartSize = int64(partSize) * 1024 * 1024
+		u.Concurrency = concurrency
+	})
+	_, err := uploader.UploadWithContext(ctx, input)
+	return err
+}
+
+// computeCOSObjectETag computes the ETag that COS returns for the content of a
+// file uploaded by uploadCOSObject: the MD5 of the content for a single request,
+// or the MD5 of the MD5 of the parts followed by the number of parts.
+func computeCOSObjectETag(path string, partSize int) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err)
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error reading COS object file (%s): %s", path, err)
+	}
+
+	size := info.Size()
+	partBytes := int64(partSize) * 1024 * 1024
+	// The same adjustment as the uploader for the files that exceed the maximum number of parts
+	if size/partBytes >= int64(s3manager.MaxUploadParts) {
+		partBytes = (size / int64(s3manager.MaxUploadParts)) + 1
+	}
+
+	if size <= partBytes {
+		return computeCOSObjectMD5(path)
+	}
+
+	parts := md5.New()
+	count := 0
+	for offset := int64(0); offset < size; offset += partBytes {
+		hash := md5.New()
+		if _, err := io.Copy(hash, io.NewSectionReader(file, offset, partBytes)); err != nil {
+			return "", fmt.Errorf("[ERROR] Error reading COS object file (%s): %s", path, err)
+		}
+		parts.Write(hash.Sum(nil))
+		count++
+	}
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(parts.Sum(nil)), count), nil
+}
+
+// computeCOSObjectMD5 computes the MD5 of the content of a file, the ETag of an
+// object uploaded in a single request.
+func computeCOSObjectMD5(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err)
+	}
+	defer file.Close()
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("[ERROR] Error reading COS object file (%s): %s", path, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
 func getCosEndpoint(bucketLocation string, endpointType string) string {
 	if bucketLocation != "" {
 		switch endpointType {
@@ -0,0 +1,417 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMCOSBucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketObjectsSyncCreate,
+		ReadContext:   resourceIBMCOSBucketObjectsSyncRead,
+		UpdateContext: resourceIBMCOSBucketObjectsSyncUpdate,
+		DeleteContext: resourceIBMCOSBucketObjectsSyncDelete,
+		CustomizeDiff: resourceIBMCOSBucketObjectsSyncCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"delete_extra": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the objects under the prefix that have no file in the source directory",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"exclude": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Glob patterns of the files that are not synchronized",
+			},
+			"files": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The ETag of the synchronized objects, by object key",
+			},
+			"include": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Glob patterns of the files that are synchronized, all the files when not set",
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntAtLeast(5),
+				Description:  "Size in MiB of the parts of a multipart upload. Files larger than one part are uploaded in parts",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The key prefix of the synchronized objects, for example site/",
+			},
+			"source_dir": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The local directory that is mirrored into the bucket",
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of parts of a multipart upload that are uploaded in parallel",
+			},
+		},
+	}
+}
+
+// cosSyncFile is a file of the source directory with the key of its object
+type cosSyncFile struct {
+	path string
+	etag string
+}
+
+func resourceIBMCOSBucketObjectsSyncCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	// The source directory may not exist yet, for example when it is built by another resource
+	if !diff.NewValueKnown("source_dir") {
+		return diff.SetNewComputed("files")
+	}
+	local, err := listCOSSyncFiles(diff.Get("source_dir").(string), diff.Get("prefix").(string),
+		flex.ExpandStringList(diff.Get("include").([]interface{})), flex.ExpandStringList(diff.Get("exclude").([]interface{})), diff.Get("part_size").(int))
+	if err != nil {
+		return err
+	}
+	files := make(map[string]interface{}, len(local))
+	for key, file := range local {
+		files[key] = file.etag
+	}
+	old := diff.Get("files").(map[string]interface{})
+	if len(old) != len(files) {
+		return diff.SetNew("files", files)
+	}
+	for key, etag := range files {
+		if old[key] != etag {
+			return diff.SetNew("files", files)
+		}
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketObjectsSyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketLocation := d.Get("bucket_location").(string)
+	prefix := d.Get("prefix").(string)
+
+	d.SetId(fmt.Sprintf("%s:sync:%s:location:%s", bucketCRN, prefix, bucketLocation))
+
+	return resourceIBMCOSBucketObjectsSyncUpdate(ctx, d, m)
+}
+
+func resourceIBMCOSBucketObjectsSyncUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	prefix := d.Get("prefix").(string)
+	include := flex.ExpandStringList(d.Get("include").([]interface{}))
+	exclude := flex.ExpandStringList(d.Get("exclude").([]interface{}))
+
+	s3Client, err := getCOSBucketObjectsSyncClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	local, err := listCOSSyncFiles(d.Get("source_dir").(string), prefix, include, exclude, d.Get("part_size").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	remote, err := listCOSSyncObjects(s3Client, bucketName, prefix)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	files := make(map[string]interface{}, len(local))
+	for key, file := range local {
+		if remote[key] != file.etag {
+			log.Printf("[INFO] Uploading %s to COS bucket (%s) object (%s)", file.path, bucketName, key)
+			if err := uploadCOSSyncFile(ctx, s3Client, bucketName, key, file.path, d.Get("part_size").(int), d.Get("upload_concurrency").(int)); err != nil {
+				d.Set("files", files)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", key, bucketName, err))
+			}
+		}
+		files[key] = file.etag
+	}
+
+	// The objects of the files removed from the source directory, and the extra objects when asked
+	var extra []string
+	oldFiles, _ := d.GetChange("files")
+	for key := range oldFiles.(map[string]interface{}) {
+		if _, ok := local[key]; !ok {
+			extra = append(extra, key)
+		}
+	}
+	if d.Get("delete_extra").(bool) {
+		for key := range remote {
+			if _, ok := local[key]; ok {
+				continue
+			}
+			if _, ok := oldFiles.(map[string]interface{})[key]; ok {
+				continue
+			}
+			if cosSyncKeyMatches(strings.TrimPrefix(key, prefix), include, exclude) {
+				extra = append(extra, key)
+			}
+		}
+	}
+	if err := deleteCOSSyncObjects(s3Client, bucketName, extra); err != nil {
+		d.Set("files", files)
+		return diag.FromErr(err)
+	}
+
+	d.Set("files", files)
+	return resourceIBMCOSBucketObjectsSyncRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketObjectsSyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	prefix := d.Get("prefix").(string)
+
+	s3Client, err := getCOSBucketObjectsSyncClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	remote, err := listCOSSyncObjects(s3Client, bucketName, prefix)
+	if err != nil {
+		if strings.Contains(err.Error(), "NoSuchBucket") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// Only the objects of the resource are tracked, plus the extra objects that are to be deleted
+	files := make(map[string]interface{})
+	for key := range d.Get("files").(map[string]interface{}) {
+		if etag, ok := remote[key]; ok {
+			files[key] = etag
+		}
+	}
+	if d.Get("delete_extra").(bool) {
+		include := flex.ExpandStringList(d.Get("include").([]interface{}))
+		exclude := flex.ExpandStringList(d.Get("exclude").([]interface{}))
+		for key, etag := range remote {
+			if cosSyncKeyMatches(strings.TrimPrefix(key, prefix), include, exclude) {
+				files[key] = etag
+			}
+		}
+	}
+	d.Set("files", files)
+	return nil
+}
+
+func resourceIBMCOSBucketObjectsSyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+
+	s3Client, err := getCOSBucketObjectsSyncClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var keys []string
+	for key := range d.Get("files").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+	if err := deleteCOSSyncObjects(s3Client, bucketName, keys); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func getCOSBucketObjectsSyncClient(d *schema.ResourceData, m interface{}) (*s3.S3, error) {
+	bucketCRN := d.Get("bucket_crn").(string)
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	return getS3Client(bxSession, d.Get("bucket_location").(string), d.Get("endpoint_type").(string), instanceCRN)
+}
+
+// cosSyncKeyMatches tells whether a path, relative to the source directory, is
+// synchronized. A pattern without a slash also matches the name of the file, and
+// a pattern ending with /** matches everything under a directory.
+func cosSyncKeyMatches(rel string, include, exclude []string) bool {
+	matchAny := func(patterns []string) bool {
+		for _, pattern := range patterns {
+			if dir := strings.TrimSuffix(pattern, "/**"); dir != pattern && strings.HasPrefix(rel, dir+"/") {
+				return true
+			}
+			if ok, _ := path.Match(pattern, rel); ok {
+				return true
+			}
+			if !strings.Contains(pattern, "/") {
+				if ok, _ := path.Match(pattern, path.Base(rel)); ok {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	if len(include) > 0 && !matchAny(include) {
+		return false
+	}
+	return !matchAny(exclude)
+}
+
+func listCOSSyncFiles(sourceDir, prefix string, include, exclude []string, partSize int) (map[string]cosSyncFile, error) {
+	files := make(map[string]cosSyncFile)
+	err := filepath.WalkDir(sourceDir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(sourceDir, filePath)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if !cosSyncKeyMatches(rel, include, exclude) {
+			return nil
+		}
+		etag, err := computeCOSObjectETag(filePath, partSize)
+		if err != nil {
+			return err
+		}
+		files[prefix+rel] = cosSyncFile{path: filePath, etag: etag}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading source directory (%s): %s", sourceDir, err)
+	}
+	return files, nil
+}
+
+func listCOSSyncObjects(s3Client *s3.S3, bucketName, prefix string) (map[string]string, error) {
+	objects := make(map[string]string)
+	input := &s3.ListObjectsInput{
+		Bucket: aws.String(bucketName),
+		Prefix: aws.String(prefix),
+	}
+	err := s3Client.ListObjectsPages(input, func(page *s3.ListObjectsOutput, lastPage bool) bool {
+		for _, object := range page.Contents {
+			objects[aws.StringValue(object.Key)] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing objects of COS bucket (%s): %s", bucketName, err)
+	}
+	return objects, nil
+}
+
+func uploadCOSSyncFile(ctx context.Context, s3Client *s3.S3, bucketName, key, filePath string, partSize, concurrency int) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", filePath, err)
+	}
+	defer file.Close()
+
+	contentType := mime.TypeByExtension(filepath.Ext(filePath))
+	if contentType == "" {
+		head := make([]byte, 512)
+		n, err := io.ReadFull(file, head)
+		if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+			return err
+		}
+		contentType = http.DetectContentType(head[:n])
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+	}
+
+	return uploadCOSObject(ctx, s3Client, &s3manager.UploadInput{
+		Bucket:      aws.String(bucketName),
+		Key:         aws.String(key),
+		Body:        file,
+		ContentType: aws.String(contentType),
+	}, partSize, concurrency)
+}
+
+func deleteCOSSyncObjects(s3Client *s3.S3, bucketName string, keys []string) error {
+	// DeleteObjects accepts up to 1000 keys per request
+	for start := 0; start < len(keys); start += 1000 {
+		end := start + 1000
+		if end > len(keys) {
+			end = len(keys)
+		}
+		objects := make([]*s3.ObjectIdentifier, 0, end-start)
+		for _, key := range keys[start:end] {
+			log.Printf("[INFO] Deleting COS bucket (%s) object (%s)", bucketName, key)
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+		out, err := s3Client.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucketName),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error deleting objects of COS bucket (%s): %s", bucketName, err)
+		}
+		if len(out.Errors) > 0 {
+			return fmt.Errorf("[ERROR] Error deleting COS bucket (%s) object (%s): %s", bucketName, aws.StringValue(out.Errors[0].Key), aws.StringValue(out.Errors[0].Message))
+		}
+	}
+	return nil
+}
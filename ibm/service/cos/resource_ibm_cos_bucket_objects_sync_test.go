@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSBucketObjectsSync_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	sourceDir := t.TempDir()
+	os.MkdirAll(filepath.Join(sourceDir, "css"), 0755)
+	os.WriteFile(filepath.Join(sourceDir, "index.html"), []byte("<html>Acceptance Testing</html>"), 0644)
+	os.WriteFile(filepath.Join(sourceDir, "css", "site.css"), []byte("body { margin: 0; }"), 0644)
+	os.WriteFile(filepath.Join(sourceDir, "notes.tmp"), []byte("excluded"), 0644)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectsSyncConfig(name, instanceCRN, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects_sync.testacc", "id"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects_sync.testacc", "files.%", "2"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects_sync.testacc", "files.site/index.html"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects_sync.testacc", "files.site/css/site.css"),
+				),
+			},
+			{
+				PreConfig: func() {
+					os.WriteFile(filepath.Join(sourceDir, "index.html"), []byte("<html>Updated</html>"), 0644)
+					os.Remove(filepath.Join(sourceDir, "css", "site.css"))
+				},
+				Config: testAccIBMCOSBucketObjectsSyncConfig(name, instanceCRN, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects_sync.testacc", "files.%", "1"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects_sync.testacc", "files.site/index.html"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketObjectsSyncConfig(name string, instanceCRN string, sourceDir string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_objects_sync" "testacc" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			source_dir      = "%[3]s"
+			prefix          = "site/"
+			exclude         = ["*.tmp"]
+			delete_extra    = true
+		}`, name, instanceCRN, sourceDir)
+}
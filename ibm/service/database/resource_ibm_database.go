@@ -140,7 +140,8 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			resourceIBMDatabaseInstanceDiff,
 			validateGroupsDiff,
-			validateUsersDiff),
+			validateUsersDiff,
+			validateVersionUpgradeDiff),
 
 		Importer: &schema.ResourceImporter{},
 
@@ -240,11 +241,15 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				Description: "The configuration schema in JSON format",
 			},
 			"version": {
-				Description: "The database version to provision if specified",
+				Description: "The database version to provision if specified. A change of version upgrades the database in place when the upgrade path is supported",
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				ForceNew:    true,
+			},
+			"version_upgrade_skip_backup": {
+				Description: "Skip the backup that is taken before an in-place upgrade of the database version",
+				Type:        schema.TypeBool,
+				Optional:    true,
 			},
 			"service_endpoints": {
 				Description:  "Types of the service endpoints. Possible values are 'public', 'private', 'public-and-private'.",
@@ -367,6 +372,11 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 					},
 				},
 			},
+			"ignore_external_allowlist_entries": {
+				Description: "Only manage the allowlist entries of the allowlist blocks, the other entries of the deployment are left to ibm_database_allowlist_entry",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
 			"logical_replication_slot": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -1692,7 +1702,11 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database allowlist: %s", err))
 	}
 
-	d.Set("allowlist", flex.FlattenAllowlist(allowlist.IPAddresses))
+	if d.Get("ignore_external_allowlist_entries").(bool) {
+		d.Set("allowlist", flex.FlattenAllowlist(filterAllowlistEntries(allowlist.IPAddresses, d.Get("allowlist").(*schema.Set))))
+	} else {
+		d.Set("allowlist", flex.FlattenAllowlist(allowlist.IPAddresses))
+	}
 
 	//ICD does not implement a GetUsers API. Users populated from tf configuration.
 	tfusers := d.Get("users").(*schema.Set)
@@ -1784,6 +1798,31 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 	}
 	icdId := flex.EscapeUrlParm(instanceID)
 
+	// The version is upgraded first, the configuration may only be valid for the new version
+	if d.HasChange("version") {
+		version := d.Get("version").(string)
+
+		// The resource controller backs up the deployment before the upgrade unless it is skipped
+		taskID, err := upgradeDeploymentVersion(rsConClient, cloudDatabasesClient, instanceID, version, d.Get("version_upgrade_skip_backup").(bool))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error upgrading database (%s) to version %s: %s", icdId, version, err))
+		}
+
+		if taskID != "" {
+			_, err = waitForDatabaseTaskComplete(taskID, d, meta, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf(
+					"[ERROR] Error waiting for database (%s) version upgrade task to complete: %s", icdId, err))
+			}
+		}
+
+		_, err = waitForDatabaseInstanceUpdate(d, meta)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(
+				"[ERROR] Error waiting for upgrade of resource instance (%s) to complete: %s", d.Id(), err))
+		}
+	}
+
 	if d.HasChange("configuration") {
 		if config, ok := d.GetOk("configuration"); ok {
 			var rawConfig map[string]json.RawMessage
@@ -1974,7 +2013,13 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		}
 	}
 
-	if d.HasChange("allowlist") {
+	if d.HasChange("allowlist") && d.Get("ignore_external_allowlist_entries").(bool) {
+		oldList, newList := d.GetChange("allowlist")
+		err = updateAllowlistEntries(instanceID, oldList.(*schema.Set), newList.(*schema.Set), d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if d.HasChange("allowlist") {
 		_, hasAllowlist := d.GetOk("allowlist")
 
 		var entries interface{}
@@ -2901,6 +2946,153 @@ func getCpuEnforcementRatios(service string, plan string, hostFlavor string, met
 	return nil, 0, 0
 }
 
+func validateVersionUpgradeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
+	if diff.Id() == "" || !diff.HasChange("version") || !diff.NewValueKnown("version") {
+		return nil
+	}
+
+	service := diff.Get("service").(string)
+	oldVersion, newVersion := diff.GetChange("version")
+	if oldVersion.(string) == "" || newVersion.(string) == "" {
+		return nil
+	}
+
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+
+	listDeployablesResponse, response, err := cloudDatabasesClient.ListDeployables(&clouddatabasesv5.ListDeployablesOptions{})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing the database versions: %s\n%s", err, response)
+	}
+
+	deployableType := strings.TrimPrefix(strings.TrimPrefix(service, "databases-for-"), "messages-for-")
+	var upgrades []string
+	for _, deployable := range listDeployablesResponse.Deployables {
+		if deployable.Type == nil || *deployable.Type != deployableType {
+			continue
+		}
+		for _, version := range deployable.Versions {
+			for _, transition := range version.Transitions {
+				if transition.FromVersion == nil || *transition.FromVersion != oldVersion.(string) || transition.ToVersion == nil {
+					continue
+				}
+				if transition.Method != nil && *transition.Method != "in-place" {
+					continue
+				}
+				if *transition.ToVersion == newVersion.(string) {
+					return nil
+				}
+				upgrades = append(upgrades, *transition.ToVersion)
+			}
+		}
+	}
+
+	if len(upgrades) == 0 {
+		return fmt.Errorf("[ERROR] %s version %s can not be upgraded in place, create a new database with version %s from a backup of this one instead", service, oldVersion, newVersion)
+	}
+	return fmt.Errorf("[ERROR] %s version %s can not be upgraded in place to version %s, the supported versions are: %s", service, oldVersion, newVersion, strings.Join(upgrades, ", "))
+}
+
+// upgradeDeploymentVersion starts the in-place upgrade of the major version of a
+// deployment through the resource controller and returns the ID of its task
+func upgradeDeploymentVersion(rsConClient *rc.ResourceControllerV2, cloudDatabasesClient *clouddatabasesv5.CloudDatabasesV5, instanceID string, version string, skipBackup bool) (string, error) {
+	updateReq := rc.UpdateResourceInstanceOptions{
+		ID: &instanceID,
+		Parameters: map[string]interface{}{
+			"version":     version,
+			"skip_backup": skipBackup,
+		},
+	}
+	instance, response, err := rsConClient.UpdateResourceInstance(&updateReq)
+	if err != nil {
+		return "", fmt.Errorf("%s\n%s", err, response)
+	}
+	if instance != nil && instance.LastOperation != nil {
+		if taskID, ok := instance.LastOperation.GetProperty("task_id").(string); ok && taskID != "" {
+			return taskID, nil
+		}
+	}
+
+	// Otherwise the upgrade is the task of the deployment that is still in progress
+	listDeploymentTasksOptions := &clouddatabasesv5.ListDeploymentTasksOptions{
+		ID: &instanceID,
+	}
+	tasks, response, err := cloudDatabasesClient.ListDeploymentTasks(listDeploymentTasksOptions)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error listing the tasks of the deployment: %s\n%s", err, response)
+	}
+	for _, task := range tasks.Tasks {
+		if task.ID != nil && task.Status != nil && (*task.Status == "queued" || *task.Status == "running") {
+			return *task.ID, nil
+		}
+	}
+	return "", nil
+}
+
+// filterAllowlistEntries returns the entries of the deployment allowlist whose
+// address is in the allowlist of the configuration
+func filterAllowlistEntries(entries []clouddatabasesv5.AllowlistEntry, allowlist *schema.Set) []clouddatabasesv5.AllowlistEntry {
+	addresses := make(map[string]bool)
+	for _, iface := range allowlist.List() {
+		addresses[iface.(map[string]interface{})["address"].(string)] = true
+	}
+	filtered := make([]clouddatabasesv5.AllowlistEntry, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Address != nil && addresses[*entry.Address] {
+			filtered = append(filtered, entry)
+		}
+	}
+	return filtered
+}
+
+// updateAllowlistEntries deletes and adds the changed entries one by one instead
+// of replacing the whole allowlist, which keeps the entries managed elsewhere
+func updateAllowlistEntries(instanceID string, oldList *schema.Set, newList *schema.Set, d *schema.ResourceData, meta interface{}) error {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+
+	for _, entry := range flex.ExpandAllowlist(oldList.Difference(newList)) {
+		deleteAllowlistEntryOptions := &clouddatabasesv5.DeleteAllowlistEntryOptions{
+			ID:        &instanceID,
+			Ipaddress: entry.Address,
+		}
+		deleteAllowlistEntryResponse, response, err := cloudDatabasesClient.DeleteAllowlistEntry(deleteAllowlistEntryOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error deleting database allowlist entry (%s): %s\n%s", *entry.Address, err, response)
+		}
+
+		_, err = waitForDatabaseTaskComplete(*deleteAllowlistEntryResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf(
+				"[ERROR] Error waiting for database (%s) allowlist entry (%s) delete task to complete: %s", instanceID, *entry.Address, err)
+		}
+	}
+
+	for _, entry := range flex.ExpandAllowlist(newList.Difference(oldList)) {
+		entry := entry
+		addAllowlistEntryOptions := &clouddatabasesv5.AddAllowlistEntryOptions{
+			ID:        &instanceID,
+			IPAddress: &entry,
+		}
+		addAllowlistEntryResponse, response, err := cloudDatabasesClient.AddAllowlistEntry(addAllowlistEntryOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error adding database allowlist entry (%s): %s\n%s", *entry.Address, err, response)
+		}
+
+		_, err = waitForDatabaseTaskComplete(*addAllowlistEntryResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf(
+				"[ERROR] Error waiting for database (%s) allowlist entry (%s) add task to complete: %s", instanceID, *entry.Address, err)
+		}
+	}
+
+	return nil
+}
+
 func validateUsersDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
 	service := diff.Get("service").(string)
 
@@ -2933,34 +3125,42 @@ func validateUsersDiff(_ context.Context, diff *schema.ResourceDiff, meta interf
 		}
 
 		if change.isCreate() || change.isUpdate() {
-			err = change.New.ValidatePassword()
+			err = change.New.Validate(service, version)
 
 			if err != nil {
 				return err
 			}
+		}
+	}
 
-			// TODO: Use Capability API
-			// RBAC roles supported for Redis 6.0 and above
-			if (service == "databases-for-redis") && !(version > 0 && version < 6) {
-				err = change.New.ValidateRBACRole()
-			} else if service == "databases-for-mongodb" && change.New.Type == "ops_manager" {
-				err = change.New.ValidateOpsManagerRole()
-			} else {
-				if change.New.Role != nil {
-					if *change.New.Role != "" {
-						err = errors.New("role is not supported for this deployment or user type")
-						err = &databaseUserValidationError{user: change.New, errs: []error{err}}
-					}
-				}
-			}
+	return
+}
 
-			if err != nil {
-				return err
+// Validate validates the password and the role of a user of a deployment of
+// the service, version is the major version of the deployment or 0 for the latest
+func (u *DatabaseUser) Validate(service string, version int) (err error) {
+	err = u.ValidatePassword()
+
+	if err != nil {
+		return err
+	}
+
+	// TODO: Use Capability API
+	// RBAC roles supported for Redis 6.0 and above
+	if (service == "databases-for-redis") && !(version > 0 && version < 6) {
+		err = u.ValidateRBACRole()
+	} else if service == "databases-for-mongodb" && u.Type == "ops_manager" {
+		err = u.ValidateOpsManagerRole()
+	} else {
+		if u.Role != nil {
+			if *u.Role != "" {
+				err = errors.New("role is not supported for this deployment or user type")
+				err = &databaseUserValidationError{user: u, errs: []error{err}}
 			}
 		}
 	}
 
-	return
+	return err
 }
 
 func expandUsers(_users []interface{}) []*DatabaseUser {
@@ -0,0 +1,177 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMDatabaseAllowlistEntry() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseAllowlistEntryCreate,
+		ReadContext:   resourceIBMDatabaseAllowlistEntryRead,
+		DeleteContext: resourceIBMDatabaseAllowlistEntryDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Description: "The CRN of the database deployment",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"address": {
+				Description:  "Allowlist IP address in CIDR notation",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateCIDR,
+			},
+			"description": {
+				Description:  "Unique allow list description",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+		},
+	}
+}
+
+// parseDatabaseAllowlistEntryID parses <deployment_id>:allowlist:<address>, the
+// deployment CRN and the address both contain slashes
+func parseDatabaseAllowlistEntryID(id string) (string, string, error) {
+	parts := strings.Split(id, ":allowlist:")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of deploymentID:allowlist:address", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceIBMDatabaseAllowlistEntryCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	deploymentID := d.Get("deployment_id").(string)
+	address := d.Get("address").(string)
+
+	// A deployment runs one task at a time
+	conns.IbmMutexKV.Lock(deploymentID)
+	defer conns.IbmMutexKV.Unlock(deploymentID)
+
+	addAllowlistEntryOptions := &clouddatabasesv5.AddAllowlistEntryOptions{
+		ID: &deploymentID,
+		IPAddress: &clouddatabasesv5.AllowlistEntry{
+			Address:     &address,
+			Description: core.StringPtr(d.Get("description").(string)),
+		},
+	}
+
+	addAllowlistEntryResponse, response, err := cloudDatabasesClient.AddAllowlistEntryWithContext(context, addAllowlistEntryOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error adding database allowlist entry (%s): %s\n%s", address, err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s:allowlist:%s", deploymentID, address))
+
+	_, err = waitForDatabaseTaskComplete(*addAllowlistEntryResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) allowlist entry (%s) add task to complete: %s", deploymentID, address, err))
+	}
+
+	return resourceIBMDatabaseAllowlistEntryRead(context, d, meta)
+}
+
+func resourceIBMDatabaseAllowlistEntryRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	deploymentID, address, err := parseDatabaseAllowlistEntryID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getAllowlistOptions := &clouddatabasesv5.GetAllowlistOptions{
+		ID: &deploymentID,
+	}
+	allowlist, response, err := cloudDatabasesClient.GetAllowlistWithContext(context, getAllowlistOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database allowlist: %s", err))
+	}
+
+	for _, entry := range allowlist.IPAddresses {
+		if entry.Address != nil && *entry.Address == address {
+			d.Set("deployment_id", deploymentID)
+			d.Set("address", address)
+			d.Set("description", entry.Description)
+			return nil
+		}
+	}
+
+	// The entry was removed outside of terraform
+	d.SetId("")
+	return nil
+}
+
+func resourceIBMDatabaseAllowlistEntryDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	deploymentID, address, err := parseDatabaseAllowlistEntryID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	conns.IbmMutexKV.Lock(deploymentID)
+	defer conns.IbmMutexKV.Unlock(deploymentID)
+
+	deleteAllowlistEntryOptions := &clouddatabasesv5.DeleteAllowlistEntryOptions{
+		ID:        &deploymentID,
+		Ipaddress: &address,
+	}
+	deleteAllowlistEntryResponse, response, err := cloudDatabasesClient.DeleteAllowlistEntryWithContext(context, deleteAllowlistEntryOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting database allowlist entry (%s): %s\n%s", address, err, response))
+	}
+
+	_, err = waitForDatabaseTaskComplete(*deleteAllowlistEntryResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) allowlist entry (%s) delete task to complete: %s", deploymentID, address, err))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseAllowlistEntryBasic(t *testing.T) {
+	t.Parallel()
+	databaseResourceGroup := "default"
+	serviceName := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_database." + serviceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseAllowlistEntryBasic(databaseResourceGroup, serviceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_database_allowlist_entry.entry", "address", "172.168.1.2/32"),
+					resource.TestCheckResourceAttr("ibm_database_allowlist_entry.entry", "description", "app-team"),
+					resource.TestCheckResourceAttr(resourceName, "allowlist.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "ibm_database_allowlist_entry.entry",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseAllowlistEntryBasic(databaseResourceGroup string, name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+		# name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id                 = data.ibm_resource_group.test_acc.id
+		name                              = "%[2]s"
+		service                           = "databases-for-postgresql"
+		plan                              = "standard"
+		location                          = "%[3]s"
+		ignore_external_allowlist_entries = true
+
+		allowlist {
+			address     = "10.0.0.0/24"
+			description = "platform"
+		}
+	}
+
+	resource "ibm_database_allowlist_entry" "entry" {
+		deployment_id = ibm_database.%[2]s.id
+		address       = "172.168.1.2/32"
+		description   = "app-team"
+	}
+				`, databaseResourceGroup, name, acc.Region())
+}
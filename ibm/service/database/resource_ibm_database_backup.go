@@ -0,0 +1,396 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	databaseRestoreTestSucceeded = "succeeded"
+	databaseRestoreTestFailed    = "failed"
+	databaseBackupStatusExpired  = "expired"
+)
+
+func ResourceIBMDatabaseBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseBackupCreate,
+		ReadContext:   resourceIBMDatabaseBackupRead,
+		DeleteContext: resourceIBMDatabaseBackupDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Description: "The CRN of the database deployment to back up",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"triggers": {
+				Description: "Arbitrary map of values that, when changed, takes a new backup",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"restore_test": {
+				Description: "Restores the backup to a temporary deployment, checks that it becomes active and deletes it",
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_group_id": {
+							Description: "The resource group of the temporary deployment. Defaults to the resource group of the backed up deployment",
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"service_endpoints": {
+							Description:  "Types of the service endpoints of the temporary deployment",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "private",
+							ValidateFunc: validation.StringInSlice([]string{"public", "private", "public-and-private"}, false),
+						},
+						"fail_on_error": {
+							Description: "Fail the apply when the restore test fails, instead of only recording the failure",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							ForceNew:    true,
+							Default:     false,
+						},
+						"deployment_id": {
+							Description: "The CRN of the temporary deployment",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "The result of the restore test, succeeded or failed",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"message": {
+							Description: "The error of a failed restore test",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"started_at": {
+							Description: "Date and time when the restore test started",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"completed_at": {
+							Description: "Date and time when the temporary deployment was deleted",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"restore_duration": {
+							Description: "The time the restore took until the temporary deployment was active, in seconds",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"backup_id": {
+				Description: "The ID of the backup",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description: "The type of backup",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "The status of this backup, expired once the backup is past the retention period of the deployment",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"is_downloadable": {
+				Description: "Is this backup available to download?",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"is_restorable": {
+				Description: "Can this backup be used to restore an instance?",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "Date and time when this backup was created",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceIBMDatabaseBackupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	deploymentID := d.Get("deployment_id").(string)
+
+	backupID, err := startDatabaseBackup(context, cloudDatabasesClient, deploymentID, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(backupID)
+
+	var diags diag.Diagnostics
+	if _, ok := d.GetOk("restore_test"); ok {
+		restoreTest := d.Get("restore_test").([]interface{})[0].(map[string]interface{})
+		result, err := runDatabaseRestoreTest(context, d, meta, deploymentID, backupID, restoreTest)
+		if err != nil {
+			// The temporary deployment could not be deleted, keep the backup in the state and surface it
+			diags = append(diags, diag.FromErr(err)...)
+		} else if result["status"] == databaseRestoreTestFailed {
+			if restoreTest["fail_on_error"].(bool) {
+				diags = append(diags, diag.Errorf("[ERROR] Restore test of backup (%s) failed: %s", backupID, result["message"])...)
+			} else {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Restore test of backup %s failed", backupID),
+					Detail:   result["message"].(string),
+				})
+			}
+		}
+		for k, v := range result {
+			restoreTest[k] = v
+		}
+		d.Set("restore_test", []interface{}{restoreTest})
+	}
+
+	return append(diags, resourceIBMDatabaseBackupRead(context, d, meta)...)
+}
+
+// startDatabaseBackup takes an on-demand backup and returns its ID once completed
+func startDatabaseBackup(context context.Context, cloudDatabasesClient *clouddatabasesv5.CloudDatabasesV5, deploymentID string, d *schema.ResourceData, meta interface{}) (string, error) {
+	conns.IbmMutexKV.Lock(deploymentID)
+	defer conns.IbmMutexKV.Unlock(deploymentID)
+
+	startOndemandBackupOptions := &clouddatabasesv5.StartOndemandBackupOptions{
+		ID: &deploymentID,
+	}
+	startOndemandBackupResponse, response, err := cloudDatabasesClient.StartOndemandBackupWithContext(context, startOndemandBackupOptions)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error starting the backup of database (%s): %s\n%s", deploymentID, err, response)
+	}
+	task := startOndemandBackupResponse.Task
+
+	_, err = waitForDatabaseTaskComplete(*task.ID, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error waiting for database (%s) backup task to complete: %s", deploymentID, err)
+	}
+
+	// The task does not reference the backup, pick the latest on-demand backup
+	listDeploymentBackupsOptions := &clouddatabasesv5.ListDeploymentBackupsOptions{
+		ID: &deploymentID,
+	}
+	backups, response, err := cloudDatabasesClient.ListDeploymentBackupsWithContext(context, listDeploymentBackupsOptions)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error listing the backups of database (%s): %s\n%s", deploymentID, err, response)
+	}
+
+	var latest *clouddatabasesv5.Backup
+	for i, backup := range backups.Backups {
+		if backup.Type == nil || *backup.Type != clouddatabasesv5.BackupTypeOnDemandConst || backup.CreatedAt == nil {
+			continue
+		}
+		if task.CreatedAt != nil && time.Time(*backup.CreatedAt).Before(time.Time(*task.CreatedAt)) {
+			continue
+		}
+		if latest == nil || time.Time(*backup.CreatedAt).After(time.Time(*latest.CreatedAt)) {
+			latest = &backups.Backups[i]
+		}
+	}
+	if latest == nil {
+		return "", fmt.Errorf("[ERROR] The on-demand backup of database (%s) was not found after its task completed", deploymentID)
+	}
+
+	return *latest.ID, nil
+}
+
+// runDatabaseRestoreTest restores the backup to a temporary deployment and deletes it. A failed
+// restore is recorded in the result, an error is only returned when the deployment could not be deleted.
+func runDatabaseRestoreTest(context context.Context, d *schema.ResourceData, meta interface{}, deploymentID, backupID string, restoreTest map[string]interface{}) (map[string]interface{}, error) {
+	startedAt := time.Now().UTC()
+	result := map[string]interface{}{
+		"status":     databaseRestoreTestSucceeded,
+		"message":    "",
+		"started_at": startedAt.Format(time.RFC3339),
+	}
+	fail := func(err error) (map[string]interface{}, error) {
+		log.Printf("[WARN] Restore test of backup %s failed: %s", backupID, err)
+		result["status"] = databaseRestoreTestFailed
+		result["message"] = err.Error()
+		result["completed_at"] = time.Now().UTC().Format(time.RFC3339)
+		return result, nil
+	}
+
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return nil, err
+	}
+
+	source, response, err := rsConClient.GetResourceInstanceWithContext(context, &rc.GetResourceInstanceOptions{
+		ID: &deploymentID,
+	})
+	if err != nil {
+		return fail(fmt.Errorf("error getting database (%s): %s %s", deploymentID, err, response))
+	}
+
+	name := fmt.Sprintf("%s-restore-test-%s", *source.Name, startedAt.Format("20060102150405"))
+	resourceGroupID := *source.ResourceGroupID
+	if rgID, ok := restoreTest["resource_group_id"].(string); ok && rgID != "" {
+		resourceGroupID = rgID
+	}
+
+	params := Params{
+		BackupID:         backupID,
+		ServiceEndpoints: restoreTest["service_endpoints"].(string),
+	}
+	parameters, _ := json.Marshal(params)
+	var raw map[string]interface{}
+	json.Unmarshal(parameters, &raw)
+
+	createResourceInstanceOptions := &rc.CreateResourceInstanceOptions{
+		Name:           &name,
+		Target:         source.RegionID,
+		ResourceGroup:  &resourceGroupID,
+		ResourcePlanID: source.ResourcePlanID,
+		Parameters:     raw,
+	}
+	instance, response, err := rsConClient.CreateResourceInstanceWithContext(context, createResourceInstanceOptions)
+	if err != nil {
+		return fail(fmt.Errorf("error creating the restore test database: %s %s", err, response))
+	}
+	restoreID := *instance.ID
+	result["deployment_id"] = restoreID
+	log.Printf("[INFO] Restoring backup %s to %s for the restore test", backupID, restoreID)
+
+	_, restoreErr := waitForDatabaseInstanceCreate(d, meta, restoreID)
+	if restoreErr == nil {
+		result["restore_duration"] = int(time.Since(startedAt).Seconds())
+	}
+
+	// The temporary deployment is deleted whatever the outcome of the restore
+	recursive := true
+	response, err = rsConClient.DeleteResourceInstanceWithContext(context, &rc.DeleteResourceInstanceOptions{
+		ID:        &restoreID,
+		Recursive: &recursive,
+	})
+	if err != nil && !strings.Contains(err.Error(), "Gone") && !strings.Contains(err.Error(), "status code: 410") {
+		return nil, fmt.Errorf("[ERROR] Error deleting the restore test database (%s), it must be deleted manually: %s %s", restoreID, err, response)
+	}
+	if _, err := waitForDatabaseRestoreTestDelete(d, meta, restoreID); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error waiting for the restore test database (%s) to be deleted: %s", restoreID, err)
+	}
+
+	if restoreErr != nil {
+		return fail(fmt.Errorf("restore to %s did not complete: %s", restoreID, restoreErr))
+	}
+
+	result["completed_at"] = time.Now().UTC().Format(time.RFC3339)
+	return result, nil
+}
+
+func waitForDatabaseRestoreTestDelete(d *schema.ResourceData, meta interface{}, instanceID string) (interface{}, error) {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return false, err
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{databaseInstanceProgressStatus, databaseInstanceInactiveStatus, databaseInstanceSuccessStatus, databaseInstanceProvisioningStatus, databaseInstanceFailStatus},
+		Target:  []string{databaseInstanceRemovedStatus, databaseInstanceReclamation},
+		Refresh: func() (interface{}, string, error) {
+			rsInst := rc.GetResourceInstanceOptions{
+				ID: &instanceID,
+			}
+			instance, response, err := rsConClient.GetResourceInstance(&rsInst)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return instance, databaseInstanceRemovedStatus, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] GetResourceInstance on %s failed with error %s %s", instanceID, err, response)
+			}
+			return *instance, *instance.State, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func resourceIBMDatabaseBackupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	getBackupInfoOptions := &clouddatabasesv5.GetBackupInfoOptions{}
+	getBackupInfoOptions.SetBackupID(d.Id())
+
+	backup, response, err := cloudDatabasesClient.GetBackupInfoWithContext(context, getBackupInfoOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			if d.Get("backup_id").(string) == "" {
+				// An imported backup that does not exist
+				d.SetId("")
+				return nil
+			}
+			// The backup expired, it stays in the state as the record of the backup and is only
+			// taken again when triggers changes
+			log.Printf("[WARN] Database backup %s expired", d.Id())
+			d.Set("status", databaseBackupStatusExpired)
+			d.Set("is_downloadable", false)
+			d.Set("is_restorable", false)
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database backup (%s): %s\n%s", d.Id(), err, response))
+	}
+
+	d.Set("backup_id", backup.Backup.ID)
+	d.Set("deployment_id", backup.Backup.DeploymentID)
+	d.Set("type", backup.Backup.Type)
+	d.Set("status", backup.Backup.Status)
+	d.Set("is_downloadable", backup.Backup.IsDownloadable)
+	d.Set("is_restorable", backup.Backup.IsRestorable)
+	d.Set("created_at", flex.DateTimeToString(backup.Backup.CreatedAt))
+
+	return nil
+}
+
+func resourceIBMDatabaseBackupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Backups cannot be deleted, they expire after the retention period of the deployment
+	log.Printf("[WARN] Database backup %s is only removed from the state, it expires with the retention period of the deployment", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseBackupRestoreTest(t *testing.T) {
+	t.Parallel()
+	databaseResourceGroup := "default"
+	serviceName := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseBackupRestoreTest(databaseResourceGroup, serviceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_database_backup.backup", "type", "on_demand"),
+					resource.TestCheckResourceAttr("ibm_database_backup.backup", "status", "completed"),
+					resource.TestCheckResourceAttr("ibm_database_backup.backup", "restore_test.0.status", "succeeded"),
+					resource.TestCheckResourceAttrSet("ibm_database_backup.backup", "restore_test.0.deployment_id"),
+					resource.TestCheckResourceAttrSet("ibm_database_backup.backup", "restore_test.0.completed_at"),
+				),
+			},
+			{
+				ResourceName:            "ibm_database_backup.backup",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"restore_test", "triggers"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseBackupRestoreTest(databaseResourceGroup string, name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+		# name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[2]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[3]s"
+	}
+
+	resource "ibm_database_backup" "backup" {
+		deployment_id = ibm_database.%[2]s.id
+
+		triggers = {
+			run = "1"
+		}
+
+		restore_test {
+			fail_on_error = true
+		}
+	}
+				`, databaseResourceGroup, name, acc.Region())
+}
@@ -216,6 +216,36 @@ func TestAccIBMDatabaseInstancePostgresImport(t *testing.T) {
 	})
 }
 
+func TestAccIBMDatabaseInstancePostgresVersionUpgrade(t *testing.T) {
+	t.Parallel()
+	databaseResourceGroup := "default"
+	var databaseInstanceOne string
+	serviceName := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_database." + serviceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseInstancePostgresVersion(databaseResourceGroup, serviceName, "14"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(resourceName, &databaseInstanceOne),
+					resource.TestCheckResourceAttr(resourceName, "version", "14"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseInstancePostgresVersion(databaseResourceGroup, serviceName, "16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(resourceName, &databaseInstanceOne),
+					resource.TestCheckResourceAttr(resourceName, "version", "16"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMDatabaseInstancePostgresPITR(t *testing.T) {
 	t.Parallel()
 	databaseResourceGroup := "default"
@@ -731,3 +761,25 @@ func testAccCheckIBMDatabaseInstancePostgresMinimal_PITR(databaseResourceGroup s
 	}
 				`, databaseResourceGroup, name, acc.Region())
 }
+
+func testAccCheckIBMDatabaseInstancePostgresVersion(databaseResourceGroup string, name string, version string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+		# name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[2]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[3]s"
+		version           = "%[4]s"
+
+		timeouts {
+			update = "120m"
+		}
+	}
+				`, databaseResourceGroup, name, acc.Region(), version)
+}
@@ -0,0 +1,243 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMDatabaseUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseUserCreate,
+		ReadContext:   resourceIBMDatabaseUserRead,
+		UpdateContext: resourceIBMDatabaseUserUpdate,
+		DeleteContext: resourceIBMDatabaseUserDelete,
+		CustomizeDiff: resourceIBMDatabaseUserDiff,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Description: "The CRN of the database deployment",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description:  "User name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(4, 32),
+			},
+			"password": {
+				Description:  "User password. A change of password rotates it in place, or replaces the user for the ops_manager user type",
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(15, 32),
+			},
+			"type": {
+				Description:  "User type",
+				Type:         schema.TypeString,
+				Default:      "database",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"database", "ops_manager", "read_only_replica"}, false),
+			},
+			"role": {
+				Description: "User role. Only available for ops_manager user type and Redis 6.0 and above.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// parseDatabaseUserID parses <deployment_id>:user:<type>:<name>, the deployment
+// CRN contains slashes
+func parseDatabaseUserID(id string) (string, string, string, error) {
+	parts := strings.Split(id, ":user:")
+	if len(parts) != 2 || !strings.Contains(parts[1], ":") {
+		return "", "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of deploymentID:user:type:name", id)
+	}
+	user := strings.SplitN(parts[1], ":", 2)
+	return parts[0], user[0], user[1], nil
+}
+
+func expandDatabaseUser(d schemaGetter) *DatabaseUser {
+	user := &DatabaseUser{
+		Username: d.Get("name").(string),
+		Password: d.Get("password").(string),
+		Type:     d.Get("type").(string),
+	}
+	if role, ok := d.GetOk("role"); ok {
+		user.Role = core.StringPtr(role.(string))
+	}
+	return user
+}
+
+// schemaGetter is implemented by both schema.ResourceData and schema.ResourceDiff
+type schemaGetter interface {
+	Get(string) interface{}
+	GetOk(string) (interface{}, bool)
+}
+
+func resourceIBMDatabaseUserDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// A user that cannot be updated, such as ops_manager, is replaced, and the plan shows it
+	if diff.Id() != "" && !(&DatabaseUser{Type: diff.Get("type").(string)}).isUpdatable() {
+		for _, key := range []string{"password", "role"} {
+			if diff.HasChange(key) {
+				if err := diff.ForceNew(key); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if !diff.NewValueKnown("password") {
+		return nil
+	}
+	// The role is validated on apply, against the service and version of the deployment
+	return expandDatabaseUser(diff).ValidatePassword()
+}
+
+// validateDatabaseUserRole validates the role of the user against the deployment
+func validateDatabaseUserRole(context context.Context, user *DatabaseUser, deploymentID string, meta interface{}) error {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+
+	getDeploymentInfoOptions := &clouddatabasesv5.GetDeploymentInfoOptions{
+		ID: &deploymentID,
+	}
+	getDeploymentInfoResponse, response, err := cloudDatabasesClient.GetDeploymentInfoWithContext(context, getDeploymentInfoOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting database config for: %s with error %s\n%s", deploymentID, err, response)
+	}
+
+	deployment := getDeploymentInfoResponse.Deployment
+	service := "databases-for-" + *deployment.Type
+	if *deployment.Type == "rabbitmq" {
+		service = "messages-for-rabbitmq"
+	}
+	version := 0
+	if deployment.Version != nil {
+		if v, err := strconv.ParseFloat(*deployment.Version, 64); err == nil {
+			version = int(v)
+		}
+	}
+
+	return user.Validate(service, version)
+}
+
+func resourceIBMDatabaseUserCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	deploymentID := d.Get("deployment_id").(string)
+	user := expandDatabaseUser(d)
+
+	if err := validateDatabaseUserRole(context, user, deploymentID, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// A deployment runs one task at a time
+	conns.IbmMutexKV.Lock(deploymentID)
+	defer conns.IbmMutexKV.Unlock(deploymentID)
+
+	if err := user.Create(deploymentID, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:user:%s:%s", deploymentID, user.Type, user.Username))
+
+	return resourceIBMDatabaseUserRead(context, d, meta)
+}
+
+func resourceIBMDatabaseUserRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	deploymentID, userType, name, err := parseDatabaseUserID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getDeploymentInfoOptions := &clouddatabasesv5.GetDeploymentInfoOptions{
+		ID: &deploymentID,
+	}
+	_, response, err := cloudDatabasesClient.GetDeploymentInfoWithContext(context, getDeploymentInfoOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database config for: %s with error %s\n%s", deploymentID, err, response))
+	}
+
+	// ICD does not implement a GetUsers API, the password and role are kept from the configuration
+	d.Set("deployment_id", deploymentID)
+	d.Set("type", userType)
+	d.Set("name", name)
+
+	return nil
+}
+
+func resourceIBMDatabaseUserUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	deploymentID := d.Get("deployment_id").(string)
+	user := expandDatabaseUser(d)
+
+	if d.HasChanges("password", "role") {
+		if err := validateDatabaseUserRole(context, user, deploymentID, meta); err != nil {
+			return diag.FromErr(err)
+		}
+
+		conns.IbmMutexKV.Lock(deploymentID)
+		defer conns.IbmMutexKV.Unlock(deploymentID)
+
+		// The user types that cannot be updated, such as ops_manager, are replaced by the plan instead
+		if err := user.Update(deploymentID, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMDatabaseUserRead(context, d, meta)
+}
+
+func resourceIBMDatabaseUserDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	deploymentID, userType, name, err := parseDatabaseUserID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	conns.IbmMutexKV.Lock(deploymentID)
+	defer conns.IbmMutexKV.Unlock(deploymentID)
+
+	user := &DatabaseUser{
+		Username: name,
+		Type:     userType,
+	}
+	if err := user.Delete(deploymentID, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseUserBasic(t *testing.T) {
+	t.Parallel()
+	databaseResourceGroup := "default"
+	serviceName := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseUserBasic(databaseResourceGroup, serviceName, "password12345678"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_database_user.user", "name", "appuser"),
+					resource.TestCheckResourceAttr("ibm_database_user.user", "type", "database"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseUserBasic(databaseResourceGroup, serviceName, "password87654321"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_database_user.user", "name", "appuser"),
+				),
+			},
+			{
+				ResourceName:            "ibm_database_user.user",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseUserBasic(databaseResourceGroup string, name string, password string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+		# name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[2]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[3]s"
+	}
+
+	resource "ibm_database_user" "user" {
+		deployment_id = ibm_database.%[2]s.id
+		name          = "appuser"
+		password      = "%[4]s"
+	}
+				`, databaseResourceGroup, name, acc.Region(), password)
+}
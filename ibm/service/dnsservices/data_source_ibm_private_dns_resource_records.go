@@ -6,14 +6,17 @@ package dnsservices
 import (
 	"encoding/json"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 const (
-	pdnsResourceRecords = "dns_resource_records"
+	pdnsResourceRecords         = "dns_resource_records"
+	pdnsResourceRecordsZoneFile = "zone_file"
 )
 
 func DataSourceIBMPrivateDNSResourceRecords() *schema.Resource {
@@ -30,6 +33,11 @@ func DataSourceIBMPrivateDNSResourceRecords() *schema.Resource {
 				Required:    true,
 				Description: "Zone Id",
 			},
+			pdnsResourceRecordsZoneFile: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DNS resource records of the zone in BIND zone file format",
+			},
 			pdnsResourceRecords: {
 				Type:        schema.TypeList,
 				Description: "Collection of dns resource records",
@@ -76,13 +84,26 @@ func dataSourceIBMPrivateDNSResourceRecordsRead(d *schema.ResourceData, meta int
 	}
 	instanceID := d.Get(pdnsInstanceID).(string)
 	DnszoneID := d.Get(pdnsZoneID).(string)
-	listDNSResRecOptions := sess.NewListResourceRecordsOptions(instanceID, DnszoneID)
-	availableDNSResRecs, detail, err := sess.ListResourceRecords(listDNSResRecOptions)
+	// The zone file is best-effort, the records are still listed when the zone name can not be read
+	zoneName, err := pdnsGetZoneName(sess, instanceID, DnszoneID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error reading list of pdns resource records:%s\n%s", err, detail)
+		log.Printf("[WARN] The zone file of zone %s is not exported, error getting the zone name: %s", DnszoneID, err)
+	}
+	availableDNSResRecs, err := pdnsResourceRecordsList(sess, instanceID, DnszoneID)
+	if err != nil {
+		return err
 	}
 	dnsResRecs := make([]map[string]interface{}, 0)
-	for _, instance := range availableDNSResRecs.ResourceRecords {
+	zoneRecords := make([]flex.ZoneRecord, 0, len(availableDNSResRecs))
+	for _, instance := range availableDNSResRecs {
+		if zoneName != "" {
+			if zoneRecord, err := pdnsZoneRecord(instance, zoneName); err == nil {
+				zoneRecords = append(zoneRecords, zoneRecord)
+			} else {
+				log.Printf("[WARN] Record %s is not exported to the zone file: %s", *instance.ID, err)
+			}
+		}
+
 		dnsRecord := map[string]interface{}{}
 		dnsRecord["id"] = *instance.ID
 		dnsRecord[pdnsRecordName] = *instance.Name
@@ -99,6 +120,11 @@ func dataSourceIBMPrivateDNSResourceRecordsRead(d *schema.ResourceData, meta int
 	}
 	d.SetId(dataSourceIBMPrivateDNSResourceRecordsID(d))
 	d.Set(pdnsResourceRecords, dnsResRecs)
+	if zoneName != "" {
+		d.Set(pdnsResourceRecordsZoneFile, flex.FormatZoneFile(zoneName, zoneRecords))
+	} else {
+		d.Set(pdnsResourceRecordsZoneFile, "")
+	}
 	return nil
 }
 
@@ -29,6 +29,7 @@ func TestAccIBMPrivateDNSResourceRecordsDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(node, "dns_resource_records.0.name"),
 					resource.TestCheckResourceAttrSet(node, "dns_resource_records.0.rdata"),
 					resource.TestCheckResourceAttrSet(node, "dns_resource_records.0.type"),
+					resource.TestCheckResourceAttrSet(node, "zone_file"),
 				),
 			},
 		},
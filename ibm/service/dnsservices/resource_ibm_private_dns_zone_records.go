@@ -0,0 +1,535 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package dnsservices
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	dns "github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	pdnsZoneRecordsZoneName        = "zone_name"
+	pdnsZoneRecordsZoneFile        = "zone_file"
+	pdnsZoneRecordsRecord          = "record"
+	pdnsZoneRecordsIgnoredTypes    = "ignored_types"
+	pdnsZoneRecordsBatchSize       = "batch_size"
+	pdnsZoneRecordsRecords         = "records"
+	pdnsZoneRecordsRecordsToCreate = "records_to_create"
+	pdnsZoneRecordsRecordsToUpdate = "records_to_update"
+	pdnsZoneRecordsRecordsToDelete = "records_to_delete"
+
+	pdnsZoneRecordsDefaultTTL = 900
+)
+
+var pdnsZoneRecordsDefaultIgnoredTypes = []string{"SOA", "NS"}
+
+func ResourceIBMPrivateDNSZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMPrivateDNSZoneRecordsCreate,
+		Read:     resourceIBMPrivateDNSZoneRecordsRead,
+		Update:   resourceIBMPrivateDNSZoneRecordsUpdate,
+		Delete:   resourceIBMPrivateDNSZoneRecordsDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: resourceIBMPrivateDNSZoneRecordsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			pdnsInstanceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Instance ID",
+			},
+			pdnsZoneID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Zone ID",
+			},
+			pdnsZoneRecordsZoneName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the zone",
+			},
+			pdnsZoneRecordsZoneFile: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{pdnsZoneRecordsZoneFile, pdnsZoneRecordsRecord},
+				Description:  "Records of the zone in BIND zone file format. Relative names are relative to the zone.",
+			},
+			pdnsZoneRecordsRecord: {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ExactlyOneOf: []string{pdnsZoneRecordsZoneFile, pdnsZoneRecordsRecord},
+				Description:  "Records of the zone",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the record, relative to the zone, or fully qualified with a trailing dot. `@` is the zone.",
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(allowedPrivateDomainRecordTypes, false),
+							Description:  "Type of the record",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "TTL of the record. Defaults to 900.",
+						},
+						"data": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Data of the record in zone file format, such as `10 mail` for a MX record",
+						},
+					},
+				},
+			},
+			pdnsZoneRecordsIgnoredTypes: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Types of the records which are not managed. Defaults to SOA and NS.",
+			},
+			pdnsZoneRecordsBatchSize: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 50),
+				Description:  "Number of records which are changed at the same time",
+			},
+			pdnsZoneRecordsRecords: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Managed records of the zone",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Resource record ID",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Fully qualified name of the record",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the record",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "TTL of the record",
+						},
+						"data": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Data of the record in zone file format",
+						},
+					},
+				},
+			},
+			pdnsZoneRecordsRecordsToCreate: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of records created by the last change",
+			},
+			pdnsZoneRecordsRecordsToUpdate: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of records updated by the last change",
+			},
+			pdnsZoneRecordsRecordsToDelete: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of records deleted by the last change",
+			},
+		},
+	}
+}
+
+// resourceIBMPrivateDNSZoneRecordsCustomizeDiff shows the number of records to change, by comparing the desired
+// records with the records refreshed in the state
+func resourceIBMPrivateDNSZoneRecordsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	zoneName := diff.Get(pdnsZoneRecordsZoneName).(string)
+	if !diff.NewValueKnown(pdnsZoneRecordsZoneFile) || !diff.NewValueKnown(pdnsZoneRecordsRecord) {
+		return nil
+	}
+	desired, err := pdnsZoneRecordsDesired(diff, zoneName)
+	if err != nil {
+		return err
+	}
+	if diff.Id() == "" || zoneName == "" {
+		return nil
+	}
+
+	live := flex.ExpandZoneRecordsState(diff.Get(pdnsZoneRecordsRecords).([]interface{}))
+	changes := flex.DiffZoneRecords(desired, flex.FilterZoneRecords(live, pdnsZoneRecordsIgnored(diff)), pdnsZoneRecordsDefaultTTL)
+	if changes.Empty() {
+		return nil
+	}
+	for attribute, count := range map[string]int{
+		pdnsZoneRecordsRecordsToCreate: len(changes.Create),
+		pdnsZoneRecordsRecordsToUpdate: len(changes.Update),
+		pdnsZoneRecordsRecordsToDelete: len(changes.Delete),
+	} {
+		if err := diff.SetNew(attribute, count); err != nil {
+			return err
+		}
+	}
+	return diff.SetNewComputed(pdnsZoneRecordsRecords)
+}
+
+type pdnsZoneRecordsGetter interface {
+	Get(string) interface{}
+}
+
+// pdnsZoneRecordsDesired returns the desired records, without the ignored types. Their name is not checked when the
+// zone name is not known yet.
+func pdnsZoneRecordsDesired(d pdnsZoneRecordsGetter, zoneName string) ([]flex.ZoneRecord, error) {
+	records, err := flex.ExpandZoneRecords(d.Get(pdnsZoneRecordsZoneFile).(string), d.Get(pdnsZoneRecordsRecord).(*schema.Set), zoneName)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing the records of zone %s: %s", zoneName, err)
+	}
+	records = flex.FilterZoneRecords(records, pdnsZoneRecordsIgnored(d))
+	for _, record := range records {
+		supported := false
+		for _, recordType := range allowedPrivateDomainRecordTypes {
+			supported = supported || record.Type == recordType
+		}
+		if !supported {
+			return nil, fmt.Errorf("[ERROR] Record %s has the unsupported type %s", record.Name, record.Type)
+		}
+		if zoneName != "" && record.Name != zoneName && !strings.HasSuffix(record.Name, "."+zoneName) {
+			return nil, fmt.Errorf("[ERROR] Record %s is not in zone %s", record.Name, zoneName)
+		}
+	}
+	return records, nil
+}
+
+func pdnsZoneRecordsIgnored(d pdnsZoneRecordsGetter) []string {
+	ignoredTypes := flex.ExpandStringList(d.Get(pdnsZoneRecordsIgnoredTypes).(*schema.Set).List())
+	if len(ignoredTypes) == 0 {
+		return pdnsZoneRecordsDefaultIgnoredTypes
+	}
+	return ignoredTypes
+}
+
+func resourceIBMPrivateDNSZoneRecordsCreate(d *schema.ResourceData, meta interface{}) error {
+	instanceID := d.Get(pdnsInstanceID).(string)
+	zoneID := d.Get(pdnsZoneID).(string)
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, zoneID))
+
+	return resourceIBMPrivateDNSZoneRecordsUpdate(d, meta)
+}
+
+func resourceIBMPrivateDNSZoneRecordsUpdate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return err
+	}
+	idSet := strings.Split(d.Id(), "/")
+	instanceID, zoneID := idSet[0], idSet[1]
+
+	zoneName, err := pdnsGetZoneName(sess, instanceID, zoneID)
+	if err != nil {
+		return err
+	}
+	desired, err := pdnsZoneRecordsDesired(d, zoneName)
+	if err != nil {
+		return err
+	}
+	live, err := pdnsZoneRecordsList(sess, instanceID, zoneID, zoneName)
+	if err != nil {
+		return err
+	}
+
+	changes := flex.DiffZoneRecords(desired, flex.FilterZoneRecords(live, pdnsZoneRecordsIgnored(d)), pdnsZoneRecordsDefaultTTL)
+	log.Printf("[INFO] Changing the records of zone %s: %d to create, %d to update, %d to delete",
+		zoneName, len(changes.Create), len(changes.Update), len(changes.Delete))
+	err = flex.ApplyZoneRecordChanges(changes, d.Get(pdnsZoneRecordsBatchSize).(int),
+		func(record flex.ZoneRecord) error {
+			return pdnsZoneRecordCreate(sess, instanceID, zoneID, record)
+		},
+		func(record flex.ZoneRecord) error {
+			return pdnsZoneRecordUpdate(sess, instanceID, zoneID, record)
+		},
+		func(record flex.ZoneRecord) error {
+			return pdnsZoneRecordDelete(sess, instanceID, zoneID, record)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error changing the records of zone %s: %s", zoneName, err)
+	}
+
+	if !changes.Empty() {
+		d.Set(pdnsZoneRecordsRecordsToCreate, len(changes.Create))
+		d.Set(pdnsZoneRecordsRecordsToUpdate, len(changes.Update))
+		d.Set(pdnsZoneRecordsRecordsToDelete, len(changes.Delete))
+	}
+	return resourceIBMPrivateDNSZoneRecordsRead(d, meta)
+}
+
+func resourceIBMPrivateDNSZoneRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return err
+	}
+	idSet := strings.Split(d.Id(), "/")
+	if len(idSet) != 2 {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of instanceID/zoneID", d.Id())
+	}
+	instanceID, zoneID := idSet[0], idSet[1]
+
+	zoneName, err := pdnsGetZoneName(sess, instanceID, zoneID)
+	if err != nil {
+		return err
+	}
+	live, err := pdnsZoneRecordsList(sess, instanceID, zoneID, zoneName)
+	if err != nil {
+		return err
+	}
+
+	d.Set(pdnsInstanceID, instanceID)
+	d.Set(pdnsZoneID, zoneID)
+	d.Set(pdnsZoneRecordsZoneName, zoneName)
+	d.Set(pdnsZoneRecordsRecords, flex.FlattenZoneRecords(flex.FilterZoneRecords(live, pdnsZoneRecordsIgnored(d))))
+	return nil
+}
+
+func resourceIBMPrivateDNSZoneRecordsDelete(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return err
+	}
+	idSet := strings.Split(d.Id(), "/")
+	instanceID, zoneID := idSet[0], idSet[1]
+
+	changes := flex.ZoneRecordChanges{
+		Delete: flex.ExpandZoneRecordsState(d.Get(pdnsZoneRecordsRecords).([]interface{})),
+	}
+	err = flex.ApplyZoneRecordChanges(changes, d.Get(pdnsZoneRecordsBatchSize).(int), nil, nil,
+		func(record flex.ZoneRecord) error {
+			return pdnsZoneRecordDelete(sess, instanceID, zoneID, record)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error deleting the records of zone %s: %s", d.Get(pdnsZoneRecordsZoneName).(string), err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func pdnsGetZoneName(sess *dns.DnsSvcsV1, instanceID, zoneID string) (string, error) {
+	zone, detail, err := sess.GetDnszone(sess.NewGetDnszoneOptions(instanceID, zoneID))
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error fetching pdns zone:%s\n%s", err, detail)
+	}
+	return strings.ToLower(*zone.Name), nil
+}
+
+// pdnsResourceRecordsList lists all the resource records of a zone
+func pdnsResourceRecordsList(sess *dns.DnsSvcsV1, instanceID, zoneID string) ([]dns.ResourceRecord, error) {
+	var records []dns.ResourceRecord
+	listDNSResRecOptions := sess.NewListResourceRecordsOptions(instanceID, zoneID)
+	listDNSResRecOptions.SetLimit(1000)
+	for {
+		listDNSResRecOptions.SetOffset(int64(len(records)))
+		availableDNSResRecs, detail, err := sess.ListResourceRecords(listDNSResRecOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error reading list of pdns resource records:%s\n%s", err, detail)
+		}
+		records = append(records, availableDNSResRecs.ResourceRecords...)
+		if len(availableDNSResRecs.ResourceRecords) == 0 || availableDNSResRecs.TotalCount == nil ||
+			int64(len(records)) >= *availableDNSResRecs.TotalCount {
+			return records, nil
+		}
+	}
+}
+
+func pdnsZoneRecordsList(sess *dns.DnsSvcsV1, instanceID, zoneID, zoneName string) ([]flex.ZoneRecord, error) {
+	records, err := pdnsResourceRecordsList(sess, instanceID, zoneID)
+	if err != nil {
+		return nil, err
+	}
+	zoneRecords := make([]flex.ZoneRecord, 0, len(records))
+	for _, record := range records {
+		zoneRecord, err := pdnsZoneRecord(record, zoneName)
+		if err != nil {
+			return nil, err
+		}
+		zoneRecords = append(zoneRecords, zoneRecord)
+	}
+	return zoneRecords, nil
+}
+
+// pdnsZoneRecord converts a resource record to the zone file format
+func pdnsZoneRecord(record dns.ResourceRecord, zoneName string) (flex.ZoneRecord, error) {
+	data := ""
+	rdata := record.Rdata
+	switch *record.Type {
+	case "A", "AAAA":
+		data = fmt.Sprintf("%v", rdata["ip"])
+	case "CNAME":
+		data = fmt.Sprintf("%v.", rdata["cname"])
+	case "PTR":
+		data = fmt.Sprintf("%v.", rdata["ptrdname"])
+	case "TXT":
+		data = flex.QuoteZoneText(fmt.Sprintf("%v", rdata["text"]))
+	case "MX":
+		data = fmt.Sprintf("%v %v.", rdata["preference"], rdata["exchange"])
+	case "SRV":
+		data = fmt.Sprintf("%v %v %v %v.", rdata["priority"], rdata["weight"], rdata["port"], rdata["target"])
+	}
+
+	zoneRecord, err := flex.NewZoneRecord(*record.Name+".", *record.Type, int(*record.TTL), data, zoneName)
+	if err != nil {
+		return zoneRecord, err
+	}
+	zoneRecord.ID = *record.ID
+	return zoneRecord, nil
+}
+
+// pdnsSRVName splits the name of a SRV record in its service, protocol and name
+func pdnsSRVName(record flex.ZoneRecord) (string, string, string, error) {
+	labels := strings.SplitN(record.Name, ".", 3)
+	if len(labels) < 3 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+		return "", "", "", fmt.Errorf("[ERROR] SRV record %s is not named _service._protocol.name", record.Name)
+	}
+	return labels[0], strings.TrimPrefix(labels[1], "_"), labels[2], nil
+}
+
+func pdnsZoneRecordCreate(sess *dns.DnsSvcsV1, instanceID, zoneID string, record flex.ZoneRecord) error {
+	fields := flex.ZoneRecordFields(record)
+	number := func(i int) int64 {
+		value, _ := strconv.ParseInt(fields[i], 10, 64)
+		return value
+	}
+
+	createResourceRecordOptions := sess.NewCreateResourceRecordOptions(instanceID, zoneID)
+	createResourceRecordOptions.SetName(record.Name)
+	createResourceRecordOptions.SetType(record.Type)
+	createResourceRecordOptions.SetTTL(int64(record.TTL))
+
+	var rdata dns.ResourceRecordInputRdataIntf
+	var err error
+	switch record.Type {
+	case "A":
+		rdata, err = sess.NewResourceRecordInputRdataRdataARecord(fields[0])
+	case "AAAA":
+		rdata, err = sess.NewResourceRecordInputRdataRdataAaaaRecord(fields[0])
+	case "CNAME":
+		rdata, err = sess.NewResourceRecordInputRdataRdataCnameRecord(fields[0])
+	case "PTR":
+		rdata, err = sess.NewResourceRecordInputRdataRdataPtrRecord(fields[0])
+	case "TXT":
+		rdata, err = sess.NewResourceRecordInputRdataRdataTxtRecord(fields[0])
+	case "MX":
+		rdata, err = sess.NewResourceRecordInputRdataRdataMxRecord(fields[1], number(0))
+	case "SRV":
+		service, protocol, name, srvErr := pdnsSRVName(record)
+		if srvErr != nil {
+			return srvErr
+		}
+		createResourceRecordOptions.SetName(name)
+		createResourceRecordOptions.SetService(service)
+		createResourceRecordOptions.SetProtocol(protocol)
+		rdata, err = sess.NewResourceRecordInputRdataRdataSrvRecord(number(2), number(0), fields[3], number(1))
+	default:
+		return fmt.Errorf("[ERROR] Record %s has the unsupported type %s", record.Name, record.Type)
+	}
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating pdns resource record %s data:%s", record.Type, err)
+	}
+	createResourceRecordOptions.SetRdata(rdata)
+
+	_, detail, err := sess.CreateResourceRecord(createResourceRecordOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating pdns resource record %s %s:%s\n%s", record.Type, record.Name, err, detail)
+	}
+	return nil
+}
+
+func pdnsZoneRecordUpdate(sess *dns.DnsSvcsV1, instanceID, zoneID string, record flex.ZoneRecord) error {
+	fields := flex.ZoneRecordFields(record)
+	number := func(i int) int64 {
+		value, _ := strconv.ParseInt(fields[i], 10, 64)
+		return value
+	}
+
+	updateResourceRecordOptions := sess.NewUpdateResourceRecordOptions(instanceID, zoneID, record.ID)
+	updateResourceRecordOptions.SetName(record.Name)
+	updateResourceRecordOptions.SetTTL(int64(record.TTL))
+
+	var rdata dns.ResourceRecordUpdateInputRdataIntf
+	var err error
+	switch record.Type {
+	case "A":
+		rdata, err = sess.NewResourceRecordUpdateInputRdataRdataARecord(fields[0])
+	case "AAAA":
+		rdata, err = sess.NewResourceRecordUpdateInputRdataRdataAaaaRecord(fields[0])
+	case "CNAME":
+		rdata, err = sess.NewResourceRecordUpdateInputRdataRdataCnameRecord(fields[0])
+	case "PTR":
+		rdata, err = sess.NewResourceRecordUpdateInputRdataRdataPtrRecord(fields[0])
+	case "TXT":
+		rdata, err = sess.NewResourceRecordUpdateInputRdataRdataTxtRecord(fields[0])
+	case "MX":
+		rdata, err = sess.NewResourceRecordUpdateInputRdataRdataMxRecord(fields[1], number(0))
+	case "SRV":
+		service, protocol, name, srvErr := pdnsSRVName(record)
+		if srvErr != nil {
+			return srvErr
+		}
+		updateResourceRecordOptions.SetName(name)
+		updateResourceRecordOptions.SetService(service)
+		updateResourceRecordOptions.SetProtocol(protocol)
+		rdata, err = sess.NewResourceRecordUpdateInputRdataRdataSrvRecord(number(2), number(0), fields[3], number(1))
+	default:
+		return fmt.Errorf("[ERROR] Record %s has the unsupported type %s", record.Name, record.Type)
+	}
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error updating pdns resource record %s data:%s", record.Type, err)
+	}
+	updateResourceRecordOptions.SetRdata(rdata)
+
+	_, detail, err := sess.UpdateResourceRecord(updateResourceRecordOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error updating pdns resource record %s %s:%s\n%s", record.Type, record.Name, err, detail)
+	}
+	return nil
+}
+
+func pdnsZoneRecordDelete(sess *dns.DnsSvcsV1, instanceID, zoneID string, record flex.ZoneRecord) error {
+	response, err := sess.DeleteResourceRecord(sess.NewDeleteResourceRecordOptions(instanceID, zoneID, record.ID))
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error deleting pdns resource record %s %s:%s\n%s", record.Type, record.Name, err, response)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package dnsservices_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPrivateDNSZoneRecords_Basic(t *testing.T) {
+	node := "ibm_dns_zone_records.test"
+	riname := fmt.Sprintf("tf-instance-%d", acctest.RandIntRange(100, 200))
+	zonename := fmt.Sprintf("tf-dnszone-%d.com", acctest.RandIntRange(100, 200))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPrivateDNSZoneRecordsConfig(riname, zonename, `
+	record {
+		name = "www"
+		type = "A"
+		data = "192.0.2.10"
+	}
+	record {
+		name = "_sip._udp"
+		type = "SRV"
+		ttl  = 300
+		data = "10 20 5060 www"
+	}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "zone_name", zonename),
+					resource.TestCheckResourceAttr(node, "records_to_create", "2"),
+					resource.TestCheckResourceAttr(node, "records.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPrivateDNSZoneRecordsConfig(riname, zonename, `
+	record {
+		name = "www"
+		type = "A"
+		ttl  = 300
+		data = "192.0.2.10"
+	}
+	record {
+		name = "mail"
+		type = "MX"
+		data = "10 www"
+	}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "records_to_create", "1"),
+					resource.TestCheckResourceAttr(node, "records_to_update", "1"),
+					resource.TestCheckResourceAttr(node, "records_to_delete", "1"),
+					resource.TestCheckResourceAttr(node, "records.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPrivateDNSZoneRecordsConfig(riname, zonename, records string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "rg" {
+		is_default=true
+	}
+
+	resource "ibm_resource_instance" "test-pdns-instance" {
+		name = "%s"
+		resource_group_id = data.ibm_resource_group.rg.id
+		location = "global"
+		service = "dns-svcs"
+		plan = "standard-dns"
+	}
+
+	resource "ibm_dns_zone" "test-pdns-zone" {
+		name        = "%s"
+		instance_id = ibm_resource_instance.test-pdns-instance.guid
+		description = "testdescription"
+		label       = "testlabel"
+	}
+
+	resource "ibm_dns_zone_records" "test" {
+		instance_id = ibm_resource_instance.test-pdns-instance.guid
+		zone_id     = ibm_dns_zone.test-pdns-zone.zone_id
+		%s
+	}`, riname, zonename, records)
+}
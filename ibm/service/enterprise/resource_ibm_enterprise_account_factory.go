@@ -0,0 +1,638 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package enterprise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/iamaccessgroup"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/iamidentity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	rg "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+)
+
+// accountFactoryAssignment describes a kind of template assignment of the account baseline. The assignments are
+// created, read and deleted through the template assignment resources.
+type accountFactoryAssignment struct {
+	key      string
+	resource func() *schema.Resource
+	update   func(context context.Context, assignment *schema.ResourceData, version interface{}, meta interface{}) error
+}
+
+// The template assignments of the account baseline, in the order they are applied
+var accountFactoryAssignments = []accountFactoryAssignment{
+	{
+		key:      "account_settings_template",
+		resource: iamidentity.ResourceIBMAccountSettingsTemplateAssignment,
+		update:   updateAccountFactoryAccountSettingsAssignment,
+	},
+	{
+		key:      "trusted_profile_templates",
+		resource: iamidentity.ResourceIBMTrustedProfileTemplateAssignment,
+		update:   updateAccountFactoryTrustedProfileAssignment,
+	},
+	{
+		key:      "access_group_templates",
+		resource: iamaccessgroup.ResourceIBMIAMAccessGroupTemplateAssignment,
+		update:   updateAccountFactoryAccessGroupAssignment,
+	},
+}
+
+// ResourceIBMEnterpriseAccountFactory creates an enterprise account like ibm_enterprise_account, then applies
+// a baseline of template assignments and resource groups to it. The resource groups are created with a trusted
+// profile that a trusted profile template of the baseline creates in the account.
+func ResourceIBMEnterpriseAccountFactory() *schema.Resource {
+	accountSchema := ResourceIBMEnterpriseAccount().Schema
+
+	accountSchema["account_settings_template"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The account settings template assigned to the account.",
+		Elem:        accountFactoryAssignmentSchema(schema.TypeInt),
+	}
+	accountSchema["trusted_profile_templates"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "The trusted profile templates assigned to the account.",
+		Elem:        accountFactoryAssignmentSchema(schema.TypeInt),
+	}
+	accountSchema["access_group_templates"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "The access group templates assigned to the account.",
+		Elem:        accountFactoryAssignmentSchema(schema.TypeString),
+	}
+	accountSchema["resource_groups"] = &schema.Schema{
+		Type:         schema.TypeSet,
+		Optional:     true,
+		Elem:         &schema.Schema{Type: schema.TypeString},
+		Set:          schema.HashString,
+		RequiredWith: []string{"resource_groups_trusted_profile_template_id"},
+		Description:  "The names of the resource groups created in the account.",
+	}
+	accountSchema["resource_groups_trusted_profile_template_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"resource_groups"},
+		Description:  "The ID of the trusted profile template, in trusted_profile_templates, whose profile is assumed in the account to create the resource groups.",
+	}
+	accountSchema["resource_group_ids"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The IDs of the resource groups created in the account, by name.",
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceIbmEnterpriseAccountFactoryCreate,
+		ReadContext:   resourceIbmEnterpriseAccountFactoryRead,
+		UpdateContext: resourceIbmEnterpriseAccountFactoryUpdate,
+		DeleteContext: resourceIbmEnterpriseAccountFactoryDelete,
+		Importer:      &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Schema: accountSchema,
+	}
+}
+
+func accountFactoryAssignmentSchema(versionType schema.ValueType) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"template_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the template.",
+			},
+			"template_version": {
+				Type:        versionType,
+				Required:    true,
+				Description: "The version of the template.",
+			},
+			"assignment_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the template assignment.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the template assignment.",
+			},
+		},
+	}
+}
+
+func resourceIbmEnterpriseAccountFactoryCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := resourceIbmEnterpriseAccountCreate(context, d, meta); diags.HasError() {
+		return diags
+	}
+
+	if err := waitForEnterpriseAccountActive(context, d.Id(), d.Timeout(schema.TimeoutCreate), meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := applyEnterpriseAccountFactoryBaseline(context, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIbmEnterpriseAccountFactoryRead(context, d, meta)
+}
+
+func resourceIbmEnterpriseAccountFactoryRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := resourceIbmEnterpriseAccountRead(context, d, meta); diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
+	for _, kind := range accountFactoryAssignments {
+		items := []interface{}{}
+		for _, i := range d.Get(kind.key).([]interface{}) {
+			item := i.(map[string]interface{})
+			assignmentID := item["assignment_id"].(string)
+			if assignmentID == "" {
+				continue
+			}
+			r := kind.resource()
+			assignment := r.Data(nil)
+			assignment.SetId(assignmentID)
+			if diags := r.ReadContext(context, assignment, meta); diags.HasError() {
+				return diags
+			}
+			if assignment.Id() == "" {
+				log.Printf("[WARN] Template assignment %s of account %s was removed outside of Terraform", assignmentID, d.Id())
+				continue
+			}
+			item["template_version"] = assignment.Get("template_version")
+			item["status"] = assignment.Get("status")
+			items = append(items, item)
+		}
+		if err := d.Set(kind.key, items); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting %s: %s", kind.key, err))
+		}
+	}
+
+	if len(d.Get("resource_group_ids").(map[string]interface{})) == 0 {
+		return nil
+	}
+	rMgtClient, err := accountFactoryResourceManagerClient(context, d, meta)
+	if err != nil {
+		// The resource groups are kept as they are, the next apply fails if the profile can not be assumed
+		log.Printf("[WARN] The resource groups of account %s are not refreshed: %s", d.Id(), err)
+		return nil
+	}
+	resourceGroupIDs := map[string]interface{}{}
+	for name, id := range d.Get("resource_group_ids").(map[string]interface{}) {
+		resourceGroupID := id.(string)
+		_, response, err := rMgtClient.GetResourceGroupWithContext(context, &rg.GetResourceGroupOptions{
+			ID: &resourceGroupID,
+		})
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				log.Printf("[WARN] Resource group %s of account %s was deleted outside of Terraform", name, d.Id())
+				continue
+			}
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting resource group %s: %s\n%s", name, err, response))
+		}
+		resourceGroupIDs[name] = resourceGroupID
+	}
+	if err = d.Set("resource_group_ids", resourceGroupIDs); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_group_ids: %s", err))
+	}
+	resourceGroups := []interface{}{}
+	for name := range resourceGroupIDs {
+		resourceGroups = append(resourceGroups, name)
+	}
+	if err = d.Set("resource_groups", schema.NewSet(schema.HashString, resourceGroups)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_groups: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmEnterpriseAccountFactoryUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("parent") {
+		if diags := resourceIbmEnterpriseAccountUpdate(context, d, meta); diags.HasError() {
+			return diags
+		}
+	}
+
+	if err := applyEnterpriseAccountFactoryBaseline(context, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIbmEnterpriseAccountFactoryRead(context, d, meta)
+}
+
+func resourceIbmEnterpriseAccountFactoryDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The resource groups are deleted while the trusted profile that created them still exists
+	if resourceGroupIDs := d.Get("resource_group_ids").(map[string]interface{}); len(resourceGroupIDs) > 0 {
+		rMgtClient, err := accountFactoryResourceManagerClient(context, d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for name, id := range resourceGroupIDs {
+			if err := deleteAccountFactoryResourceGroup(context, rMgtClient, name, id.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	// The assignments are removed in the reverse order they are applied
+	for i := len(accountFactoryAssignments) - 1; i >= 0; i-- {
+		kind := accountFactoryAssignments[i]
+		for _, item := range d.Get(kind.key).([]interface{}) {
+			if err := deleteAccountFactoryAssignment(context, kind, item.(map[string]interface{})["assignment_id"].(string), meta); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceIbmEnterpriseAccountDelete(context, d, meta)
+}
+
+// applyEnterpriseAccountFactoryBaseline reconciles the template assignments and the resource groups of the account
+// with the configuration. The items are matched on their template ID or name.
+func applyEnterpriseAccountFactoryBaseline(context context.Context, d *schema.ResourceData, meta interface{}) error {
+	accountID := d.Id()
+
+	for _, kind := range accountFactoryAssignments {
+		o, n := d.GetChange(kind.key)
+		existing := map[string]map[string]interface{}{}
+		for _, item := range o.([]interface{}) {
+			if item != nil {
+				existing[item.(map[string]interface{})["template_id"].(string)] = item.(map[string]interface{})
+			}
+		}
+
+		items := []interface{}{}
+		var applyErr error
+		for _, i := range n.([]interface{}) {
+			item := i.(map[string]interface{})
+			templateID := item["template_id"].(string)
+			old, ok := existing[templateID]
+			delete(existing, templateID)
+			if applyErr != nil {
+				if ok {
+					items = append(items, old)
+				}
+				continue
+			}
+
+			if ok && old["assignment_id"].(string) != "" {
+				item["assignment_id"] = old["assignment_id"]
+				item["status"] = old["status"]
+				if old["template_version"] != item["template_version"] || old["status"] == "failed" {
+					applyErr = updateAccountFactoryAssignment(context, kind, item, d.Timeout(schema.TimeoutUpdate), meta)
+				}
+			} else {
+				applyErr = createAccountFactoryAssignment(context, kind, accountID, item, meta)
+			}
+			if item["assignment_id"].(string) != "" {
+				items = append(items, item)
+			}
+		}
+
+		for _, old := range existing {
+			if applyErr != nil {
+				items = append(items, old)
+				continue
+			}
+			if err := deleteAccountFactoryAssignment(context, kind, old["assignment_id"].(string), meta); err != nil {
+				applyErr = err
+				items = append(items, old)
+			}
+		}
+
+		if err := d.Set(kind.key, items); err != nil {
+			return fmt.Errorf("[ERROR] Error setting %s: %s", kind.key, err)
+		}
+		if applyErr != nil {
+			return applyErr
+		}
+	}
+
+	return applyEnterpriseAccountFactoryResourceGroups(context, d, meta)
+}
+
+func applyEnterpriseAccountFactoryResourceGroups(context context.Context, d *schema.ResourceData, meta interface{}) error {
+	existing := d.Get("resource_group_ids").(map[string]interface{})
+	names := d.Get("resource_groups").(*schema.Set)
+	if len(existing) == 0 && names.Len() == 0 {
+		return nil
+	}
+
+	rMgtClient, err := accountFactoryResourceManagerClient(context, d, meta)
+	if err != nil {
+		return err
+	}
+
+	accountID := d.Id()
+	resourceGroupIDs := map[string]interface{}{}
+	for k, v := range existing {
+		resourceGroupIDs[k] = v
+	}
+	defer d.Set("resource_group_ids", resourceGroupIDs)
+
+	for _, n := range names.List() {
+		name := n.(string)
+		if _, ok := resourceGroupIDs[name]; ok {
+			continue
+		}
+		resourceGroup, response, err := rMgtClient.CreateResourceGroupWithContext(context, &rg.CreateResourceGroupOptions{
+			Name:      &name,
+			AccountID: &accountID,
+		})
+		if err != nil || resourceGroup == nil {
+			return fmt.Errorf("[ERROR] Error creating resource group %s in account %s: %s\n%s", name, accountID, err, response)
+		}
+		resourceGroupIDs[name] = *resourceGroup.ID
+	}
+
+	for name, id := range existing {
+		if names.Contains(name) {
+			continue
+		}
+		if err := deleteAccountFactoryResourceGroup(context, rMgtClient, name, id.(string)); err != nil {
+			return err
+		}
+		delete(resourceGroupIDs, name)
+	}
+
+	return nil
+}
+
+func deleteAccountFactoryResourceGroup(context context.Context, rMgtClient *rg.ResourceManagerV2, name, resourceGroupID string) error {
+	response, err := rMgtClient.DeleteResourceGroupWithContext(context, &rg.DeleteResourceGroupOptions{
+		ID: &resourceGroupID,
+	})
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return fmt.Errorf("[ERROR] Error deleting resource group %s: %s\n%s", name, err, response)
+	}
+	return nil
+}
+
+// accountFactoryResourceManagerClient returns a resource manager client that is authenticated in the account with
+// the trusted profile that the assignment of resource_groups_trusted_profile_template_id created there
+func accountFactoryResourceManagerClient(context context.Context, d *schema.ResourceData, meta interface{}) (*rg.ResourceManagerV2, error) {
+	accountID := d.Id()
+	templateID := d.Get("resource_groups_trusted_profile_template_id").(string)
+	assignmentID := ""
+	for _, i := range d.Get("trusted_profile_templates").([]interface{}) {
+		if item := i.(map[string]interface{}); item["template_id"].(string) == templateID {
+			assignmentID = item["assignment_id"].(string)
+		}
+	}
+	if assignmentID == "" {
+		return nil, fmt.Errorf("[ERROR] The trusted profile template %s is not assigned to account %s, add it to trusted_profile_templates", templateID, accountID)
+	}
+
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return nil, err
+	}
+	getOptions := &iamidentityv1.GetTrustedProfileAssignmentOptions{}
+	getOptions.SetAssignmentID(assignmentID)
+	assignment, response, err := iamIdentityClient.GetTrustedProfileAssignmentWithContext(context, getOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] GetTrustedProfileAssignmentWithContext failed %s\n%s", err, response)
+	}
+	profileID := ""
+	for _, resource := range assignment.Resources {
+		if resource.Target != nil && *resource.Target == accountID && resource.Profile != nil && resource.Profile.ResourceCreated != nil && resource.Profile.ResourceCreated.ID != nil {
+			profileID = *resource.Profile.ResourceCreated.ID
+		}
+	}
+	if profileID == "" {
+		return nil, fmt.Errorf("[ERROR] The assignment %s of trusted profile template %s did not create a profile in account %s", assignmentID, templateID, accountID)
+	}
+
+	token, err := assumeAccountFactoryTrustedProfile(context, iamIdentityClient, profileID, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	rMgtClient, err := meta.(conns.ClientSession).ResourceManagerV2API()
+	if err != nil {
+		return nil, err
+	}
+	return rg.NewResourceManagerV2(&rg.ResourceManagerV2Options{
+		URL:           rMgtClient.Service.GetServiceURL(),
+		Authenticator: &core.BearerTokenAuthenticator{BearerToken: token},
+	})
+}
+
+// assumeAccountFactoryTrustedProfile exchanges the IAM token of the provider for a token of the trusted profile
+func assumeAccountFactoryTrustedProfile(context context.Context, iamIdentityClient *iamidentityv1.IamIdentityV1, profileID string, meta interface{}) (string, error) {
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return "", err
+	}
+
+	builder := core.NewRequestBuilder(core.POST)
+	builder = builder.WithContext(context)
+	_, err = builder.ResolveRequestURL(iamIdentityClient.Service.GetServiceURL(), "/identity/token", nil)
+	if err != nil {
+		return "", err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddFormData("grant_type", "", "", "urn:ibm:params:oauth:grant-type:assume")
+	builder.AddFormData("access_token", "", "", strings.TrimPrefix(bxSession.Config.IAMAccessToken, "Bearer "))
+	builder.AddFormData("profile_id", "", "", profileID)
+	request, err := builder.Build()
+	if err != nil {
+		return "", err
+	}
+
+	// The token request is authenticated by the token in its body, not by the authenticator of the client
+	response, err := iamIdentityClient.Service.Client.Do(request)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error assuming trusted profile %s: %s", profileID, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return "", fmt.Errorf("[ERROR] Error assuming trusted profile %s: %s", profileID, response.Status)
+	}
+	var tokenResponse struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err = json.NewDecoder(response.Body).Decode(&tokenResponse); err != nil {
+		return "", fmt.Errorf("[ERROR] Error assuming trusted profile %s: %s", profileID, err)
+	}
+	return tokenResponse.AccessToken, nil
+}
+
+func createAccountFactoryAssignment(context context.Context, kind accountFactoryAssignment, accountID string, item map[string]interface{}, meta interface{}) error {
+	r := kind.resource()
+	assignment := r.Data(nil)
+	assignment.Set("template_id", item["template_id"])
+	assignment.Set("template_version", item["template_version"])
+	assignment.Set("target_type", "Account")
+	assignment.Set("target", accountID)
+
+	diags := r.CreateContext(context, assignment, meta)
+	// Keep track of the assignment even if it failed, so that it can be retried or removed
+	item["assignment_id"] = assignment.Id()
+	item["status"] = assignment.Get("status")
+	if diags.HasError() {
+		return fmt.Errorf("[ERROR] Error assigning template %s to account %s: %s", item["template_id"], accountID, diags[0].Summary)
+	}
+	return nil
+}
+
+func updateAccountFactoryAssignment(context context.Context, kind accountFactoryAssignment, item map[string]interface{}, timeout time.Duration, meta interface{}) error {
+	r := kind.resource()
+	assignment := r.Data(nil)
+	assignment.SetId(item["assignment_id"].(string))
+	if diags := r.ReadContext(context, assignment, meta); diags.HasError() {
+		return fmt.Errorf("[ERROR] Error getting template assignment %s: %s", assignment.Id(), diags[0].Summary)
+	}
+
+	if err := kind.update(context, assignment, item["template_version"], meta); err != nil {
+		return err
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"accepted", "in_progress"},
+		Target:  []string{"succeeded"},
+		Refresh: func() (interface{}, string, error) {
+			if diags := r.ReadContext(context, assignment, meta); diags.HasError() {
+				return nil, "", fmt.Errorf("%s", diags[0].Summary)
+			}
+			status := assignment.Get("status").(string)
+			if status == "failed" {
+				return assignment, status, fmt.Errorf("[ERROR] The assignment %s did complete but with a 'failed' status", assignment.Id())
+			}
+			return assignment, status, nil
+		},
+		Delay:        30 * time.Second,
+		PollInterval: time.Minute,
+		Timeout:      timeout,
+	}
+	_, err := stateConf.WaitForStateContext(context)
+	item["status"] = assignment.Get("status")
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error updating template assignment %s: %s", assignment.Id(), err)
+	}
+	return nil
+}
+
+func deleteAccountFactoryAssignment(context context.Context, kind accountFactoryAssignment, assignmentID string, meta interface{}) error {
+	if assignmentID == "" {
+		return nil
+	}
+	r := kind.resource()
+	assignment := r.Data(nil)
+	assignment.SetId(assignmentID)
+	if diags := r.ReadContext(context, assignment, meta); diags.HasError() {
+		return fmt.Errorf("[ERROR] Error getting template assignment %s: %s", assignmentID, diags[0].Summary)
+	}
+	if assignment.Id() == "" {
+		return nil
+	}
+	if diags := r.DeleteContext(context, assignment, meta); diags.HasError() {
+		return fmt.Errorf("[ERROR] Error removing template assignment %s: %s", assignmentID, diags[0].Summary)
+	}
+	return nil
+}
+
+func updateAccountFactoryAccountSettingsAssignment(context context.Context, assignment *schema.ResourceData, version interface{}, meta interface{}) error {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return err
+	}
+	updateOptions := &iamidentityv1.UpdateAccountSettingsAssignmentOptions{}
+	updateOptions.SetAssignmentID(assignment.Id())
+	updateOptions.SetIfMatch(assignment.Get("entity_tag").(string))
+	updateOptions.SetTemplateVersion(int64(version.(int)))
+	_, response, err := iamIdentityClient.UpdateAccountSettingsAssignmentWithContext(context, updateOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] UpdateAccountSettingsAssignmentWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+func updateAccountFactoryTrustedProfileAssignment(context context.Context, assignment *schema.ResourceData, version interface{}, meta interface{}) error {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return err
+	}
+	updateOptions := &iamidentityv1.UpdateTrustedProfileAssignmentOptions{}
+	updateOptions.SetAssignmentID(assignment.Id())
+	updateOptions.SetIfMatch(assignment.Get("entity_tag").(string))
+	updateOptions.SetTemplateVersion(int64(version.(int)))
+	_, response, err := iamIdentityClient.UpdateTrustedProfileAssignmentWithContext(context, updateOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] UpdateTrustedProfileAssignmentWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+func updateAccountFactoryAccessGroupAssignment(context context.Context, assignment *schema.ResourceData, version interface{}, meta interface{}) error {
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return err
+	}
+	updateOptions := &iamaccessgroupsv2.UpdateAssignmentOptions{}
+	updateOptions.SetAssignmentID(assignment.Id())
+	updateOptions.SetIfMatch(assignment.Get("etag").(string))
+	updateOptions.SetTemplateVersion(version.(string))
+	_, response, err := iamAccessGroupsClient.UpdateAssignmentWithContext(context, updateOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] UpdateAssignmentWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+// waitForEnterpriseAccountActive waits for the new account to be ready for the baseline
+func waitForEnterpriseAccountActive(context context.Context, accountID string, timeout time.Duration, meta interface{}) error {
+	enterpriseManagementClient, err := meta.(conns.ClientSession).EnterpriseManagementV1()
+	if err != nil {
+		return err
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"PENDING", ""},
+		Target:  []string{"ACTIVE"},
+		Refresh: func() (interface{}, string, error) {
+			getAccountOptions := &enterprisemanagementv1.GetAccountOptions{}
+			getAccountOptions.SetAccountID(accountID)
+			account, response, err := enterpriseManagementClient.GetAccountWithContext(context, getAccountOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return account, "", nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting account %s: %s\n%s", accountID, err, response)
+			}
+			if account.State == nil {
+				return account, "", nil
+			}
+			// The state is reported either in upper or in lower case
+			return account, strings.ToUpper(*account.State), nil
+		},
+		Delay:        10 * time.Second,
+		PollInterval: 30 * time.Second,
+		Timeout:      timeout,
+	}
+	_, err = stateConf.WaitForStateContext(context)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for account %s to be active: %s", accountID, err)
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package enterprise_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+)
+
+/* To run this test case ensure the IC_API_KEY belongs to an enterprise" */
+func TestAccIbmEnterpriseAccountFactoryBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-gen-account-name_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckEnterprise(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMEnterpriseAccountFactoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmEnterpriseAccountFactoryConfigBasic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_enterprise_account_factory.factory", "name", name),
+					resource.TestCheckResourceAttrSet("ibm_enterprise_account_factory.factory", "account_id"),
+				),
+			},
+		},
+	})
+}
+
+/* To run this test case ensure the IC_API_KEY belongs to the primary contact of an enterprise" */
+func TestAccIbmEnterpriseAccountFactoryResourceGroups(t *testing.T) {
+	name := fmt.Sprintf("tf-gen-account-name_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckEnterprise(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMEnterpriseAccountFactoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmEnterpriseAccountFactoryConfigResourceGroups(name, `"dev"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_enterprise_account_factory.factory", "resource_groups.#", "1"),
+					resource.TestCheckResourceAttrSet("ibm_enterprise_account_factory.factory", "resource_group_ids.dev"),
+				),
+			},
+			{
+				Config: testAccCheckIbmEnterpriseAccountFactoryConfigResourceGroups(name, `"dev", "prod"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_enterprise_account_factory.factory", "resource_groups.#", "2"),
+					resource.TestCheckResourceAttrSet("ibm_enterprise_account_factory.factory", "resource_group_ids.dev"),
+					resource.TestCheckResourceAttrSet("ibm_enterprise_account_factory.factory", "resource_group_ids.prod"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEnterpriseAccountFactoryDestroy(s *terraform.State) error {
+	enterpriseManagementClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).EnterpriseManagementV1()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_enterprise_account_factory" {
+			continue
+		}
+
+		getAccountOptions := &enterprisemanagementv1.GetAccountOptions{}
+		getAccountOptions.SetAccountID(rs.Primary.ID)
+
+		instance, resp, err := enterpriseManagementClient.GetAccount(getAccountOptions)
+		if err == nil {
+			if strings.EqualFold(*instance.State, "active") {
+				return fmt.Errorf("IBM Enterprise Account still exists: %s", rs.Primary.ID)
+			}
+		} else if !strings.Contains(err.Error(), "404") {
+			return fmt.Errorf("[ERROR] Error checking if Account (%s) has been destroyed: %s with resp code: %s", rs.Primary.ID, err, resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIbmEnterpriseAccountFactoryConfigBasic(name string) string {
+	return fmt.Sprintf(`
+		data "ibm_enterprises" "enterprises_instance" {
+		}
+		resource "ibm_enterprise_account_factory" "factory" {
+			parent       = data.ibm_enterprises.enterprises_instance.enterprises[0].crn
+			name         = "%s"
+			owner_iam_id = data.ibm_enterprises.enterprises_instance.enterprises[0].primary_contact_iam_id
+		}
+	`, name)
+}
+
+func testAccCheckIbmEnterpriseAccountFactoryConfigResourceGroups(name, resourceGroups string) string {
+	return fmt.Sprintf(`
+		data "ibm_enterprises" "enterprises_instance" {
+		}
+		resource "ibm_iam_policy_template" "resource_groups" {
+			name      = "%[1]s-resource-groups"
+			committed = true
+			policy {
+				type = "access"
+				resource {
+					attributes {
+						key      = "resourceType"
+						operator = "stringEquals"
+						value    = "resource-group"
+					}
+				}
+				roles = ["Administrator"]
+			}
+		}
+		resource "ibm_iam_trusted_profile_template" "factory" {
+			name      = "%[1]s-factory"
+			committed = true
+			profile {
+				name = "%[1]s-factory"
+				identities {
+					iam_id     = data.ibm_enterprises.enterprises_instance.enterprises[0].primary_contact_iam_id
+					identifier = data.ibm_enterprises.enterprises_instance.enterprises[0].primary_contact_iam_id
+					type       = "user"
+					accounts   = [data.ibm_enterprises.enterprises_instance.enterprises[0].enterprise_account_id]
+				}
+			}
+			policy_template_references {
+				id      = split("/", ibm_iam_policy_template.resource_groups.id)[0]
+				version = ibm_iam_policy_template.resource_groups.version
+			}
+		}
+		resource "ibm_enterprise_account_factory" "factory" {
+			parent       = data.ibm_enterprises.enterprises_instance.enterprises[0].crn
+			name         = "%[1]s"
+			owner_iam_id = data.ibm_enterprises.enterprises_instance.enterprises[0].primary_contact_iam_id
+			trusted_profile_templates {
+				template_id      = ibm_iam_trusted_profile_template.factory.id
+				template_version = ibm_iam_trusted_profile_template.factory.version
+			}
+			resource_groups                             = [%[2]s]
+			resource_groups_trusted_profile_template_id = ibm_iam_trusted_profile_template.factory.id
+		}
+	`, name, resourceGroups)
+}
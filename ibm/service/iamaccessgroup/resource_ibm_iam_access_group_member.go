@@ -0,0 +1,251 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamaccessgroup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var accessGroupMemberIdentities = []string{"ibm_id", "iam_service_id", "iam_profile_id"}
+
+// ResourceIBMIAMAccessGroupMember manages a single member of an access group, without affecting the
+// other members of the group
+func ResourceIBMIAMAccessGroupMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMAccessGroupMemberCreate,
+		ReadContext:   resourceIBMIAMAccessGroupMemberRead,
+		DeleteContext: resourceIBMIAMAccessGroupMemberDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"access_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique identifier of the access group",
+				ValidateFunc: validate.InvokeValidator("ibm_iam_access_group_member",
+					"access_group_id"),
+			},
+
+			"ibm_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: accessGroupMemberIdentities,
+				Description:  "The ibm id or email of the user",
+			},
+
+			"iam_service_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: accessGroupMemberIdentities,
+				Description:  "The UUID of the service ID",
+			},
+
+			"iam_profile_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: accessGroupMemberIdentities,
+				Description:  "The UUID of the trusted profile",
+			},
+
+			"iam_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IAM ID of the member",
+			},
+
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the member, either user, service or profile",
+			},
+		},
+	}
+}
+
+func ResourceIBMIAMAccessGroupMemberValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "access_group_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "iam",
+			CloudDataRange:             []string{"service:access_group", "resolved_to:id"},
+			Optional:                   true})
+
+	iBMIAMAccessGroupMemberValidator := validate.ResourceValidator{ResourceName: "ibm_iam_access_group_member", Schema: validateSchema}
+	return &iBMIAMAccessGroupMemberValidator
+}
+
+func resourceIBMIAMAccessGroupMemberCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	grpID := d.Get("access_group_id").(string)
+
+	var userids, serviceids, profileids []string
+	if v, ok := d.GetOk("ibm_id"); ok {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		userids, err = flex.FlattenUserIds(userDetails.UserAccount, []string{v.(string)}, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if v, ok := d.GetOk("iam_service_id"); ok {
+		serviceids, err = FlattenServiceIds([]string{v.(string)}, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if v, ok := d.GetOk("iam_profile_id"); ok {
+		profileids, err = FlattenProfileIds([]string{v.(string)}, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	members := prepareMemberAddRequest(iamAccessGroupsClient, userids, serviceids, profileids)
+
+	addMembersToAccessGroupOptions := iamAccessGroupsClient.NewAddMembersToAccessGroupOptions(grpID)
+	addMembersToAccessGroupOptions.SetMembers(members)
+	membership, detailResponse, err := iamAccessGroupsClient.AddMembersToAccessGroup(addMembersToAccessGroupOptions)
+	if err != nil || membership == nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error adding member to group(%s). API response: %s", grpID, detailResponse))
+	}
+	for _, m := range membership.Members {
+		if m.StatusCode != nil && *m.StatusCode >= 300 {
+			var messages []string
+			for _, e := range m.Errors {
+				messages = append(messages, flex.StringValue(e.Message))
+			}
+			return diag.FromErr(fmt.Errorf("[ERROR] Error adding member %s to group(%s): %s", flex.StringValue(m.IamID), grpID, strings.Join(messages, ", ")))
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", grpID, *members[0].IamID))
+
+	return resourceIBMIAMAccessGroupMemberRead(context, d, meta)
+}
+
+func resourceIBMIAMAccessGroupMemberRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of accessGroupID/iamID", d.Id()))
+	}
+	grpID := parts[0]
+	iamID := parts[1]
+
+	isMemberOfAccessGroupOptions := iamAccessGroupsClient.NewIsMemberOfAccessGroupOptions(grpID, iamID)
+	detailedResponse, err := iamAccessGroupsClient.IsMemberOfAccessGroup(isMemberOfAccessGroupOptions)
+	if err != nil {
+		if detailedResponse != nil && detailedResponse.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group member: %s. API Response: %s", err, detailedResponse))
+	}
+
+	d.Set("access_group_id", grpID)
+	d.Set("iam_id", iamID)
+
+	switch {
+	case strings.HasPrefix(iamID, "iam-ServiceId-"):
+		d.Set("type", "service")
+		d.Set("iam_service_id", strings.TrimPrefix(iamID, "iam-"))
+	case strings.HasPrefix(iamID, "iam-Profile-"):
+		d.Set("type", "profile")
+		d.Set("iam_profile_id", strings.TrimPrefix(iamID, "iam-"))
+	default:
+		d.Set("type", "user")
+		if _, ok := d.GetOk("ibm_id"); !ok {
+			// The email of the user is only looked up on import
+			email, err := getAccessGroupMemberEmail(meta, iamID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			d.Set("ibm_id", email)
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMIAMAccessGroupMemberDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	grpID := parts[0]
+	iamID := parts[1]
+
+	removeMemberFromAccessGroupOptions := iamAccessGroupsClient.NewRemoveMemberFromAccessGroupOptions(grpID, iamID)
+	detailResponse, err := iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMemberFromAccessGroupOptions)
+	if err != nil {
+		if detailResponse != nil && detailResponse.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error removing member from group(%s). API Response: %s", grpID, detailResponse))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// getAccessGroupMemberEmail returns the email of the user with the given IAM ID
+func getAccessGroupMemberEmail(meta interface{}, iamID string) (string, error) {
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return "", err
+	}
+	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
+	if err != nil {
+		return "", err
+	}
+	users, err := userManagement.UserInvite().ListUsers(userDetails.UserAccount)
+	if err != nil {
+		return "", err
+	}
+	for _, user := range users {
+		if user.IamID == iamID {
+			return user.Email, nil
+		}
+	}
+	return "", fmt.Errorf("[ERROR] User with IAM ID %s was not found in account %s", iamID, userDetails.UserAccount)
+}
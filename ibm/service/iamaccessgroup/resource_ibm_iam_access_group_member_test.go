@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamaccessgroup_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMAccessGroupSingleMember_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	sname := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	sname1 := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMAccessGroupSingleMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The member is added next to the members of a non-authoritative ibm_iam_access_group_members
+				Config: testAccCheckIBMIAMAccessGroupSingleMemberBasic(name, sname, sname1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_member.member", "type", "service"),
+					resource.TestCheckResourceAttrPair("ibm_iam_access_group_member.member", "iam_id", "ibm_iam_service_id.serviceID1", "iam_id"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "iam_service_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "ibm_iam_access_group_member.member",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMAccessGroupSingleMemberDestroy(s *terraform.State) error {
+	accClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_access_group_member" {
+			continue
+		}
+
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		isMemberOfAccessGroupOptions := &iamaccessgroupsv2.IsMemberOfAccessGroupOptions{
+			AccessGroupID: &parts[0],
+			IamID:         &parts[1],
+		}
+		detailResponse, err := accClient.IsMemberOfAccessGroup(isMemberOfAccessGroupOptions)
+		if err == nil {
+			return fmt.Errorf("[ERROR] Access group member still exists: %s", rs.Primary.ID)
+		} else if detailResponse == nil || detailResponse.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking if access group member (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMIAMAccessGroupSingleMemberBasic(name, sname, sname1 string) string {
+	return fmt.Sprintf(`
+
+	resource "ibm_iam_access_group" "accgroup" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_service_id" "serviceID" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_service_id" "serviceID1" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_access_group_members" "accgroupmem" {
+		access_group_id = ibm_iam_access_group.accgroup.id
+		iam_service_ids = [ibm_iam_service_id.serviceID.id]
+		authoritative   = false
+	}
+
+	resource "ibm_iam_access_group_member" "member" {
+		access_group_id = ibm_iam_access_group.accgroup.id
+		iam_service_id  = ibm_iam_service_id.serviceID1.id
+	}`, name, sname, sname1)
+}
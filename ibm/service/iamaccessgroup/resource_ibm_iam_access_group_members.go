@@ -7,8 +7,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/bluemix-go/api/usermanagement/usermanagementv2"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
@@ -16,6 +18,7 @@ import (
 
 	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -27,6 +30,12 @@ func ResourceIBMIAMAccessGroupMembers() *schema.Resource {
 		DeleteContext: resourceIBMIAMAccessGroupMembersDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMIAMAccessGroupMembersForeignDiff(diff)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 			"access_group_id": {
 				Type:        schema.TypeString,
@@ -56,6 +65,20 @@ func ResourceIBMIAMAccessGroupMembers() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"authoritative": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the resource manages all the members of the access group. If true, the members that are added outside of this resource, for example by ibm_iam_access_group_member, and the members that do not belong to the account are removed. If false, only the members in the configuration are managed",
+			},
+
+			"foreign_iam_ids": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IAM IDs of the members of the access group that do not belong to the account, removed on the next apply when authoritative is true",
+			},
+
 			"members": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -254,15 +277,25 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 
 	d.Set("members", flex.FlattenAccessGroupMembers(allMembers, res, allrecs))
 	ibmID, serviceID, profileID := flex.FlattenMembersData(allMembers, res, allrecs, allprofiles)
-	if len(ibmID) > 0 {
-		d.Set("ibm_ids", ibmID)
-	}
-	if len(serviceID) > 0 {
-		d.Set("iam_service_ids", serviceID)
-	}
-	if len(profileID) > 0 {
-		d.Set("iam_profile_ids", profileID)
-	}
+	if !d.Get("authoritative").(bool) {
+		// Only the configured members are managed, the other members of the group are left untouched
+		d.Set("ibm_ids", filterAccessGroupMembers(ibmID, d.Get("ibm_ids").(*schema.Set)))
+		d.Set("iam_service_ids", filterAccessGroupMembers(serviceID, d.Get("iam_service_ids").(*schema.Set)))
+		d.Set("iam_profile_ids", filterAccessGroupMembers(profileID, d.Get("iam_profile_ids").(*schema.Set)))
+		d.Set("foreign_iam_ids", []string{})
+		return nil
+	}
+	// The members that can not be resolved in this account, for example users or service IDs of another account,
+	// can not be written to the configuration, so they are recorded apart and their removal is planned by the diff
+	foreign := foreignAccessGroupMembers(allMembers, res, allrecs, allprofiles)
+	if len(foreign) > 0 {
+		log.Printf("[WARN] Access group %s has members that do not belong to account %s: %s", grpID, accountID, strings.Join(foreign, ", "))
+	}
+	d.Set("foreign_iam_ids", foreign)
+	// All the members are recorded, so the members added outside of this resource show as a planned removal
+	d.Set("ibm_ids", ibmID)
+	d.Set("iam_service_ids", serviceID)
+	d.Set("iam_profile_ids", profileID)
 	return nil
 }
 
@@ -386,7 +419,29 @@ func resourceIBMIAMAccessGroupMembersUpdate(context context.Context, d *schema.R
 		}
 	}
 
-	return resourceIBMIAMAccessGroupMembersRead(context, d, meta)
+	var diags diag.Diagnostics
+	if d.Get("authoritative").(bool) {
+		of, nf := d.GetChange("foreign_iam_ids")
+		removeForeign := flex.ExpandStringList(of.(*schema.Set).Difference(nf.(*schema.Set)).List())
+		// authoritative defaults to true, so a member that can not be removed only fails an explicit configuration
+		explicit := !d.GetRawConfig().GetAttr("authoritative").IsNull()
+		for _, iamID := range removeForeign {
+			removeMembersFromAccessGroupOptions := iamAccessGroupsClient.NewRemoveMemberFromAccessGroupOptions(grpID, iamID)
+			detailResponse, err := iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMembersFromAccessGroupOptions)
+			if err != nil {
+				if explicit {
+					return diag.FromErr(fmt.Errorf("[ERROR] Error removing member %s that does not belong to account %s from group(%s). API Response: %s", iamID, accountID, grpID, detailResponse))
+				}
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Member %s of access group %s could not be removed", iamID, grpID),
+					Detail:   fmt.Sprintf("The member does not belong to account %s and could not be removed: %s. Remove it from the access group or set authoritative to false", accountID, err),
+				})
+			}
+		}
+	}
+
+	return append(diags, resourceIBMIAMAccessGroupMembersRead(context, d, meta)...)
 
 }
 
@@ -466,6 +521,54 @@ func resourceIBMIAMAccessGroupMembersDelete(context context.Context, d *schema.R
 	return nil
 }
 
+// foreignAccessGroupMembers returns the IAM IDs of the members of the group that are not users, service IDs or
+// trusted profiles of the account
+func foreignAccessGroupMembers(members []iamaccessgroupsv2.ListGroupMembersResponseMember, users []usermanagementv2.UserInfo, serviceids []iamidentityv1.ServiceID, profileids []iamidentityv1.TrustedProfile) []string {
+	known := make(map[string]bool, len(users)+len(serviceids)+len(profileids))
+	for _, user := range users {
+		known[user.IamID] = true
+	}
+	for _, srid := range serviceids {
+		known[*srid.IamID] = true
+	}
+	for _, prid := range profileids {
+		known[*prid.IamID] = true
+	}
+	foreign := []string{}
+	for _, m := range members {
+		if !known[*m.IamID] {
+			foreign = append(foreign, *m.IamID)
+		}
+	}
+	return foreign
+}
+
+// resourceIBMIAMAccessGroupMembersForeignDiff plans the removal of the members that do not belong to the account
+// when the resource is authoritative
+func resourceIBMIAMAccessGroupMembersForeignDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" || !diff.Get("authoritative").(bool) {
+		return nil
+	}
+	if foreign := diff.Get("foreign_iam_ids").(*schema.Set); foreign.Len() > 0 {
+		return diff.SetNew("foreign_iam_ids", []string{})
+	}
+	return nil
+}
+
+// filterAccessGroupMembers returns the members of the group that are in the configuration
+func filterAccessGroupMembers(members []string, configured *schema.Set) []string {
+	result := []string{}
+	for _, m := range members {
+		for _, c := range configured.List() {
+			if strings.EqualFold(m, c.(string)) {
+				result = append(result, c.(string))
+				break
+			}
+		}
+	}
+	return result
+}
+
 func prepareMemberAddRequest(iamAccessGroupsClient *iamaccessgroupsv2.IamAccessGroupsV2, userIds, serviceIds, profileIds []string) (members []iamaccessgroupsv2.AddGroupMembersRequestMembersItem) {
 	members = make([]iamaccessgroupsv2.AddGroupMembersRequestMembersItem, len(userIds)+len(serviceIds)+len(profileIds))
 	var i = 0
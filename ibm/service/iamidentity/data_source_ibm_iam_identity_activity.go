@@ -0,0 +1,299 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+const (
+	identityActivityReportInactive = "inactive"
+
+	identityActivityReportPending = "pending"
+	identityActivityReportDone    = "done"
+)
+
+func DataSourceIBMIAMIdentityActivity() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIAMIdentityActivityRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the account. Defaults to the account of the provider.",
+			},
+			"reference": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"inactive_hours", "duration_hours"},
+				Description:   "Reference of an existing activity report to read, or `latest` for the latest report of the account. When not set, a new report is generated.",
+			},
+			"inactive_hours": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntAtLeast(1),
+				ConflictsWith: []string{"duration_hours"},
+				Description:   "When set, only the identities which have not authenticated within this number of hours are returned.",
+			},
+			"duration_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Duration in hours of the generated activity report. The service defaults to 720 hours.",
+			},
+			"report_duration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Duration in hours for which the report is generated.",
+			},
+			"report_start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Start time of the report.",
+			},
+			"report_end_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "End time of the report.",
+			},
+			"service_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Activity of the service IDs of the account.",
+				Elem:        identityActivityEntitySchema(),
+			},
+			"profiles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Activity of the trusted profiles of the account.",
+				Elem:        identityActivityEntitySchema(),
+			},
+			"api_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Activity of the API keys of the account.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Unique ID of the API key.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the API key.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the API key, `serviceid` or `user`.",
+						},
+						"service_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the service ID of a `serviceid` API key.",
+						},
+						"iam_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IAM ID of the user of a `user` API key.",
+						},
+						"last_authn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time when the API key was last authenticated. Empty when it did not authenticate during the report duration.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func identityActivityEntitySchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique ID of the identity.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the identity.",
+			},
+			"last_authn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time when the identity was last authenticated. Empty when it did not authenticate during the report duration.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMIAMIdentityActivityRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		accountID = userDetails.UserAccount
+	}
+
+	reference := d.Get("reference").(string)
+	if reference == "" {
+		createReportOptions := &iamidentityv1.CreateReportOptions{}
+		createReportOptions.SetAccountID(accountID)
+		if hours, ok := d.GetOk("inactive_hours"); ok {
+			createReportOptions.SetType(identityActivityReportInactive)
+			createReportOptions.SetDuration(fmt.Sprintf("%d", hours.(int)))
+		} else if hours, ok := d.GetOk("duration_hours"); ok {
+			createReportOptions.SetDuration(fmt.Sprintf("%d", hours.(int)))
+		}
+
+		reportReference, response, err := iamIdentityClient.CreateReportWithContext(context, createReportOptions)
+		if err != nil {
+			log.Printf("[DEBUG] CreateReportWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("CreateReportWithContext failed %s\n%s", err, response))
+		}
+		reference = *reportReference.Reference
+	}
+
+	report, err := waitForIdentityActivityReport(context, iamIdentityClient, accountID, reference, d.Timeout(schema.TimeoutRead))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", accountID, *report.Reference))
+
+	if err = d.Set("account_id", accountID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting account_id: %s", err))
+	}
+	if err = d.Set("reference", report.Reference); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting reference: %s", err))
+	}
+	if err = d.Set("report_duration", report.ReportDuration); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting report_duration: %s", err))
+	}
+	if err = d.Set("report_start_time", report.ReportStartTime); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting report_start_time: %s", err))
+	}
+	if err = d.Set("report_end_time", report.ReportEndTime); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting report_end_time: %s", err))
+	}
+	if err = d.Set("service_ids", flattenIdentityActivityEntities(report.Serviceids)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting service_ids: %s", err))
+	}
+	if err = d.Set("profiles", flattenIdentityActivityEntities(report.Profiles)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting profiles: %s", err))
+	}
+	if err = d.Set("api_keys", flattenIdentityActivityAPIKeys(report.Apikeys)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting api_keys: %s", err))
+	}
+
+	return nil
+}
+
+// waitForIdentityActivityReport waits for the report to be generated. The service does not return the report while
+// it is in progress.
+func waitForIdentityActivityReport(context context.Context, iamIdentityClient *iamidentityv1.IamIdentityV1, accountID, reference string, timeout time.Duration) (*iamidentityv1.Report, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{identityActivityReportPending},
+		Target:  []string{identityActivityReportDone},
+		Refresh: func() (interface{}, string, error) {
+			getReportOptions := &iamidentityv1.GetReportOptions{}
+			getReportOptions.SetAccountID(accountID)
+			getReportOptions.SetReference(reference)
+			report, response, err := iamIdentityClient.GetReportWithContext(context, getReportOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return nil, identityActivityReportPending, nil
+				}
+				log.Printf("[DEBUG] GetReportWithContext failed %s\n%s", err, response)
+				return nil, "", fmt.Errorf("GetReportWithContext failed %s\n%s", err, response)
+			}
+			if report == nil || report.Reference == nil {
+				return nil, identityActivityReportPending, nil
+			}
+			return report, identityActivityReportDone, nil
+		},
+		Delay:        5 * time.Second,
+		MinTimeout:   5 * time.Second,
+		Timeout:      timeout,
+		PollInterval: 10 * time.Second,
+	}
+
+	report, err := stateConf.WaitForStateContext(context)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error waiting for identity activity report %s of account %s: %s", reference, accountID, err)
+	}
+	return report.(*iamidentityv1.Report), nil
+}
+
+func flattenIdentityActivityEntities(entities []iamidentityv1.EntityActivity) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(entities))
+	for _, entity := range entities {
+		modelMap := map[string]interface{}{
+			"id": entity.ID,
+		}
+		if entity.Name != nil {
+			modelMap["name"] = entity.Name
+		}
+		if entity.LastAuthn != nil {
+			modelMap["last_authn"] = entity.LastAuthn
+		}
+		result = append(result, modelMap)
+	}
+	return result
+}
+
+func flattenIdentityActivityAPIKeys(apikeys []iamidentityv1.ApikeyActivity) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(apikeys))
+	for _, apikey := range apikeys {
+		modelMap := map[string]interface{}{
+			"id":   apikey.ID,
+			"type": apikey.Type,
+		}
+		if apikey.Name != nil {
+			modelMap["name"] = apikey.Name
+		}
+		if apikey.Serviceid != nil && apikey.Serviceid.ID != nil {
+			modelMap["service_id"] = apikey.Serviceid.ID
+		}
+		if apikey.User != nil && apikey.User.IamID != nil {
+			modelMap["iam_id"] = apikey.User.IamID
+		}
+		if apikey.LastAuthn != nil {
+			modelMap["last_authn"] = apikey.LastAuthn
+		}
+		result = append(result, modelMap)
+	}
+	return result
+}
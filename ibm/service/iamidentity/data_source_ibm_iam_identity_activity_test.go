@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMIAMIdentityActivityDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMIdentityActivityDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_iam_identity_activity.activity", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_identity_activity.activity", "account_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_identity_activity.activity", "reference"),
+					resource.TestCheckResourceAttr("data.ibm_iam_identity_activity.activity", "report_duration", "720"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_identity_activity.activity", "report_start_time"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_identity_activity.activity", "report_end_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMIdentityActivityDataSourceConfigBasic() string {
+	return `
+		data "ibm_iam_identity_activity" "activity" {
+			inactive_hours = 720
+		}
+	`
+}
@@ -0,0 +1,648 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+const (
+	templateAssignmentTypeAccountSettings = "account_settings"
+	templateAssignmentTypeTrustedProfile  = "trusted_profile"
+	templateAssignmentTypeAccessGroup     = "access_group"
+
+	templateAssignmentResourceSucceeded = "succeeded"
+)
+
+// templateAssignmentDrift is a field of an IAM resource in a target account that differs from the template
+type templateAssignmentDrift struct {
+	field    string
+	expected string
+	actual   string
+}
+
+// templateAssignmentTarget is the compliance of the IAM resource created by a template assignment in one account
+type templateAssignmentTarget struct {
+	accountID  string
+	status     string
+	resourceID string
+	drifts     []templateAssignmentDrift
+}
+
+// templateAssignmentDriftReport is the compliance of all the target accounts of a template assignment
+type templateAssignmentDriftReport struct {
+	templateID      string
+	templateVersion string
+	status          string
+	targets         []*templateAssignmentTarget
+}
+
+func (target *templateAssignmentTarget) compareString(field string, expected, actual *string) {
+	// A field which is not set in the template is not managed by the template
+	if expected == nil || *expected == "" || *expected == "NOT_SET" {
+		return
+	}
+	if actual == nil || *actual != *expected {
+		target.drifts = append(target.drifts, templateAssignmentDrift{
+			field:    field,
+			expected: *expected,
+			actual:   core.StringNilMapper(actual),
+		})
+	}
+}
+
+// compareSet reports the items which are missing or which are not expected in the account, unless the template allows
+// the account administrators to add or to remove them
+func (target *templateAssignmentTarget) compareSet(field string, expected, actual []string, allowAdd, allowRemove bool) {
+	actualItems := map[string]bool{}
+	for _, item := range actual {
+		actualItems[item] = true
+	}
+	expectedItems := map[string]bool{}
+	drift := false
+	for _, item := range expected {
+		expectedItems[item] = true
+		if !actualItems[item] && !allowRemove {
+			drift = true
+		}
+	}
+	for _, item := range actual {
+		if !expectedItems[item] && !allowAdd {
+			drift = true
+		}
+	}
+	if drift {
+		sort.Strings(expected)
+		sort.Strings(actual)
+		target.drifts = append(target.drifts, templateAssignmentDrift{
+			field:    field,
+			expected: strings.Join(expected, ","),
+			actual:   strings.Join(actual, ","),
+		})
+	}
+}
+
+// compareStatus reports a resource of the template which was not applied to the account
+func (target *templateAssignmentTarget) compareStatus(field string, status *string) {
+	if status != nil && *status != templateAssignmentResourceSucceeded {
+		target.drifts = append(target.drifts, templateAssignmentDrift{
+			field:    field,
+			expected: templateAssignmentResourceSucceeded,
+			actual:   *status,
+		})
+	}
+}
+
+func (target *templateAssignmentTarget) notFound(field string) {
+	target.drifts = append(target.drifts, templateAssignmentDrift{
+		field:    field,
+		expected: "exists",
+		actual:   "not found",
+	})
+}
+
+func DataSourceIBMIAMTemplateAssignmentDrift() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIAMTemplateAssignmentDriftRead,
+
+		Schema: map[string]*schema.Schema{
+			"assignment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the template assignment.",
+			},
+			"assignment_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					templateAssignmentTypeAccountSettings,
+					templateAssignmentTypeTrustedProfile,
+					templateAssignmentTypeAccessGroup,
+				}, false),
+				Description: "Type of the template assignment. Allowable values are: account_settings, trusted_profile, access_group.",
+			},
+			"fail_on_drift": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fails the read when a target account is not compliant with the template, instead of returning warnings.",
+			},
+			"template_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the assigned template.",
+			},
+			"template_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the assigned template.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the template assignment.",
+			},
+			"compliant": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether all the target accounts are compliant with the template.",
+			},
+			"accounts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Compliance of each target account of the assignment.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the target account.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the assignment in the target account.",
+						},
+						"resource_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the IAM resource created by the assignment in the target account.",
+						},
+						"compliant": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the target account is compliant with the template.",
+						},
+						"drifts": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Fields of the IAM resource which differ from the template.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of the field.",
+									},
+									"expected": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Value of the field in the template.",
+									},
+									"actual": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Value of the field in the target account.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIAMTemplateAssignmentDriftRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	assignmentID := d.Get("assignment_id").(string)
+
+	var report *templateAssignmentDriftReport
+	var err error
+	switch d.Get("assignment_type").(string) {
+	case templateAssignmentTypeAccountSettings:
+		report, err = accountSettingsAssignmentDrift(context, assignmentID, meta)
+	case templateAssignmentTypeTrustedProfile:
+		report, err = trustedProfileAssignmentDrift(context, assignmentID, meta)
+	case templateAssignmentTypeAccessGroup:
+		report, err = accessGroupAssignmentDrift(context, assignmentID, meta)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(assignmentID)
+
+	var diags diag.Diagnostics
+	var noncompliant []string
+	compliant := true
+	accounts := make([]map[string]interface{}, 0, len(report.targets))
+	for _, target := range report.targets {
+		drifts := make([]map[string]interface{}, 0, len(target.drifts))
+		details := make([]string, 0, len(target.drifts))
+		for _, drift := range target.drifts {
+			drifts = append(drifts, map[string]interface{}{
+				"field":    drift.field,
+				"expected": drift.expected,
+				"actual":   drift.actual,
+			})
+			details = append(details, fmt.Sprintf("%s: expected %q, got %q", drift.field, drift.expected, drift.actual))
+		}
+		if len(target.drifts) > 0 {
+			compliant = false
+			noncompliant = append(noncompliant, target.accountID)
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Account %s is not compliant with template %s version %s", target.accountID, report.templateID, report.templateVersion),
+				Detail:   strings.Join(details, "\n"),
+			})
+		}
+		accounts = append(accounts, map[string]interface{}{
+			"account_id":  target.accountID,
+			"status":      target.status,
+			"resource_id": target.resourceID,
+			"compliant":   len(target.drifts) == 0,
+			"drifts":      drifts,
+		})
+	}
+
+	if !compliant && d.Get("fail_on_drift").(bool) {
+		return diag.FromErr(fmt.Errorf("[ERROR] Accounts %s are not compliant with template %s version %s of assignment %s", strings.Join(noncompliant, ", "), report.templateID, report.templateVersion, assignmentID))
+	}
+
+	if err = d.Set("template_id", report.templateID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting template_id: %s", err))
+	}
+	if err = d.Set("template_version", report.templateVersion); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting template_version: %s", err))
+	}
+	if err = d.Set("status", report.status); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting status: %s", err))
+	}
+	if err = d.Set("compliant", compliant); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting compliant: %s", err))
+	}
+	if err = d.Set("accounts", accounts); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting accounts: %s", err))
+	}
+
+	return diags
+}
+
+// templateAssignmentResourceID returns the ID of the IAM resource created in the target account, if any
+func templateAssignmentResourceID(detail *iamidentityv1.TemplateAssignmentResponseResourceDetail) string {
+	if detail == nil || detail.ResourceCreated == nil || detail.ResourceCreated.ID == nil {
+		return ""
+	}
+	return *detail.ResourceCreated.ID
+}
+
+func accountSettingsAssignmentDrift(context context.Context, assignmentID string, meta interface{}) (*templateAssignmentDriftReport, error) {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return nil, err
+	}
+
+	getAccountSettingsAssignmentOptions := &iamidentityv1.GetAccountSettingsAssignmentOptions{}
+	getAccountSettingsAssignmentOptions.SetAssignmentID(assignmentID)
+	assignment, response, err := iamIdentityClient.GetAccountSettingsAssignmentWithContext(context, getAccountSettingsAssignmentOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetAccountSettingsAssignmentWithContext failed %s\n%s", err, response)
+		return nil, fmt.Errorf("GetAccountSettingsAssignmentWithContext failed %s\n%s", err, response)
+	}
+
+	getAccountSettingsTemplateVersionOptions := &iamidentityv1.GetAccountSettingsTemplateVersionOptions{}
+	getAccountSettingsTemplateVersionOptions.SetTemplateID(*assignment.TemplateID)
+	getAccountSettingsTemplateVersionOptions.SetVersion(strconv.FormatInt(*assignment.TemplateVersion, 10))
+	template, response, err := iamIdentityClient.GetAccountSettingsTemplateVersionWithContext(context, getAccountSettingsTemplateVersionOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetAccountSettingsTemplateVersionWithContext failed %s\n%s", err, response)
+		return nil, fmt.Errorf("GetAccountSettingsTemplateVersionWithContext failed %s\n%s", err, response)
+	}
+
+	report := &templateAssignmentDriftReport{
+		templateID:      *assignment.TemplateID,
+		templateVersion: strconv.FormatInt(*assignment.TemplateVersion, 10),
+		status:          *assignment.Status,
+	}
+	expected := template.AccountSettings
+	for _, resource := range assignment.Resources {
+		target := &templateAssignmentTarget{
+			accountID:  *resource.Target,
+			resourceID: templateAssignmentResourceID(resource.AccountSettings),
+		}
+		report.targets = append(report.targets, target)
+		if resource.AccountSettings != nil {
+			target.status = core.StringNilMapper(resource.AccountSettings.Status)
+			target.compareStatus("status", resource.AccountSettings.Status)
+		}
+		if expected == nil {
+			continue
+		}
+
+		getAccountSettingsOptions := &iamidentityv1.GetAccountSettingsOptions{}
+		getAccountSettingsOptions.SetAccountID(target.accountID)
+		actual, response, err := iamIdentityClient.GetAccountSettingsWithContext(context, getAccountSettingsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetAccountSettingsWithContext failed %s\n%s", err, response)
+			return nil, fmt.Errorf("GetAccountSettingsWithContext failed for account %s %s\n%s", target.accountID, err, response)
+		}
+
+		target.compareString("restrict_create_service_id", expected.RestrictCreateServiceID, actual.RestrictCreateServiceID)
+		target.compareString("restrict_create_platform_apikey", expected.RestrictCreatePlatformApikey, actual.RestrictCreatePlatformApikey)
+		target.compareString("allowed_ip_addresses", expected.AllowedIPAddresses, actual.AllowedIPAddresses)
+		target.compareString("mfa", expected.Mfa, actual.Mfa)
+		target.compareString("session_expiration_in_seconds", expected.SessionExpirationInSeconds, actual.SessionExpirationInSeconds)
+		target.compareString("session_invalidation_in_seconds", expected.SessionInvalidationInSeconds, actual.SessionInvalidationInSeconds)
+		target.compareString("max_sessions_per_identity", expected.MaxSessionsPerIdentity, actual.MaxSessionsPerIdentity)
+		target.compareString("system_access_token_expiration_in_seconds", expected.SystemAccessTokenExpirationInSeconds, actual.SystemAccessTokenExpirationInSeconds)
+		target.compareString("system_refresh_token_expiration_in_seconds", expected.SystemRefreshTokenExpirationInSeconds, actual.SystemRefreshTokenExpirationInSeconds)
+		if expected.UserMfa != nil {
+			target.compareSet("user_mfa", accountSettingsUserMfaKeys(expected.UserMfa), accountSettingsUserMfaKeys(actual.UserMfa), false, false)
+		}
+	}
+
+	return report, nil
+}
+
+func accountSettingsUserMfaKeys(userMfa []iamidentityv1.AccountSettingsUserMfa) []string {
+	keys := make([]string, 0, len(userMfa))
+	for _, user := range userMfa {
+		keys = append(keys, fmt.Sprintf("%s:%s", core.StringNilMapper(user.IamID), core.StringNilMapper(user.Mfa)))
+	}
+	return keys
+}
+
+func trustedProfileAssignmentDrift(context context.Context, assignmentID string, meta interface{}) (*templateAssignmentDriftReport, error) {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return nil, err
+	}
+
+	getTrustedProfileAssignmentOptions := &iamidentityv1.GetTrustedProfileAssignmentOptions{}
+	getTrustedProfileAssignmentOptions.SetAssignmentID(assignmentID)
+	assignment, response, err := iamIdentityClient.GetTrustedProfileAssignmentWithContext(context, getTrustedProfileAssignmentOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetTrustedProfileAssignmentWithContext failed %s\n%s", err, response)
+		return nil, fmt.Errorf("GetTrustedProfileAssignmentWithContext failed %s\n%s", err, response)
+	}
+
+	getProfileTemplateVersionOptions := &iamidentityv1.GetProfileTemplateVersionOptions{}
+	getProfileTemplateVersionOptions.SetTemplateID(*assignment.TemplateID)
+	getProfileTemplateVersionOptions.SetVersion(strconv.FormatInt(*assignment.TemplateVersion, 10))
+	template, response, err := iamIdentityClient.GetProfileTemplateVersionWithContext(context, getProfileTemplateVersionOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetProfileTemplateVersionWithContext failed %s\n%s", err, response)
+		return nil, fmt.Errorf("GetProfileTemplateVersionWithContext failed %s\n%s", err, response)
+	}
+
+	report := &templateAssignmentDriftReport{
+		templateID:      *assignment.TemplateID,
+		templateVersion: strconv.FormatInt(*assignment.TemplateVersion, 10),
+		status:          *assignment.Status,
+	}
+	expected := template.Profile
+	for _, resource := range assignment.Resources {
+		target := &templateAssignmentTarget{
+			accountID:  *resource.Target,
+			resourceID: templateAssignmentResourceID(resource.Profile),
+		}
+		report.targets = append(report.targets, target)
+		if resource.Profile != nil {
+			target.status = core.StringNilMapper(resource.Profile.Status)
+			target.compareStatus("status", resource.Profile.Status)
+		}
+		for _, policy := range resource.PolicyTemplateRefs {
+			target.compareStatus(fmt.Sprintf("policy_template_references.%s", core.StringNilMapper(policy.ID)), policy.Status)
+		}
+		if expected == nil || target.resourceID == "" {
+			continue
+		}
+
+		getProfileOptions := &iamidentityv1.GetProfileOptions{}
+		getProfileOptions.SetProfileID(target.resourceID)
+		profile, response, err := iamIdentityClient.GetProfileWithContext(context, getProfileOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				target.notFound("profile")
+				continue
+			}
+			log.Printf("[DEBUG] GetProfileWithContext failed %s\n%s", err, response)
+			return nil, fmt.Errorf("GetProfileWithContext failed for account %s %s\n%s", target.accountID, err, response)
+		}
+		target.compareString("name", expected.Name, profile.Name)
+		target.compareString("description", expected.Description, profile.Description)
+
+		listClaimRulesOptions := &iamidentityv1.ListClaimRulesOptions{}
+		listClaimRulesOptions.SetProfileID(target.resourceID)
+		rules, response, err := iamIdentityClient.ListClaimRulesWithContext(context, listClaimRulesOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListClaimRulesWithContext failed %s\n%s", err, response)
+			return nil, fmt.Errorf("ListClaimRulesWithContext failed for account %s %s\n%s", target.accountID, err, response)
+		}
+		expirations := map[string]bool{}
+		expectedRules := make([]string, 0, len(expected.Rules))
+		for _, rule := range expected.Rules {
+			expirations[core.StringNilMapper(rule.Name)] = rule.Expiration != nil
+			expectedRules = append(expectedRules, profileClaimRuleKey(rule.Name, rule.Type, rule.RealmName, rule.Expiration, rule.Conditions))
+		}
+		actualRules := make([]string, 0, len(rules.Rules))
+		for _, rule := range rules.Rules {
+			expiration := rule.Expiration
+			if !expirations[core.StringNilMapper(rule.Name)] {
+				expiration = nil
+			}
+			actualRules = append(actualRules, profileClaimRuleKey(rule.Name, rule.Type, rule.RealmName, expiration, rule.Conditions))
+		}
+		target.compareSet("rules", expectedRules, actualRules, false, false)
+
+		getProfileIdentitiesOptions := &iamidentityv1.GetProfileIdentitiesOptions{}
+		getProfileIdentitiesOptions.SetProfileID(target.resourceID)
+		identities, response, err := iamIdentityClient.GetProfileIdentitiesWithContext(context, getProfileIdentitiesOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetProfileIdentitiesWithContext failed %s\n%s", err, response)
+			return nil, fmt.Errorf("GetProfileIdentitiesWithContext failed for account %s %s\n%s", target.accountID, err, response)
+		}
+		target.compareSet("identities", profileIdentityKeys(expected.Identities), profileIdentityKeys(identities.Identities), false, false)
+	}
+
+	return report, nil
+}
+
+func templateActionControl(control *bool) bool {
+	return control != nil && *control
+}
+
+// profileClaimRuleKey identifies a claim rule by all its fields, so that a modified rule is reported as different. The
+// expiration is only compared when the template sets it, as the service sets a default one.
+func profileClaimRuleKey(name, ruleType, realmName *string, expiration *int64, conditions []iamidentityv1.ProfileClaimRuleConditions) string {
+	keys := make([]string, 0, len(conditions))
+	for _, condition := range conditions {
+		keys = append(keys, fmt.Sprintf("%s %s %s", core.StringNilMapper(condition.Claim), core.StringNilMapper(condition.Operator), core.StringNilMapper(condition.Value)))
+	}
+	sort.Strings(keys)
+	key := fmt.Sprintf("%s(%s", core.StringNilMapper(name), core.StringNilMapper(ruleType))
+	if realmName != nil {
+		key += ", " + *realmName
+	}
+	if expiration != nil {
+		key += fmt.Sprintf(", %ds", *expiration)
+	}
+	return key + ")[" + strings.Join(keys, "; ") + "]"
+}
+
+func profileIdentityKeys(identities []iamidentityv1.ProfileIdentityResponse) []string {
+	keys := make([]string, 0, len(identities))
+	for _, identity := range identities {
+		keys = append(keys, fmt.Sprintf("%s:%s", core.StringNilMapper(identity.Type), core.StringNilMapper(identity.Identifier)))
+	}
+	return keys
+}
+
+func accessGroupAssignmentDrift(context context.Context, assignmentID string, meta interface{}) (*templateAssignmentDriftReport, error) {
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return nil, err
+	}
+
+	getAssignmentOptions := &iamaccessgroupsv2.GetAssignmentOptions{}
+	getAssignmentOptions.SetAssignmentID(assignmentID)
+	getAssignmentOptions.SetVerbose(true)
+	assignment, response, err := iamAccessGroupsClient.GetAssignmentWithContext(context, getAssignmentOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetAssignmentWithContext failed %s\n%s", err, response)
+		return nil, fmt.Errorf("GetAssignmentWithContext failed %s\n%s", err, response)
+	}
+
+	getTemplateVersionOptions := &iamaccessgroupsv2.GetTemplateVersionOptions{}
+	getTemplateVersionOptions.SetTemplateID(*assignment.TemplateID)
+	getTemplateVersionOptions.SetVersionNum(*assignment.TemplateVersion)
+	template, response, err := iamAccessGroupsClient.GetTemplateVersionWithContext(context, getTemplateVersionOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetTemplateVersionWithContext failed %s\n%s", err, response)
+		return nil, fmt.Errorf("GetTemplateVersionWithContext failed %s\n%s", err, response)
+	}
+
+	report := &templateAssignmentDriftReport{
+		templateID:      *assignment.TemplateID,
+		templateVersion: *assignment.TemplateVersion,
+		status:          *assignment.Status,
+	}
+	expected := template.Group
+	for _, resource := range assignment.Resources {
+		target := &templateAssignmentTarget{
+			accountID: core.StringNilMapper(resource.Target),
+		}
+		report.targets = append(report.targets, target)
+		if resource.Group != nil && resource.Group.Group != nil {
+			target.resourceID = core.StringNilMapper(resource.Group.Group.ID)
+			target.status = core.StringNilMapper(resource.Group.Group.Status)
+			target.compareStatus("status", resource.Group.Group.Status)
+		}
+		for _, policy := range resource.PolicyTemplateReferences {
+			target.compareStatus(fmt.Sprintf("policy_template_references.%s", core.StringNilMapper(policy.ID)), policy.Status)
+		}
+		if expected == nil || target.resourceID == "" {
+			continue
+		}
+
+		getAccessGroupOptions := &iamaccessgroupsv2.GetAccessGroupOptions{}
+		getAccessGroupOptions.SetAccessGroupID(target.resourceID)
+		group, response, err := iamAccessGroupsClient.GetAccessGroupWithContext(context, getAccessGroupOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				target.notFound("group")
+				continue
+			}
+			log.Printf("[DEBUG] GetAccessGroupWithContext failed %s\n%s", err, response)
+			return nil, fmt.Errorf("GetAccessGroupWithContext failed for account %s %s\n%s", target.accountID, err, response)
+		}
+		target.compareString("name", expected.Name, group.Name)
+		target.compareString("description", expected.Description, group.Description)
+
+		if expected.Members != nil {
+			members, err := listAccessGroupMemberIDs(context, iamAccessGroupsClient, target.resourceID)
+			if err != nil {
+				return nil, err
+			}
+			var allowAdd, allowRemove bool
+			if expected.Members.ActionControls != nil {
+				allowAdd = templateActionControl(expected.Members.ActionControls.Add)
+				allowRemove = templateActionControl(expected.Members.ActionControls.Remove)
+			}
+			expectedMembers := append(append([]string{}, expected.Members.Users...), expected.Members.Services...)
+			target.compareSet("members", expectedMembers, members, allowAdd, allowRemove)
+		}
+
+		if expected.Assertions != nil {
+			listAccessGroupRulesOptions := &iamaccessgroupsv2.ListAccessGroupRulesOptions{}
+			listAccessGroupRulesOptions.SetAccessGroupID(target.resourceID)
+			rules, response, err := iamAccessGroupsClient.ListAccessGroupRulesWithContext(context, listAccessGroupRulesOptions)
+			if err != nil {
+				log.Printf("[DEBUG] ListAccessGroupRulesWithContext failed %s\n%s", err, response)
+				return nil, fmt.Errorf("ListAccessGroupRulesWithContext failed for account %s %s\n%s", target.accountID, err, response)
+			}
+			var allowAdd, allowRemove bool
+			if expected.Assertions.ActionControls != nil {
+				allowAdd = templateActionControl(expected.Assertions.ActionControls.Add)
+				allowRemove = templateActionControl(expected.Assertions.ActionControls.Remove)
+			}
+			expirations := map[string]bool{}
+			expectedRules := make([]string, 0, len(expected.Assertions.Rules))
+			for _, rule := range expected.Assertions.Rules {
+				expirations[core.StringNilMapper(rule.Name)] = rule.Expiration != nil
+				conditions := make([]iamidentityv1.ProfileClaimRuleConditions, 0, len(rule.Conditions))
+				for _, condition := range rule.Conditions {
+					conditions = append(conditions, iamidentityv1.ProfileClaimRuleConditions{Claim: condition.Claim, Operator: condition.Operator, Value: condition.Value})
+				}
+				expectedRules = append(expectedRules, profileClaimRuleKey(rule.Name, nil, rule.RealmName, rule.Expiration, conditions))
+				if rule.ActionControls != nil && templateActionControl(rule.ActionControls.Remove) {
+					allowRemove = true
+				}
+			}
+			actualRules := make([]string, 0, len(rules.Rules))
+			for _, rule := range rules.Rules {
+				conditions := make([]iamidentityv1.ProfileClaimRuleConditions, 0, len(rule.Conditions))
+				for _, condition := range rule.Conditions {
+					conditions = append(conditions, iamidentityv1.ProfileClaimRuleConditions{Claim: condition.Claim, Operator: condition.Operator, Value: condition.Value})
+				}
+				expiration := rule.Expiration
+				if !expirations[core.StringNilMapper(rule.Name)] {
+					expiration = nil
+				}
+				actualRules = append(actualRules, profileClaimRuleKey(rule.Name, nil, rule.RealmName, expiration, conditions))
+			}
+			target.compareSet("rules", expectedRules, actualRules, allowAdd, allowRemove)
+		}
+	}
+
+	return report, nil
+}
+
+func listAccessGroupMemberIDs(context context.Context, iamAccessGroupsClient *iamaccessgroupsv2.IamAccessGroupsV2, accessGroupID string) ([]string, error) {
+	var members []string
+	offset := int64(0)
+	for {
+		listAccessGroupMembersOptions := &iamaccessgroupsv2.ListAccessGroupMembersOptions{}
+		listAccessGroupMembersOptions.SetAccessGroupID(accessGroupID)
+		listAccessGroupMembersOptions.SetLimit(100)
+		listAccessGroupMembersOptions.SetOffset(offset)
+		result, response, err := iamAccessGroupsClient.ListAccessGroupMembersWithContext(context, listAccessGroupMembersOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListAccessGroupMembersWithContext failed %s\n%s", err, response)
+			return nil, fmt.Errorf("ListAccessGroupMembersWithContext failed for access group %s %s\n%s", accessGroupID, err, response)
+		}
+		for _, member := range result.Members {
+			members = append(members, core.StringNilMapper(member.IamID))
+		}
+		offset += int64(len(result.Members))
+		if len(result.Members) == 0 || result.TotalCount == nil || offset >= *result.TotalCount {
+			return members, nil
+		}
+	}
+}
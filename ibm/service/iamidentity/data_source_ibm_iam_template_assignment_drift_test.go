@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMIAMTemplateAssignmentDriftDataSourceBasic(t *testing.T) {
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acc.TestAccPreCheckEnterprise(t)
+			acc.TestAccPreCheckAssignmentTargetAccount(t)
+		},
+		Providers:                 acc.TestAccProviders,
+		CheckDestroy:              testAccCheckIBMTrustedProfileTemplateAssignmentDataSourceDestroy,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config:             testAccCheckIBMIAMTemplateAssignmentDriftDataSourceConfigBasic(name),
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_iam_template_assignment_drift.drift", "template_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_template_assignment_drift.drift", "template_version"),
+					resource.TestCheckResourceAttr("data.ibm_iam_template_assignment_drift.drift", "compliant", "true"),
+					resource.TestCheckResourceAttr("data.ibm_iam_template_assignment_drift.drift", "accounts.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_iam_template_assignment_drift.drift", "accounts.0.account_id", acc.IamIdentityAssignmentTargetAccountId),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_template_assignment_drift.drift", "accounts.0.resource_id"),
+					resource.TestCheckResourceAttr("data.ibm_iam_template_assignment_drift.drift", "accounts.0.drifts.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMTemplateAssignmentDriftDataSourceConfigBasic(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_trusted_profile_template" "trusted_profile_template" {
+			name = "%s"
+			profile {
+				name = "%s"
+			}
+			committed = "true"
+		}
+
+		resource "ibm_iam_trusted_profile_template_assignment" "trusted_profile_template_assignment_instance" {
+			template_id = split("/", ibm_iam_trusted_profile_template.trusted_profile_template.id)[0]
+			template_version = ibm_iam_trusted_profile_template.trusted_profile_template.version
+			target_type = "Account"
+			target = "%s"
+
+			timeouts {
+				create = "5m"
+			}
+		}
+
+		data "ibm_iam_template_assignment_drift" "drift" {
+			assignment_id   = ibm_iam_trusted_profile_template_assignment.trusted_profile_template_assignment_instance.id
+			assignment_type = "trusted_profile"
+			fail_on_drift   = true
+		}
+	`, name, name, acc.IamIdentityAssignmentTargetAccountId)
+}
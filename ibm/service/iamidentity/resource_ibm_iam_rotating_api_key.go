@@ -0,0 +1,464 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The attributes that change when the API key is rotated
+var rotatingAPIKeyRotationAttributes = []string{"current_key_id", "apikey", "last_rotated_at", "next_rotation_at", "keys", "secret_version_id"}
+
+func ResourceIBMIAMRotatingAPIKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMRotatingAPIKeyCreate,
+		ReadContext:   resourceIBMIAMRotatingAPIKeyRead,
+		UpdateContext: resourceIBMIAMRotatingAPIKeyUpdate,
+		DeleteContext: resourceIBMIAMRotatingAPIKeyDelete,
+		CustomizeDiff: resourceIBMIAMRotatingAPIKeyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name prefix of the API keys. Each API key is named after the prefix and its creation time",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the API keys created from now on",
+			},
+			"iam_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The iam_id of the service ID or user that the API keys authenticate",
+			},
+			"store_value": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the value of the API keys created from now on is retrievable in the future",
+			},
+			"keep_keys": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of API keys that are kept alive, including the current API key",
+			},
+			"rotation_interval_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of days after which a new API key is created",
+			},
+			"grace_period_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The number of hours after a rotation before the API keys in excess of keep_keys are deleted",
+			},
+			"keepers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, triggers a new rotation of the API key",
+			},
+			"secrets_manager": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The arbitrary or key-value secret that the current API key is stored into",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the Secrets Manager instance",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The region of the Secrets Manager instance",
+						},
+						"endpoint_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+							Description:  "public or private",
+						},
+						"secret_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the arbitrary or key-value secret",
+						},
+					},
+				},
+			},
+			"current_key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the current API key",
+			},
+			"apikey": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The value of the current API key",
+			},
+			"last_rotated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time the current API key was created",
+			},
+			"next_rotation_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time after which the next apply rotates the API key",
+			},
+			"secret_version_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the secret version that holds the current API key",
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The API keys that are alive, from the newest to the oldest",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the API key",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the API key",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time the API key was created",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceIBMIAMRotatingAPIKeyCustomizeDiff plans a rotation when the current API key is due or missing, and
+// the deletion of the API keys whose grace period is over. The apply only performs what is planned here, so
+// that the outcome does not depend on the time between the plan and the apply.
+func resourceIBMIAMRotatingAPIKeyCustomizeDiff(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if d.HasChange("keepers") || d.Get("current_key_id").(string) == "" || rotatingAPIKeyRotationDue(d.Get("last_rotated_at").(string), d.Get("rotation_interval_days").(int), time.Now()) {
+		for _, k := range rotatingAPIKeyRotationAttributes {
+			if err := d.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	keys := d.Get("keys").([]interface{})
+	if expired := rotatingAPIKeysToDelete(keys, d.Get("keep_keys").(int), d.Get("grace_period_hours").(int), time.Now()); len(expired) > 0 {
+		return d.SetNew("keys", keys[:len(keys)-len(expired)])
+	}
+	return nil
+}
+
+func resourceIBMIAMRotatingAPIKeyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("iam_id").(string), d.Get("name").(string)))
+	d.Set("keys", []interface{}{})
+
+	if err := rotateIBMIAMRotatingAPIKey(context, d, meta); err != nil {
+		if len(d.Get("keys").([]interface{})) == 0 {
+			d.SetId("")
+		}
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIAMRotatingAPIKeyRead(context, d, meta)
+}
+
+func resourceIBMIAMRotatingAPIKeyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	currentKeyID := d.Get("current_key_id").(string)
+	keys := []interface{}{}
+	for _, k := range d.Get("keys").([]interface{}) {
+		key := k.(map[string]interface{})
+		apiKeyID := key["id"].(string)
+		_, response, err := iamIdentityClient.GetAPIKeyWithContext(context, &iamidentityv1.GetAPIKeyOptions{
+			ID: &apiKeyID,
+		})
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				log.Printf("[WARN] API key %s was deleted outside of Terraform", apiKeyID)
+				if apiKeyID == currentKeyID {
+					currentKeyID = ""
+				}
+				continue
+			}
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving API Key %s: %s\n%s", apiKeyID, err, response))
+		}
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		d.SetId("")
+		return nil
+	}
+	d.Set("keys", keys)
+	d.Set("current_key_id", currentKeyID)
+	if currentKeyID == "" {
+		d.Set("apikey", "")
+	}
+	if interval := d.Get("rotation_interval_days").(int); interval > 0 {
+		if lastRotatedAt, err := time.Parse(time.RFC3339, d.Get("last_rotated_at").(string)); err == nil {
+			d.Set("next_rotation_at", lastRotatedAt.AddDate(0, 0, interval).Format(time.RFC3339))
+		}
+	} else {
+		d.Set("next_rotation_at", "")
+	}
+
+	return nil
+}
+
+func resourceIBMIAMRotatingAPIKeyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// A rotation is planned by leaving current_key_id unknown, a deletion by planning the remaining keys
+	if d.Get("current_key_id").(string) == "" {
+		if err := rotateIBMIAMRotatingAPIKey(context, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		if d.HasChange("secrets_manager") {
+			if err := pushIBMIAMRotatingAPIKey(context, d, meta); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if err := deletePlannedIBMIAMRotatingAPIKeys(context, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIAMRotatingAPIKeyRead(context, d, meta)
+}
+
+func resourceIBMIAMRotatingAPIKeyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, k := range d.Get("keys").([]interface{}) {
+		apiKeyID := k.(map[string]interface{})["id"].(string)
+		response, err := iamIdentityClient.DeleteAPIKeyWithContext(context, &iamidentityv1.DeleteAPIKeyOptions{
+			ID: &apiKeyID,
+		})
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting API Key %s: %s\n%s", apiKeyID, err, response))
+		}
+	}
+	d.SetId("")
+
+	return nil
+}
+
+// rotateIBMIAMRotatingAPIKey creates a new API key, stores it into Secrets Manager and deletes the API keys
+// whose grace period is over
+func rotateIBMIAMRotatingAPIKey(context context.Context, d *schema.ResourceData, meta interface{}) error {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return err
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	name := fmt.Sprintf("%s-%s", d.Get("name").(string), now.Format("20060102150405"))
+	iamID := d.Get("iam_id").(string)
+	storeValue := d.Get("store_value").(bool)
+	createAPIKeyOptions := &iamidentityv1.CreateAPIKeyOptions{
+		Name:       &name,
+		IamID:      &iamID,
+		AccountID:  &userDetails.UserAccount,
+		StoreValue: &storeValue,
+	}
+	if des, ok := d.GetOk("description"); ok {
+		desString := des.(string)
+		createAPIKeyOptions.Description = &desString
+	}
+
+	apiKey, response, err := iamIdentityClient.CreateAPIKeyWithContext(context, createAPIKeyOptions)
+	if err != nil || apiKey == nil {
+		return fmt.Errorf("[ERROR] API Key creation Error: %s\n%s", err, response)
+	}
+	log.Printf("[INFO] Created API key %s for %s", *apiKey.ID, iamID)
+
+	createdAt := now.Format(time.RFC3339)
+	if apiKey.CreatedAt != nil {
+		createdAt = time.Time(*apiKey.CreatedAt).UTC().Format(time.RFC3339)
+	}
+	// The planned keys are unknown during a rotation, the new API key is added to the keys in the state
+	oldKeys, _ := d.GetChange("keys")
+	keys := append([]interface{}{map[string]interface{}{
+		"id":         *apiKey.ID,
+		"name":       name,
+		"created_at": createdAt,
+	}}, oldKeys.([]interface{})...)
+	d.Set("keys", keys)
+	d.Set("current_key_id", *apiKey.ID)
+	d.Set("apikey", *apiKey.Apikey)
+	d.Set("last_rotated_at", createdAt)
+
+	if err = pushIBMIAMRotatingAPIKey(context, d, meta); err != nil {
+		return err
+	}
+	return deleteExpiredIBMIAMRotatingAPIKeys(context, d, meta)
+}
+
+// pushIBMIAMRotatingAPIKey stores the current API key into the configured secret
+func pushIBMIAMRotatingAPIKey(context context.Context, d *schema.ResourceData, meta interface{}) error {
+	sm, ok := d.GetOk("secrets_manager")
+	if !ok || len(sm.([]interface{})) == 0 || sm.([]interface{})[0] == nil {
+		d.Set("secret_version_id", "")
+		return nil
+	}
+	apikey := d.Get("apikey").(string)
+	if apikey == "" {
+		return fmt.Errorf("[ERROR] The value of the current API key is not known, it cannot be stored into Secrets Manager")
+	}
+
+	target := sm.([]interface{})[0].(map[string]interface{})
+	data := map[string]interface{}{
+		"apikey":    apikey,
+		"apikey_id": d.Get("current_key_id").(string),
+		"iam_id":    d.Get("iam_id").(string),
+	}
+	versionID, err := secretsmanager.CreateSecretVersionWithValue(context, meta, target["instance_id"].(string), target["region"].(string),
+		target["endpoint_type"].(string), target["secret_id"].(string), apikey, data)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error storing API key %s into secret %s: %s", d.Get("current_key_id").(string), target["secret_id"].(string), err)
+	}
+	d.Set("secret_version_id", versionID)
+	return nil
+}
+
+// deletePlannedIBMIAMRotatingAPIKeys deletes the API keys that the plan removes from keys
+func deletePlannedIBMIAMRotatingAPIKeys(context context.Context, d *schema.ResourceData, meta interface{}) error {
+	o, n := d.GetChange("keys")
+	planned := map[string]bool{}
+	for _, k := range n.([]interface{}) {
+		planned[k.(map[string]interface{})["id"].(string)] = true
+	}
+	oldKeys := o.([]interface{})
+	remaining := []interface{}{}
+	var iamIdentityClient *iamidentityv1.IamIdentityV1
+	for i, k := range oldKeys {
+		apiKeyID := k.(map[string]interface{})["id"].(string)
+		if planned[apiKeyID] {
+			remaining = append(remaining, k)
+			continue
+		}
+		if iamIdentityClient == nil {
+			client, err := meta.(conns.ClientSession).IAMIdentityV1API()
+			if err != nil {
+				return err
+			}
+			iamIdentityClient = client
+		}
+		response, err := iamIdentityClient.DeleteAPIKeyWithContext(context, &iamidentityv1.DeleteAPIKeyOptions{
+			ID: &apiKeyID,
+		})
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			d.Set("keys", append(remaining, oldKeys[i:]...))
+			return fmt.Errorf("[ERROR] Error deleting API Key %s: %s\n%s", apiKeyID, err, response)
+		}
+		log.Printf("[INFO] Deleted API key %s", apiKeyID)
+	}
+	d.Set("keys", remaining)
+	return nil
+}
+
+// deleteExpiredIBMIAMRotatingAPIKeys deletes the API keys in excess of keep_keys once the grace period is over.
+// It runs right after a rotation, when the newest API key was just created.
+func deleteExpiredIBMIAMRotatingAPIKeys(context context.Context, d *schema.ResourceData, meta interface{}) error {
+	keys := d.Get("keys").([]interface{})
+	expired := rotatingAPIKeysToDelete(keys, d.Get("keep_keys").(int), d.Get("grace_period_hours").(int), time.Now())
+	if len(expired) == 0 {
+		return nil
+	}
+
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return err
+	}
+	remaining := keys[:len(keys)-len(expired)]
+	for i := len(expired) - 1; i >= 0; i-- {
+		apiKeyID := expired[i]
+		response, err := iamIdentityClient.DeleteAPIKeyWithContext(context, &iamidentityv1.DeleteAPIKeyOptions{
+			ID: &apiKeyID,
+		})
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			d.Set("keys", keys[:len(keys)-len(expired)+i+1])
+			return fmt.Errorf("[ERROR] Error deleting API Key %s: %s\n%s", apiKeyID, err, response)
+		}
+		log.Printf("[INFO] Deleted API key %s", apiKeyID)
+	}
+	d.Set("keys", remaining)
+	return nil
+}
+
+// rotatingAPIKeysToDelete returns the IDs of the API keys in excess of keepKeys, from the newest to the oldest,
+// once the newest API key is older than the grace period
+func rotatingAPIKeysToDelete(keys []interface{}, keepKeys int, gracePeriodHours int, now time.Time) []string {
+	if len(keys) <= keepKeys {
+		return nil
+	}
+	newest, err := time.Parse(time.RFC3339, keys[0].(map[string]interface{})["created_at"].(string))
+	if err != nil || now.Before(newest.Add(time.Duration(gracePeriodHours)*time.Hour)) {
+		return nil
+	}
+	ids := []string{}
+	for _, k := range keys[keepKeys:] {
+		ids = append(ids, k.(map[string]interface{})["id"].(string))
+	}
+	return ids
+}
+
+// rotatingAPIKeyRotationDue reports whether the current API key is older than the rotation interval
+func rotatingAPIKeyRotationDue(lastRotatedAt string, intervalDays int, now time.Time) bool {
+	if intervalDays <= 0 || strings.TrimSpace(lastRotatedAt) == "" {
+		return false
+	}
+	last, err := time.Parse(time.RFC3339, lastRotatedAt)
+	if err != nil {
+		return false
+	}
+	return !now.Before(last.AddDate(0, 0, intervalDays))
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+func TestAccIBMIAMRotatingAPIKeyBasic(t *testing.T) {
+	name := fmt.Sprintf("terraform_rotating_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMRotatingAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMRotatingAPIKeyConfig(name, "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_rotating_api_key.rotating_key", "name", name),
+					resource.TestCheckResourceAttr("ibm_iam_rotating_api_key.rotating_key", "keys.#", "1"),
+					resource.TestCheckResourceAttrSet("ibm_iam_rotating_api_key.rotating_key", "current_key_id"),
+					resource.TestCheckResourceAttrSet("ibm_iam_rotating_api_key.rotating_key", "apikey"),
+					resource.TestCheckResourceAttrSet("ibm_iam_rotating_api_key.rotating_key", "next_rotation_at"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMRotatingAPIKeyConfig(name, "v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_rotating_api_key.rotating_key", "keys.#", "2"),
+					resource.TestCheckResourceAttrPair("ibm_iam_rotating_api_key.rotating_key", "current_key_id",
+						"ibm_iam_rotating_api_key.rotating_key", "keys.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMRotatingAPIKeyConfig(name, version string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_service_id" "serviceID" {
+			name = "%[1]s"
+		}
+
+		resource "ibm_iam_rotating_api_key" "rotating_key" {
+			name                   = "%[1]s"
+			iam_id                 = ibm_iam_service_id.serviceID.iam_id
+			rotation_interval_days = 30
+			keep_keys              = 2
+			keepers = {
+				version = "%[2]s"
+			}
+		}
+	`, name, version)
+}
+
+func testAccCheckIBMIAMRotatingAPIKeyDestroy(s *terraform.State) error {
+	iamIdentityClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_rotating_api_key" {
+			continue
+		}
+
+		apiKeyID := rs.Primary.Attributes["current_key_id"]
+		_, response, err := iamIdentityClient.GetAPIKey(&iamidentityv1.GetAPIKeyOptions{
+			ID: &apiKeyID,
+		})
+		if err == nil {
+			return fmt.Errorf("API key %s still exists", apiKeyID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for API key (%s) has been destroyed: %s", apiKeyID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,763 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	effectiveAccessRuleNone         = "none"
+	effectiveAccessRuleActive       = "active"
+	effectiveAccessRuleInactive     = "inactive"
+	effectiveAccessRuleNotEvaluated = "not_evaluated"
+)
+
+var effectiveAccessSubjects = []string{"ibm_id", "iam_id", "iam_service_id", "profile_id", "access_group_id"}
+
+// Data source to find the effective access of a subject on a resource, from its own policies and the
+// policies of its access groups
+func DataSourceIBMIAMEffectiveAccess() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMIAMEffectiveAccessRead,
+
+		Schema: map[string]*schema.Schema{
+			"ibm_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: effectiveAccessSubjects,
+				Description:  "The ibm id or email of the user",
+			},
+			"iam_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: effectiveAccessSubjects,
+				Description:  "The IAM ID of the user, service ID or trusted profile",
+			},
+			"iam_service_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: effectiveAccessSubjects,
+				Description:  "The UUID of the service ID",
+			},
+			"profile_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: effectiveAccessSubjects,
+				Description:  "The UUID of the trusted profile",
+			},
+			"access_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: effectiveAccessSubjects,
+				Description:  "The ID of the access group",
+			},
+			"include_access_groups": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to include the policies of the access groups that the subject is a member of",
+			},
+			"evaluation_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The time at which the time-based rule conditions of the policies are evaluated, in RFC 3339 format. Defaults to the current time",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"target": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The resource on which the effective access is evaluated",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Service name of the resource",
+						},
+						"resource_instance_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the resource instance",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Region of the resource",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Resource type of the resource",
+						},
+						"resource": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Resource of the resource",
+						},
+						"resource_group_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the resource group of the resource",
+						},
+						"service_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Service type of the resource. Defaults to service when a service name is set",
+						},
+						"service_group_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Service group id of the resource",
+						},
+						"attributes": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Additional attributes of the resource in the form of 'name=value,name=value....",
+						},
+						"resource_tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Access management tags of the resource in the form of 'name=value,name=value....",
+						},
+					},
+				},
+			},
+			"subject_iam_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IAM ID of the subject, when the subject is not an access group",
+			},
+			"access_group_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the access groups whose policies were evaluated",
+			},
+			"roles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The roles that the subject has on the resource",
+			},
+			"conditional_roles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The roles granted by policies whose rule conditions cannot be evaluated, and that the subject may have on the resource",
+			},
+			"policy_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the policies that grant the roles",
+			},
+			"policies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The policies that apply to the resource",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the policy",
+						},
+						"source": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether the policy is assigned to the subject directly, or to one of its access groups",
+						},
+						"access_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the access group that the policy is assigned to",
+						},
+						"roles": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Role names of the policy definition",
+						},
+						"rule_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The result of the evaluation of the rule conditions of the policy, either none, active, inactive or not_evaluated",
+						},
+						"pattern": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Pattern rule follows for time-based condition",
+						},
+						"template_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the policy template that the policy was assigned from",
+						},
+						"template_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The version of the policy template that the policy was assigned from",
+						},
+						"assignment_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the policy assignment that created the policy",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIAMEffectiveAccessRead(d *schema.ResourceData, meta interface{}) error {
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return err
+	}
+	accountID := userDetails.UserAccount
+
+	evaluationTime := time.Now()
+	if v, ok := d.GetOk("evaluation_time"); ok {
+		evaluationTime, err = time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error parsing evaluation_time %s: %s", v.(string), err)
+		}
+	}
+
+	iamID, err := getEffectiveAccessSubjectIamID(d, meta, accountID)
+	if err != nil {
+		return err
+	}
+
+	var accessGroupIDs []string
+	if v, ok := d.GetOk("access_group_id"); ok {
+		accessGroupIDs = []string{v.(string)}
+	} else if d.Get("include_access_groups").(bool) {
+		accessGroupIDs, err = listEffectiveAccessGroups(meta, accountID, iamID)
+		if err != nil {
+			return err
+		}
+	}
+
+	target, tags := expandEffectiveAccessTarget(d.Get("target").([]interface{}), accountID)
+
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	type subjectPolicies struct {
+		source        string
+		accessGroupID string
+		options       *iampolicymanagementv1.ListV2PoliciesOptions
+	}
+	subjects := []subjectPolicies{}
+	if iamID != "" {
+		subjects = append(subjects, subjectPolicies{
+			source: "direct",
+			options: &iampolicymanagementv1.ListV2PoliciesOptions{
+				AccountID: core.StringPtr(accountID),
+				IamID:     core.StringPtr(iamID),
+				Type:      core.StringPtr("access"),
+			},
+		})
+	}
+	for _, accessGroupID := range accessGroupIDs {
+		source := "access_group"
+		if iamID == "" {
+			source = "direct"
+		}
+		subjects = append(subjects, subjectPolicies{
+			source:        source,
+			accessGroupID: accessGroupID,
+			options: &iampolicymanagementv1.ListV2PoliciesOptions{
+				AccountID:     core.StringPtr(accountID),
+				AccessGroupID: core.StringPtr(accessGroupID),
+				Type:          core.StringPtr("access"),
+			},
+		})
+	}
+
+	roles := map[string]bool{}
+	conditionalRoles := map[string]bool{}
+	policyIDs := []string{}
+	policies := []map[string]interface{}{}
+	for _, subject := range subjects {
+		subjectPolicies, err := listEffectiveAccessPolicies(iamPolicyManagementClient, subject.options)
+		if err != nil {
+			return err
+		}
+
+		for _, policy := range subjectPolicies {
+			if policy.State != nil && *policy.State != "active" {
+				continue
+			}
+			if policy.Resource == nil || !effectiveAccessPolicyMatches(*policy.Resource, target, tags) {
+				continue
+			}
+			policyRoles, err := flex.GetRoleNamesFromPolicyResponse(policy, d, meta)
+			if err != nil {
+				return err
+			}
+
+			ruleState := effectiveAccessRuleNone
+			if policy.Rule != nil {
+				ruleState = evaluateEffectiveAccessRule(policy.Rule, evaluationTime)
+			}
+			log.Printf("[DEBUG] Policy %s applies to the target with rule state %s", *policy.ID, ruleState)
+			switch ruleState {
+			case effectiveAccessRuleNone, effectiveAccessRuleActive:
+				policyIDs = append(policyIDs, *policy.ID)
+				for _, r := range policyRoles {
+					roles[r] = true
+				}
+			case effectiveAccessRuleNotEvaluated:
+				for _, r := range policyRoles {
+					conditionalRoles[r] = true
+				}
+			}
+
+			p := map[string]interface{}{
+				"id":              *policy.ID,
+				"source":          subject.source,
+				"access_group_id": subject.accessGroupID,
+				"roles":           policyRoles,
+				"rule_state":      ruleState,
+			}
+			if policy.Pattern != nil {
+				p["pattern"] = *policy.Pattern
+			}
+			if policy.Template != nil {
+				p["template_id"] = flex.StringValue(policy.Template.ID)
+				p["template_version"] = flex.StringValue(policy.Template.Version)
+				p["assignment_id"] = flex.StringValue(policy.Template.AssignmentID)
+			}
+			policies = append(policies, p)
+		}
+	}
+
+	// Roles that the subject has anyway are not conditional
+	for r := range roles {
+		delete(conditionalRoles, r)
+	}
+
+	if iamID != "" {
+		d.SetId(iamID)
+	} else {
+		d.SetId(accessGroupIDs[0])
+	}
+	d.Set("subject_iam_id", iamID)
+	d.Set("access_group_ids", accessGroupIDs)
+	d.Set("roles", effectiveAccessSortedKeys(roles))
+	d.Set("conditional_roles", effectiveAccessSortedKeys(conditionalRoles))
+	d.Set("policy_ids", policyIDs)
+	d.Set("policies", policies)
+
+	return nil
+}
+
+// getEffectiveAccessSubjectIamID returns the IAM ID of the subject, or an empty string when the subject
+// is an access group
+func getEffectiveAccessSubjectIamID(d *schema.ResourceData, meta interface{}, accountID string) (string, error) {
+	if v, ok := d.GetOk("iam_id"); ok {
+		return v.(string), nil
+	}
+	if v, ok := d.GetOk("ibm_id"); ok {
+		return flex.GetIBMUniqueId(accountID, v.(string), meta)
+	}
+	if v, ok := d.GetOk("iam_service_id"); ok {
+		iamClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+		if err != nil {
+			return "", err
+		}
+		serviceIDUUID := v.(string)
+		serviceID, resp, err := iamClient.GetServiceID(&iamidentityv1.GetServiceIDOptions{
+			ID: &serviceIDUUID,
+		})
+		if err != nil || resp == nil {
+			return "", fmt.Errorf("[ERROR] Error Getting Service Id %s %s", err, resp)
+		}
+		return *serviceID.IamID, nil
+	}
+	if v, ok := d.GetOk("profile_id"); ok {
+		iamClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+		if err != nil {
+			return "", err
+		}
+		profileUUID := v.(string)
+		profile, resp, err := iamClient.GetProfile(&iamidentityv1.GetProfileOptions{
+			ProfileID: &profileUUID,
+		})
+		if err != nil {
+			return "", fmt.Errorf("[ERROR] Error getting profile ID %s %s", err, resp)
+		}
+		return *profile.IamID, nil
+	}
+	return "", nil
+}
+
+// listEffectiveAccessGroups lists the access groups that the IAM ID is a static or dynamic member of
+func listEffectiveAccessGroups(meta interface{}, accountID string, iamID string) ([]string, error) {
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return nil, err
+	}
+
+	accessGroupIDs := []string{}
+	offset := int64(0)
+	limit := int64(100)
+	for {
+		listAccessGroupOptions := &iamaccessgroupsv2.ListAccessGroupsOptions{
+			AccountID:      core.StringPtr(accountID),
+			IamID:          core.StringPtr(iamID),
+			MembershipType: core.StringPtr("all"),
+			Offset:         &offset,
+			Limit:          &limit,
+		}
+		groups, detailedResponse, err := iamAccessGroupsClient.ListAccessGroups(listAccessGroupOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error retrieving access groups of %s: %s. API Response is: %s", iamID, err, detailedResponse)
+		}
+		for _, group := range groups.Groups {
+			accessGroupIDs = append(accessGroupIDs, *group.ID)
+		}
+		offset += limit
+		if groups.TotalCount == nil || offset >= *groups.TotalCount {
+			return accessGroupIDs, nil
+		}
+	}
+}
+
+// listEffectiveAccessPolicies lists every page of the policies of a subject. The pages are requested
+// with the start token of the previous page, which ListV2PoliciesOptions does not expose.
+func listEffectiveAccessPolicies(client *iampolicymanagementv1.IamPolicyManagementV1, options *iampolicymanagementv1.ListV2PoliciesOptions) ([]iampolicymanagementv1.V2PolicyTemplateMetaData, error) {
+	policies := []iampolicymanagementv1.V2PolicyTemplateMetaData{}
+	start := ""
+	for {
+		builder := core.NewRequestBuilder(core.GET)
+		_, err := builder.ResolveRequestURL(client.Service.Options.URL, `/v2/policies`, nil)
+		if err != nil {
+			return nil, err
+		}
+		builder.AddHeader("Accept", "application/json")
+		builder.AddQuery("account_id", *options.AccountID)
+		if options.IamID != nil {
+			builder.AddQuery("iam_id", *options.IamID)
+		}
+		if options.AccessGroupID != nil {
+			builder.AddQuery("access_group_id", *options.AccessGroupID)
+		}
+		if options.Type != nil {
+			builder.AddQuery("type", *options.Type)
+		}
+		builder.AddQuery("limit", "100")
+		if start != "" {
+			builder.AddQuery("start", start)
+		}
+		request, err := builder.Build()
+		if err != nil {
+			return nil, err
+		}
+
+		var rawResponse map[string]json.RawMessage
+		resp, err := client.Service.Request(request, &rawResponse)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing policies: %s, %s", err, resp)
+		}
+		var page *iampolicymanagementv1.V2PolicyCollection
+		err = core.UnmarshalModel(rawResponse, "", &page, iampolicymanagementv1.UnmarshalV2PolicyCollection)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error reading the policies: %s", err)
+		}
+		policies = append(policies, page.Policies...)
+
+		var next struct {
+			Start string `json:"start"`
+		}
+		if raw, ok := rawResponse["next"]; ok {
+			if err := json.Unmarshal(raw, &next); err != nil {
+				return nil, fmt.Errorf("[ERROR] Error reading the next page of policies: %s", err)
+			}
+		}
+		if next.Start == "" {
+			return policies, nil
+		}
+		start = next.Start
+	}
+}
+
+// expandEffectiveAccessTarget returns the attributes and the access management tags of the target, keyed
+// like the attributes of the policy resources
+func expandEffectiveAccessTarget(targets []interface{}, accountID string) (map[string]string, map[string]string) {
+	attributes := map[string]string{
+		"accountId": accountID,
+	}
+	tags := map[string]string{}
+	if len(targets) == 0 || targets[0] == nil {
+		return attributes, tags
+	}
+	t := targets[0].(map[string]interface{})
+
+	keys := map[string]string{
+		"service":              "serviceName",
+		"resource_instance_id": "serviceInstance",
+		"region":               "region",
+		"resource_type":        "resourceType",
+		"resource":             "resource",
+		"resource_group_id":    "resourceGroupId",
+		"service_type":         "serviceType",
+		"service_group_id":     "service_group_id",
+	}
+	for k, key := range keys {
+		if v, ok := t[k].(string); ok && v != "" {
+			attributes[key] = v
+		}
+	}
+	if _, ok := attributes["serviceType"]; !ok && attributes["serviceName"] != "" {
+		attributes["serviceType"] = "service"
+	}
+	if v, ok := t["attributes"].(map[string]interface{}); ok {
+		for k, value := range v {
+			attributes[k] = value.(string)
+		}
+	}
+	if v, ok := t["resource_tags"].(map[string]interface{}); ok {
+		for k, value := range v {
+			tags[k] = value.(string)
+		}
+	}
+	return attributes, tags
+}
+
+// effectiveAccessPolicyMatches reports whether the resource of the policy includes the target
+func effectiveAccessPolicyMatches(resource iampolicymanagementv1.V2PolicyResource, target map[string]string, tags map[string]string) bool {
+	for _, a := range resource.Attributes {
+		value, exists := target[*a.Key]
+		if !effectiveAccessAttributeMatches(*a.Operator, a.Value, value, exists) {
+			return false
+		}
+	}
+	for _, t := range resource.Tags {
+		value, exists := tags[*t.Key]
+		if !effectiveAccessAttributeMatches(*t.Operator, *t.Value, value, exists) {
+			return false
+		}
+	}
+	return true
+}
+
+func effectiveAccessAttributeMatches(operator string, expected interface{}, value string, exists bool) bool {
+	switch operator {
+	case "stringExists":
+		want, _ := strconv.ParseBool(fmt.Sprint(expected))
+		return exists == want
+	case "stringEquals":
+		return exists && value == fmt.Sprint(expected)
+	case "stringMatch":
+		return exists && effectiveAccessWildcardMatch(fmt.Sprint(expected), value)
+	case "stringEqualsAnyOf", "stringMatchAnyOf":
+		if !exists {
+			return false
+		}
+		values, _ := expected.([]interface{})
+		for _, e := range values {
+			if operator == "stringEqualsAnyOf" && value == fmt.Sprint(e) {
+				return true
+			}
+			if operator == "stringMatchAnyOf" && effectiveAccessWildcardMatch(fmt.Sprint(e), value) {
+				return true
+			}
+		}
+		return false
+	}
+	log.Printf("[WARN] Unsupported operator %s in policy resource, the policy is ignored", operator)
+	return false
+}
+
+// effectiveAccessWildcardMatch matches a value with a pattern, where * matches any sequence of characters
+// and ? matches a single character
+func effectiveAccessWildcardMatch(pattern string, value string) bool {
+	expr := regexp.QuoteMeta(pattern)
+	expr = strings.ReplaceAll(expr, `\*`, ".*")
+	expr = strings.ReplaceAll(expr, `\?`, ".")
+	matched, err := regexp.MatchString("^"+expr+"$", value)
+	return err == nil && matched
+}
+
+// evaluateEffectiveAccessRule evaluates the rule conditions of a policy at the given time. Only the
+// time-based conditions can be evaluated, the result is not_evaluated when the other conditions decide
+func evaluateEffectiveAccessRule(ruleIntf iampolicymanagementv1.V2PolicyRuleIntf, t time.Time) string {
+	rule, ok := ruleIntf.(*iampolicymanagementv1.V2PolicyRule)
+	if !ok {
+		return effectiveAccessRuleNotEvaluated
+	}
+
+	var result *bool
+	if len(rule.Conditions) == 0 {
+		result = evaluateEffectiveAccessCondition(flex.StringValue(rule.Operator), rule.Value, t)
+	} else {
+		results := make([]*bool, 0, len(rule.Conditions))
+		for _, cIntf := range rule.Conditions {
+			c, ok := cIntf.(*iampolicymanagementv1.NestedCondition)
+			if !ok {
+				results = append(results, nil)
+				continue
+			}
+			if len(c.Conditions) == 0 {
+				results = append(results, evaluateEffectiveAccessCondition(flex.StringValue(c.Operator), c.Value, t))
+				continue
+			}
+			nested := make([]*bool, 0, len(c.Conditions))
+			for _, nc := range c.Conditions {
+				nested = append(nested, evaluateEffectiveAccessCondition(flex.StringValue(nc.Operator), nc.Value, t))
+			}
+			results = append(results, combineEffectiveAccessConditions(flex.StringValue(c.Operator), nested))
+		}
+		result = combineEffectiveAccessConditions(flex.StringValue(rule.Operator), results)
+	}
+
+	if result == nil {
+		return effectiveAccessRuleNotEvaluated
+	}
+	if *result {
+		return effectiveAccessRuleActive
+	}
+	return effectiveAccessRuleInactive
+}
+
+// combineEffectiveAccessConditions combines the results of conditions, where nil is a condition that
+// cannot be evaluated
+func combineEffectiveAccessConditions(operator string, results []*bool) *bool {
+	isOr := strings.EqualFold(operator, "or")
+	unknown := false
+	for _, r := range results {
+		if r == nil {
+			unknown = true
+			continue
+		}
+		if isOr && *r {
+			return r
+		}
+		if !isOr && !*r {
+			return r
+		}
+	}
+	if unknown {
+		return nil
+	}
+	result := !isOr
+	return &result
+}
+
+// evaluateEffectiveAccessCondition evaluates a time-based condition, and returns nil for the other conditions
+func evaluateEffectiveAccessCondition(operator string, expected interface{}, t time.Time) *bool {
+	var result bool
+	switch {
+	case strings.HasPrefix(operator, "dayOfWeek"):
+		values, ok := expected.([]interface{})
+		if !ok {
+			values = []interface{}{expected}
+		}
+		for _, v := range values {
+			day, loc, err := parseEffectiveAccessDayOfWeek(fmt.Sprint(v))
+			if err != nil {
+				return nil
+			}
+			weekday := int(t.In(loc).Weekday())
+			if weekday == 0 {
+				weekday = 7
+			}
+			if weekday == day {
+				result = true
+			}
+		}
+	case strings.HasPrefix(operator, "dateTime"):
+		ref, err := time.Parse(time.RFC3339, fmt.Sprint(expected))
+		if err != nil {
+			return nil
+		}
+		result = compareEffectiveAccessCondition(strings.TrimPrefix(operator, "dateTime"), t.Compare(ref))
+	case strings.HasPrefix(operator, "date"):
+		ref, err := time.Parse("2006-01-02Z07:00", fmt.Sprint(expected))
+		if err != nil {
+			if ref, err = time.Parse("2006-01-02", fmt.Sprint(expected)); err != nil {
+				return nil
+			}
+		}
+		current := t.In(ref.Location()).Format("2006-01-02")
+		result = compareEffectiveAccessCondition(strings.TrimPrefix(operator, "date"), strings.Compare(current, ref.Format("2006-01-02")))
+	case strings.HasPrefix(operator, "time"):
+		ref, err := time.Parse("15:04:05Z07:00", fmt.Sprint(expected))
+		if err != nil {
+			return nil
+		}
+		current := t.In(ref.Location()).Format("15:04:05")
+		result = compareEffectiveAccessCondition(strings.TrimPrefix(operator, "time"), strings.Compare(current, ref.Format("15:04:05")))
+	default:
+		return nil
+	}
+	return &result
+}
+
+func compareEffectiveAccessCondition(comparison string, c int) bool {
+	switch comparison {
+	case "LessThan":
+		return c < 0
+	case "LessThanOrEquals":
+		return c <= 0
+	case "GreaterThan":
+		return c > 0
+	case "GreaterThanOrEquals":
+		return c >= 0
+	}
+	return false
+}
+
+// parseEffectiveAccessDayOfWeek parses a day of the week, from 1 for Monday to 7 for Sunday, with an
+// optional UTC offset such as 1+00:00
+func parseEffectiveAccessDayOfWeek(v string) (int, *time.Location, error) {
+	loc := time.UTC
+	if len(v) > 1 {
+		offset, err := time.Parse("Z07:00", v[1:])
+		if err != nil {
+			return 0, nil, err
+		}
+		loc = offset.Location()
+	}
+	day, err := strconv.Atoi(v[:1])
+	if err != nil || day < 1 || day > 7 {
+		return 0, nil, fmt.Errorf("invalid day of week %s", v)
+	}
+	return day, loc, nil
+}
+
+func effectiveAccessSortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
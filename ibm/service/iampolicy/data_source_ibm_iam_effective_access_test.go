@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIAMEffectiveAccessDataSource_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMEffectiveAccessDataSourceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_iam_effective_access.testacc_ds_effective_access", "subject_iam_id"),
+					resource.TestCheckResourceAttr("data.ibm_iam_effective_access.testacc_ds_effective_access", "roles.#", "2"),
+					resource.TestCheckTypeSetElemAttr("data.ibm_iam_effective_access.testacc_ds_effective_access", "roles.*", "Viewer"),
+					resource.TestCheckTypeSetElemAttr("data.ibm_iam_effective_access.testacc_ds_effective_access", "roles.*", "Reader"),
+					resource.TestCheckResourceAttr("data.ibm_iam_effective_access.testacc_ds_effective_access", "policy_ids.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_iam_effective_access.testacc_ds_effective_access", "conditional_roles.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMEffectiveAccessDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "ibm_iam_service_id" "serviceID" {
+	name = "%s"
+}
+
+resource "ibm_iam_service_policy" "policy" {
+	iam_service_id = ibm_iam_service_id.serviceID.id
+	roles          = ["Viewer"]
+	resources {
+		service = "kms"
+	}
+}
+
+resource "ibm_iam_access_group" "accgrp" {
+	name = "%s"
+}
+
+resource "ibm_iam_access_group_members" "accgroupmem" {
+	access_group_id = ibm_iam_access_group.accgrp.id
+	iam_service_ids = [ibm_iam_service_id.serviceID.id]
+}
+
+resource "ibm_iam_access_group_policy" "policy" {
+	access_group_id = ibm_iam_access_group.accgrp.id
+	roles           = ["Reader"]
+	resources {
+		service = "kms"
+	}
+}
+
+data "ibm_iam_effective_access" "testacc_ds_effective_access" {
+	iam_service_id = ibm_iam_service_id.serviceID.id
+	target {
+		service = "kms"
+	}
+	depends_on = [
+		ibm_iam_service_policy.policy,
+		ibm_iam_access_group_members.accgroupmem,
+		ibm_iam_access_group_policy.policy,
+	]
+}
+`, name, name)
+}
@@ -0,0 +1,557 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceIBMIAMPolicyBundle manages a set of access policies, each one granting roles on a target to an
+// access group or an IAM identity
+func ResourceIBMIAMPolicyBundle() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMPolicyBundleCreate,
+		ReadContext:   resourceIBMIAMPolicyBundleRead,
+		UpdateContext: resourceIBMIAMPolicyBundleUpdate,
+		DeleteContext: resourceIBMIAMPolicyBundleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The access policies of the bundle",
+				Elem: &schema.Resource{
+					Schema: policyBundleItemSchema(),
+				},
+			},
+
+			"policy_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the access policies of the bundle",
+			},
+		},
+	}
+}
+
+func policyBundleItemSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"access_group_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "ID of the access group that the policy is assigned to",
+		},
+
+		"iam_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "IAM ID of the user, service ID or trusted profile that the policy is assigned to",
+		},
+
+		"roles": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Role names of the policy definition",
+		},
+
+		"resources": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"service": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Service name of the policy definition",
+					},
+
+					"resource_instance_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "ID of resource instance of the policy definition",
+					},
+
+					"region": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Region of the policy definition",
+					},
+
+					"resource_type": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Resource type of the policy definition",
+					},
+
+					"resource": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Resource of the policy definition",
+					},
+
+					"resource_group_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "ID of the resource group.",
+					},
+
+					"service_type": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Service type of the policy definition",
+					},
+
+					"service_group_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Service group id of the policy definition",
+					},
+
+					"attributes": {
+						Type:        schema.TypeMap,
+						Optional:    true,
+						Description: "Set resource attributes in the form of 'name=value,name=value....",
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+
+		"resource_attributes": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Set resource attributes.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Name of attribute.",
+					},
+					"value": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Value of attribute.",
+					},
+					"operator": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "stringEquals",
+						Description: "Operator of attribute.",
+					},
+				},
+			},
+		},
+
+		"account_management": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Give access to all account management services",
+		},
+
+		"resource_tags": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Set access management tags.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Name of attribute.",
+					},
+					"value": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Value of attribute.",
+					},
+					"operator": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "stringEquals",
+						Description: "Operator of attribute.",
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Description of the Policy",
+		},
+
+		"rule_conditions": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Rule conditions enforced by the policy",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Key of the condition",
+					},
+					"operator": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Operator of the condition",
+					},
+					"value": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "Value of the condition",
+					},
+					"conditions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Additional Rule conditions enforced by the policy",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"key": {
+									Type:        schema.TypeString,
+									Required:    true,
+									Description: "Key of the condition",
+								},
+								"operator": {
+									Type:        schema.TypeString,
+									Required:    true,
+									Description: "Operator of the condition",
+								},
+								"value": {
+									Type:        schema.TypeList,
+									Required:    true,
+									Elem:        &schema.Schema{Type: schema.TypeString},
+									Description: "Value of the condition",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"rule_operator": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"and", "or"}, false),
+			Description:  "Operator that multiple rule conditions are evaluated over",
+		},
+
+		"pattern": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Pattern rule follows for time-based condition",
+		},
+
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the access policy",
+		},
+	}
+}
+
+func resourceIBMIAMPolicyBundleCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(resource.UniqueId())
+
+	policies := []interface{}{}
+	for _, p := range d.Get("policy").(*schema.Set).List() {
+		policy := p.(map[string]interface{})
+		policyID, err := createPolicyBundleItem(policy, meta)
+		if err != nil {
+			if len(policies) > 0 {
+				// Keep track of the policies that were already created, so that they are not leaked
+				d.Set("policy", policies)
+			} else {
+				d.SetId("")
+			}
+			return diag.FromErr(err)
+		}
+		policy["id"] = policyID
+		policies = append(policies, policy)
+	}
+	d.Set("policy", policies)
+
+	return resourceIBMIAMPolicyBundleRead(context, d, meta)
+}
+
+func resourceIBMIAMPolicyBundleRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policies := []interface{}{}
+	policyIDs := []string{}
+	for _, p := range d.Get("policy").(*schema.Set).List() {
+		item := p.(map[string]interface{})
+		policyID := item["id"].(string)
+		if policyID == "" {
+			continue
+		}
+
+		getPolicyOptions := iamPolicyManagementClient.NewGetV2PolicyOptions(policyID)
+		policy, res, err := iamPolicyManagementClient.GetV2Policy(getPolicyOptions)
+		if err != nil || policy == nil {
+			if res != nil && res.StatusCode == 404 {
+				log.Printf("[WARN] Policy %s of policy bundle %s was deleted outside of Terraform", policyID, d.Id())
+				continue
+			}
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving policy %s: %s\n%s", policyID, err, res))
+		}
+		if policy.State != nil && *policy.State == "deleted" {
+			log.Printf("[WARN] Policy %s of policy bundle %s was deleted outside of Terraform", policyID, d.Id())
+			continue
+		}
+
+		item, err = flattenPolicyBundleItem(item, *policy, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		policies = append(policies, item)
+		policyIDs = append(policyIDs, policyID)
+	}
+
+	if len(policies) == 0 {
+		d.SetId("")
+		return nil
+	}
+	d.Set("policy", policies)
+	d.Set("policy_ids", policyIDs)
+
+	return nil
+}
+
+func resourceIBMIAMPolicyBundleUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("policy") {
+		o, n := d.GetChange("policy")
+		oldPolicies := o.(*schema.Set)
+		newPolicies := n.(*schema.Set)
+		removed := oldPolicies.Difference(newPolicies).List()
+
+		// The policies are matched on their definition, the unchanged ones keep their ID. The new policies are
+		// created before the removed ones are deleted, so that the access is not interrupted
+		policyIDs := map[int]interface{}{}
+		for _, p := range oldPolicies.List() {
+			policyIDs[oldPolicies.F(p)] = p.(map[string]interface{})["id"]
+		}
+		policies := []interface{}{}
+		for _, p := range newPolicies.List() {
+			policy := p.(map[string]interface{})
+			if policyID, ok := policyIDs[newPolicies.F(p)]; ok {
+				policy["id"] = policyID
+				policies = append(policies, policy)
+				continue
+			}
+			policyID, err := createPolicyBundleItem(policy, meta)
+			if err != nil {
+				d.Set("policy", append(policies, removed...))
+				return diag.FromErr(err)
+			}
+			policy["id"] = policyID
+			policies = append(policies, policy)
+		}
+
+		for i, p := range removed {
+			if err := deletePolicyBundleItem(p.(map[string]interface{})["id"].(string), meta); err != nil {
+				d.Set("policy", append(policies, removed[i:]...))
+				return diag.FromErr(err)
+			}
+		}
+		d.Set("policy", policies)
+	}
+
+	return resourceIBMIAMPolicyBundleRead(context, d, meta)
+}
+
+func resourceIBMIAMPolicyBundleDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	for _, p := range d.Get("policy").(*schema.Set).List() {
+		if err := deletePolicyBundleItem(p.(map[string]interface{})["id"].(string), meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId("")
+
+	return nil
+}
+
+// policyBundleItemData returns the ResourceData of a policy of the bundle, so that the policy is built
+// and read back with the same helpers as the standalone policy resources
+func policyBundleItemData(policy map[string]interface{}) (*schema.ResourceData, error) {
+	itemData := (&schema.Resource{Schema: policyBundleItemSchema()}).Data(nil)
+	for k, v := range policy {
+		if k == "id" {
+			continue
+		}
+		if err := itemData.Set(k, v); err != nil {
+			return nil, fmt.Errorf("[ERROR] Error while parsing policy values: %s", err)
+		}
+	}
+	return itemData, nil
+}
+
+func createPolicyBundleItem(policy map[string]interface{}, meta interface{}) (string, error) {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return "", err
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return "", err
+	}
+
+	itemData, err := policyBundleItemData(policy)
+	if err != nil {
+		return "", err
+	}
+
+	subjectAttribute := iampolicymanagementv1.V2PolicySubjectAttribute{
+		Operator: core.StringPtr("stringEquals"),
+	}
+	accessGroupID := itemData.Get("access_group_id").(string)
+	iamID := itemData.Get("iam_id").(string)
+	switch {
+	case accessGroupID != "" && iamID != "":
+		return "", fmt.Errorf("[ERROR] Only one of access_group_id or iam_id can be set in a policy of the bundle")
+	case accessGroupID != "":
+		subjectAttribute.Key = core.StringPtr("access_group_id")
+		subjectAttribute.Value = &accessGroupID
+	case iamID != "":
+		subjectAttribute.Key = core.StringPtr("iam_id")
+		subjectAttribute.Value = &iamID
+	default:
+		return "", fmt.Errorf("[ERROR] One of access_group_id or iam_id must be set in a policy of the bundle")
+	}
+	if _, ok := itemData.GetOk("resources"); ok {
+		if _, ok := itemData.GetOk("resource_attributes"); ok {
+			return "", fmt.Errorf("[ERROR] Only one of resources or resource_attributes can be set in a policy of the bundle")
+		}
+	}
+
+	policyOptions, err := flex.GenerateV2PolicyOptions(itemData, meta)
+	if err != nil {
+		return "", err
+	}
+
+	accountIDResourceAttribute := iampolicymanagementv1.V2PolicyResourceAttribute{
+		Key:      core.StringPtr("accountId"),
+		Value:    core.StringPtr(userDetails.UserAccount),
+		Operator: core.StringPtr("stringEquals"),
+	}
+	policyResource := &iampolicymanagementv1.V2PolicyResource{
+		Attributes: append(policyOptions.Resource.Attributes, accountIDResourceAttribute),
+		Tags:       flex.SetV2PolicyTags(itemData),
+	}
+
+	createPolicyOptions := iamPolicyManagementClient.NewCreateV2PolicyOptions(policyOptions.Control, "access")
+	createPolicyOptions.SetSubject(&iampolicymanagementv1.V2PolicySubject{
+		Attributes: []iampolicymanagementv1.V2PolicySubjectAttribute{subjectAttribute},
+	})
+	createPolicyOptions.SetResource(policyResource)
+	if pattern, ok := itemData.GetOk("pattern"); ok {
+		createPolicyOptions.SetPattern(pattern.(string))
+	}
+	if ruleConditions, ok := itemData.GetOk("rule_conditions"); ok {
+		createPolicyOptions.SetRule(flex.GeneratePolicyRule(itemData, ruleConditions))
+	}
+	if description, ok := itemData.GetOk("description"); ok {
+		createPolicyOptions.SetDescription(description.(string))
+	}
+
+	createdPolicy, res, err := iamPolicyManagementClient.CreateV2Policy(createPolicyOptions)
+	if err != nil || createdPolicy == nil {
+		return "", fmt.Errorf("[ERROR] Error creating policy for %s: %s\n%s", *subjectAttribute.Value.(*string), err, res)
+	}
+	log.Printf("[INFO] Created policy %s for %s", *createdPolicy.ID, *subjectAttribute.Value.(*string))
+
+	return *createdPolicy.ID, nil
+}
+
+// flattenPolicyBundleItem reads back the policy into the bundle item, the optional blocks are only set
+// when they are configured
+func flattenPolicyBundleItem(item map[string]interface{}, policy iampolicymanagementv1.V2PolicyTemplateMetaData, meta interface{}) (map[string]interface{}, error) {
+	itemData, err := policyBundleItemData(item)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := flex.GetRoleNamesFromPolicyResponse(policy, itemData, meta)
+	if err != nil {
+		return nil, err
+	}
+	item["roles"] = roles
+
+	if policy.Subject != nil {
+		if v, ok := flex.GetV2PolicySubjectAttribute("access_group_id", *policy.Subject).(string); ok {
+			item["access_group_id"] = v
+		}
+		if v, ok := flex.GetV2PolicySubjectAttribute("iam_id", *policy.Subject).(string); ok {
+			item["iam_id"] = v
+		}
+	}
+	if policy.Resource != nil {
+		if _, ok := itemData.GetOk("resources"); ok {
+			item["resources"] = flex.FlattenV2PolicyResource(*policy.Resource)
+		}
+		if _, ok := itemData.GetOk("resource_attributes"); ok {
+			item["resource_attributes"] = flex.FlattenV2PolicyResourceAttributes(policy.Resource.Attributes)
+		}
+		if _, ok := itemData.GetOk("resource_tags"); ok {
+			item["resource_tags"] = flex.FlattenV2PolicyResourceTags(*policy.Resource)
+		}
+	}
+	if rule, ok := policy.Rule.(*iampolicymanagementv1.V2PolicyRule); ok && rule != nil {
+		if _, ok := itemData.GetOk("rule_conditions"); ok {
+			item["rule_conditions"] = flex.FlattenRuleConditions(*rule)
+		}
+		if _, ok := itemData.GetOk("rule_operator"); ok && rule.Operator != nil {
+			item["rule_operator"] = *rule.Operator
+		}
+	}
+	if _, ok := itemData.GetOk("pattern"); ok && policy.Pattern != nil {
+		item["pattern"] = *policy.Pattern
+	}
+	if policy.Description != nil {
+		item["description"] = *policy.Description
+	}
+
+	return item, nil
+}
+
+func deletePolicyBundleItem(policyID string, meta interface{}) error {
+	if policyID == "" {
+		return nil
+	}
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	deletePolicyOptions := iamPolicyManagementClient.NewDeleteV2PolicyOptions(policyID)
+	res, err := iamPolicyManagementClient.DeleteV2Policy(deletePolicyOptions)
+	if err != nil && (res == nil || res.StatusCode != 404) {
+		return fmt.Errorf("[ERROR] Error deleting policy %s: %s\n%s", policyID, err, res)
+	}
+
+	return nil
+}
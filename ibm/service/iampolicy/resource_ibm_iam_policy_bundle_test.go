@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMPolicyBundle_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMPolicyBundleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMPolicyBundleBasic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_policy_bundle.bundle", "policy.#", "2"),
+					resource.TestCheckResourceAttr("ibm_iam_policy_bundle.bundle", "policy_ids.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMPolicyBundleUpdate(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_policy_bundle.bundle", "policy.#", "3"),
+					resource.TestCheckResourceAttr("ibm_iam_policy_bundle.bundle", "policy_ids.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMPolicyBundleDestroy(s *terraform.State) error {
+	iamPolicyManagementClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_policy_bundle" {
+			continue
+		}
+		count, _ := strconv.Atoi(rs.Primary.Attributes["policy_ids.#"])
+		for i := 0; i < count; i++ {
+			policyID := rs.Primary.Attributes[fmt.Sprintf("policy_ids.%d", i)]
+			getPolicyOptions := iamPolicyManagementClient.NewGetV2PolicyOptions(policyID)
+
+			destroyedPolicy, response, err := iamPolicyManagementClient.GetV2Policy(getPolicyOptions)
+			if err == nil && *destroyedPolicy.State != "deleted" {
+				return fmt.Errorf("Policy %s of policy bundle still exists: %s\n", policyID, rs.Primary.ID)
+			} else if err != nil && response.StatusCode != 404 {
+				return fmt.Errorf("[ERROR] Error waiting for policy (%s) to be destroyed: %s", policyID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMIAMPolicyBundleBasic(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_access_group" "accgrp" {
+			name = "%[1]s"
+		}
+
+		resource "ibm_iam_service_id" "serviceID" {
+			name = "%[1]s"
+		}
+
+		resource "ibm_iam_policy_bundle" "bundle" {
+			policy {
+				access_group_id = ibm_iam_access_group.accgrp.id
+				roles           = ["Viewer"]
+				resources {
+					service = "kms"
+				}
+			}
+
+			policy {
+				iam_id = ibm_iam_service_id.serviceID.iam_id
+				roles  = ["Reader"]
+				resource_attributes {
+					name  = "serviceName"
+					value = "cloud-object-storage"
+				}
+			}
+		}
+	`, name)
+}
+
+func testAccCheckIBMIAMPolicyBundleUpdate(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_access_group" "accgrp" {
+			name = "%[1]s"
+		}
+
+		resource "ibm_iam_service_id" "serviceID" {
+			name = "%[1]s"
+		}
+
+		locals {
+			bundle = {
+				policies = [
+					{ roles = ["Viewer"], service = "kms" },
+					{ roles = ["Viewer", "Manager"], service = "cloud-object-storage" },
+				]
+			}
+		}
+
+		resource "ibm_iam_policy_bundle" "bundle" {
+			dynamic "policy" {
+				for_each = local.bundle.policies
+				content {
+					access_group_id = ibm_iam_access_group.accgrp.id
+					roles           = policy.value.roles
+					resources {
+						service = policy.value.service
+					}
+				}
+			}
+
+			policy {
+				iam_id = ibm_iam_service_id.serviceID.iam_id
+				roles  = ["Reader"]
+				resource_attributes {
+					name  = "serviceName"
+					value = "cloud-object-storage"
+				}
+			}
+		}
+	`, name)
+}
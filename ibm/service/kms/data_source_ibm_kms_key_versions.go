@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMKMSKeyVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMKMSKeyVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Key protect or hpcs instance GUID",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				Default:      "public",
+			},
+			"key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Key ID of the Key",
+				ExactlyOneOf: []string{"key_id", "alias"},
+			},
+			"alias": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Alias of the Key",
+				ExactlyOneOf: []string{"key_id", "alias"},
+			},
+			"all_key_states": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to list the versions of the key regardless of its state, including when it is deleted",
+			},
+			"current_key_version_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the current version of the key",
+			},
+			"last_rotate_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the key was last rotated. The date format follows RFC 3339.",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of versions of the key",
+			},
+			"versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The versions of the key",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the key version",
+						},
+						"creation_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the key version was created. The date format follows RFC 3339.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMKMSKeyVersionsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
+	api, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var id string
+	if v, ok := d.GetOk("key_id"); ok {
+		id = v.(string)
+	}
+	if v, ok := d.GetOk("alias"); ok {
+		id = v.(string)
+	}
+	allKeyStates := d.Get("all_key_states").(bool)
+
+	if !allKeyStates {
+		key, err := api.GetKey(context, id)
+		if err != nil {
+			return diag.Errorf("Failed to get Key: %s", err)
+		}
+		id = key.ID
+		if key.KeyVersion != nil {
+			d.Set("current_key_version_id", key.KeyVersion.ID)
+		}
+		if key.LastRotateDate != nil {
+			d.Set("last_rotate_date", key.LastRotateDate.Format(time.RFC3339))
+		}
+	}
+
+	versions, err := listKmsKeyVersions(context, api, id, allKeyStates)
+	if err != nil {
+		return diag.Errorf("Failed to list key versions: %s", err)
+	}
+	versionList := make([]map[string]interface{}, 0, len(versions))
+	for _, v := range versions {
+		version := map[string]interface{}{
+			"id": v.ID,
+		}
+		if v.CreationDate != nil {
+			version["creation_date"] = v.CreationDate.Format(time.RFC3339)
+		}
+		versionList = append(versionList, version)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, id))
+	d.Set("instance_id", instanceID)
+	d.Set("key_id", id)
+	d.Set("versions", versionList)
+	d.Set("total_count", len(versionList))
+
+	return nil
+}
@@ -0,0 +1,332 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMKmsKeyRotation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsKeyRotationCreate,
+		ReadContext:   resourceIBMKmsKeyRotationRead,
+		DeleteContext: resourceIBMKmsKeyRotationDelete,
+		Importer:      &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect or hpcs instance GUID",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID or alias of the root key to rotate",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				ForceNew:     true,
+			},
+			"payload": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				ForceNew:    true,
+				Description: "The new key material of an imported root key",
+			},
+			"keepers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, triggers a new rotation of the key",
+			},
+			"sync_associated_resources": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether to request the associated resources to be synced with the new key version after the rotation",
+			},
+			"wait_for_registrations": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether to wait until all the registrations of the key report the new key version",
+			},
+			"key_crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the rotated key",
+			},
+			"key_version_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the key version created by the rotation",
+			},
+			"previous_key_version_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the key version before the rotation",
+			},
+			"current_key_version_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the current version of the key, which differs from key_version_id once the key is rotated again",
+			},
+			"rotated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the key was rotated. The date format follows RFC 3339.",
+			},
+			"registrations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The registrations of the key and the key version that they use",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the resource that is associated with the key",
+						},
+						"key_version_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the key version that the resource uses",
+						},
+						"rewrapped": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the resource uses the key version created by the rotation, or a later one",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKeyRotationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	key, err := kpAPI.GetKey(context, d.Get("key_id").(string))
+	if err != nil {
+		return diag.Errorf("Get Key failed with error while rotating key: %s", err)
+	}
+	if key.KeyVersion != nil {
+		d.Set("previous_key_version_id", key.KeyVersion.ID)
+	}
+
+	if err = kpAPI.Rotate(context, key.ID, d.Get("payload").(string)); err != nil {
+		return diag.Errorf("Failed to rotate key %s: %s", key.ID, err)
+	}
+
+	key, err = kpAPI.GetKey(context, key.ID)
+	if err != nil {
+		return diag.Errorf("Get Key failed with error after rotating key: %s", err)
+	}
+	if key.KeyVersion == nil {
+		return diag.Errorf("Key %s does not report a key version after its rotation", key.ID)
+	}
+	versionID := key.KeyVersion.ID
+	d.SetId(fmt.Sprintf("%s:rotation:%s", key.CRN, versionID))
+	if key.LastRotateDate != nil {
+		d.Set("rotated_at", key.LastRotateDate.Format(time.RFC3339))
+	}
+
+	// The key is rotated at this point, so a failure of the follow-up operations is only a warning: an error
+	// would taint the resource and rotate the key once more on the next apply. The registrations attribute
+	// records which resources still use a previous key version.
+	var diags diag.Diagnostics
+	if d.Get("sync_associated_resources").(bool) {
+		if err = kpAPI.SyncAssociatedResources(context, key.ID); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Failed to sync the resources associated with key %s", key.ID),
+				Detail:   fmt.Sprintf("Key %s was rotated to key version %s, but the sync of its associated resources failed: %s", key.ID, versionID, err),
+			})
+		}
+	}
+	if d.Get("wait_for_registrations").(bool) {
+		if err = waitForKmsKeyRegistrationsRewrap(context, kpAPI, key.ID, versionID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Registrations of key %s do not use key version %s", key.ID, versionID),
+				Detail:   fmt.Sprintf("Key %s was rotated, but waiting for its registrations to use key version %s failed: %s", key.ID, versionID, err),
+			})
+		}
+	}
+
+	return append(diags, resourceIBMKmsKeyRotationRead(context, d, meta)...)
+}
+
+func resourceIBMKmsKeyRotationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := strings.Split(d.Id(), ":rotation:")
+	if len(id) < 2 {
+		return diag.Errorf("Incorrect ID %s: Id should be a combination of keyCRN:rotation:keyVersionID", d.Id())
+	}
+	versionID := id[1]
+	_, instanceID, keyid := getInstanceAndKeyDataFromCRN(id[0])
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	key, err := kpAPI.GetKey(context, keyid)
+	if err != nil {
+		kpError := err.(*kp.Error)
+		if kpError.StatusCode == 404 || kpError.StatusCode == 409 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Get Key failed with error while reading key rotation: %s", err)
+	} else if key.State == 5 { //Refers to Deleted state of the Key
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_id", instanceID)
+	if _, ok := d.GetOk("key_id"); !ok {
+		d.Set("key_id", key.ID)
+	}
+	if strings.Contains((kpAPI.URL).String(), "private") || strings.Contains(kpAPI.Config.BaseURL, "private") {
+		d.Set("endpoint_type", "private")
+	} else {
+		d.Set("endpoint_type", "public")
+	}
+	d.Set("key_crn", key.CRN)
+	d.Set("key_version_id", versionID)
+	if key.KeyVersion != nil {
+		d.Set("current_key_version_id", key.KeyVersion.ID)
+	}
+
+	versions, err := listKmsKeyVersions(context, kpAPI, key.ID, false)
+	if err != nil {
+		return diag.Errorf("Failed to list the versions of key %s: %s", key.ID, err)
+	}
+	registrations, err := kpAPI.ListRegistrations(context, key.ID, "")
+	if err != nil {
+		return diag.Errorf("Failed to list the registrations of key %s: %s", key.ID, err)
+	}
+	d.Set("registrations", flattenKmsKeyRotationRegistrations(registrations.Registrations, versionID, versions))
+
+	return nil
+}
+
+func resourceIBMKmsKeyRotationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// A rotation cannot be reverted, the previous key versions remain available to unwrap data
+	log.Printf("[WARN] Removing the rotation of key %s from the state, the key version is not affected", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// waitForKmsKeyRegistrationsRewrap waits until every registration of the key uses the given key
+// version, or a later one
+func waitForKmsKeyRegistrationsRewrap(context context.Context, kpAPI *kp.Client, keyID, versionID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"rewrapped"},
+		Refresh: func() (interface{}, string, error) {
+			versions, err := listKmsKeyVersions(context, kpAPI, keyID, false)
+			if err != nil {
+				return nil, "", err
+			}
+			registrations, err := kpAPI.ListRegistrations(context, keyID, "")
+			if err != nil {
+				return nil, "", err
+			}
+			for _, r := range flattenKmsKeyRotationRegistrations(registrations.Registrations, versionID, versions) {
+				if !r["rewrapped"].(bool) {
+					log.Printf("[DEBUG] Resource %s still uses key version %s", r["resource_crn"], r["key_version_id"])
+					return registrations, "pending", nil
+				}
+			}
+			return registrations, "rewrapped", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(context)
+	return err
+}
+
+// flattenKmsKeyRotationRegistrations flattens the registrations of a key, flagging the ones that
+// use the given key version or a version created after it
+func flattenKmsKeyRotationRegistrations(registrations []kp.Registration, versionID string, versions []kp.KeyVersion) []map[string]interface{} {
+	var rotatedAt *time.Time
+	created := make(map[string]*time.Time, len(versions))
+	for _, v := range versions {
+		created[v.ID] = v.CreationDate
+		if v.ID == versionID {
+			rotatedAt = v.CreationDate
+		}
+	}
+
+	result := make([]map[string]interface{}, 0, len(registrations))
+	for _, r := range registrations {
+		rewrapped := r.KeyVersion.ID == versionID
+		if !rewrapped && rotatedAt != nil {
+			if c := created[r.KeyVersion.ID]; c != nil && c.After(*rotatedAt) {
+				rewrapped = true
+			}
+		}
+		result = append(result, map[string]interface{}{
+			"resource_crn":   r.ResourceCrn,
+			"key_version_id": r.KeyVersion.ID,
+			"rewrapped":      rewrapped,
+		})
+	}
+	return result
+}
+
+// listKmsKeyVersions lists all the versions of a key
+func listKmsKeyVersions(context context.Context, kpAPI *kp.Client, keyID string, allKeyStates bool) ([]kp.KeyVersion, error) {
+	limit := uint32(200)
+	offset := uint32(0)
+	versions := make([]kp.KeyVersion, 0)
+	for {
+		options := &kp.ListKeyVersionsOptions{
+			Limit:  &limit,
+			Offset: &offset,
+		}
+		if allKeyStates {
+			options.AllKeyStates = &allKeyStates
+		}
+		page, err := kpAPI.ListKeyVersions(context, keyID, options)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, page.KeyVersion...)
+		if uint32(len(page.KeyVersion)) < limit {
+			return versions, nil
+		}
+		offset += limit
+	}
+}
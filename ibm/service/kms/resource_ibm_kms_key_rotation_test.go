@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMKMSKeyRotation_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	var firstVersion string
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKeyRotationConfig(instanceName, keyName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_kms_key_rotation.test", "key_version_id"),
+					resource.TestCheckResourceAttrSet("ibm_kms_key_rotation.test", "previous_key_version_id"),
+					resource.TestCheckResourceAttrPair("ibm_kms_key_rotation.test", "key_version_id", "ibm_kms_key_rotation.test", "current_key_version_id"),
+					testAccCheckIBMKmsKeyRotationVersion("ibm_kms_key_rotation.test", &firstVersion),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsKeyRotationConfig(instanceName, keyName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("ibm_kms_key_rotation.test", "previous_key_version_id", &firstVersion),
+					resource.TestCheckResourceAttr("data.ibm_kms_key_versions.test", "total_count", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsKeyRotationVersion(n string, version *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		*version = rs.Primary.Attributes["key_version_id"]
+		return nil
+	}
+}
+
+func testAccCheckIBMKmsKeyRotationConfig(instanceName, keyName, keeper string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kp_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+
+	resource "ibm_kms_key" "test" {
+		instance_id  = ibm_resource_instance.kp_instance.guid
+		key_name     = "%s"
+		standard_key = false
+	}
+
+	resource "ibm_kms_key_rotation" "test" {
+		instance_id = ibm_kms_key.test.instance_id
+		key_id      = ibm_kms_key.test.key_id
+		keepers = {
+			rotation = "%s"
+		}
+	}
+
+	data "ibm_kms_key_versions" "test" {
+		instance_id = ibm_kms_key.test.instance_id
+		key_id      = ibm_kms_key_rotation.test.key_id
+	}
+`, instanceName, keyName, keeper)
+}
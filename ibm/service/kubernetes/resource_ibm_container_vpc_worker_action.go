@@ -0,0 +1,633 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	workerActionReboot  = "reboot"
+	workerActionReload  = "reload"
+	workerActionReplace = "replace"
+
+	workerActionNotStarted = "not_started"
+	workerActionStarted    = "started"
+
+	workerIDNodeLabel = "ibm-cloud.kubernetes.io/worker-id"
+
+	nodeReady    = "ready"
+	nodeNotReady = "not_ready"
+)
+
+func ResourceIBMContainerVpcWorkerAction() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMContainerVpcWorkerActionCreate,
+		Read:   resourceIBMContainerVpcWorkerActionRead,
+		Delete: resourceIBMContainerVpcWorkerActionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name or ID of the cluster",
+			},
+
+			"worker_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"worker_id", "label_selector"},
+				Description:  "The ID of the worker on which the action is performed",
+			},
+
+			"label_selector": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"worker_id", "label_selector"},
+				Description:  "The Kubernetes label selector of the nodes whose workers the action is performed on, one worker at a time",
+			},
+
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_container_vpc_worker_action", "action"),
+				Description:  "The action performed on the workers, reboot, reload or replace",
+			},
+
+			"drain": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Cordon and drain the node of each worker before the action",
+			},
+
+			"drain_timeout": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "10m",
+				Description: "Timeout for evicting the pods of each node",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					_, err := time.ParseDuration(value)
+					if err != nil {
+						errors = append(errors, fmt.Errorf("[ERROR] Error parsing drain_timeout: %s", err))
+					}
+					return
+				},
+			},
+
+			"force_drain": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Delete the pods that could not be evicted within drain_timeout instead of failing",
+			},
+
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the resource group.",
+			},
+
+			"endpoint_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The type of server URL used to reach the cluster API server",
+			},
+
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that perform the action again when changed",
+			},
+
+			"workers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The workers on which the action was performed",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"worker_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the worker",
+						},
+						"node_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the Kubernetes node of the worker",
+						},
+						"new_worker_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the worker that replaced the worker",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The health state of the worker after the action",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMContainerVpcWorkerActionValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "action",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              fmt.Sprintf("%s, %s, %s", workerActionReboot, workerActionReload, workerActionReplace)})
+
+	containerVpcWorkerActionValidator := validate.ResourceValidator{ResourceName: "ibm_container_vpc_worker_action", Schema: validateSchema}
+	return &containerVpcWorkerActionValidator
+}
+
+func resourceIBMContainerVpcWorkerActionCreate(d *schema.ResourceData, meta interface{}) error {
+	wkClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d)
+	if err != nil {
+		return err
+	}
+
+	clusterNameID := d.Get("cluster_name_id").(string)
+	action := d.Get("action").(string)
+	drain := d.Get("drain").(bool)
+	forceDrain := d.Get("force_drain").(bool)
+	drainTimeout, _ := time.ParseDuration(d.Get("drain_timeout").(string))
+	labelSelector := d.Get("label_selector").(string)
+
+	cls, err := wkClient.Clusters().GetCluster(clusterNameID, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving container vpc cluster: %s", err)
+	}
+
+	// The API server is only needed to drain the nodes or to select them by label
+	var clientset *kubernetes.Clientset
+	if drain || labelSelector != "" {
+		clientset, err = getVpcClusterClientset(d, meta, cls.ID, targetEnv)
+		if err != nil {
+			return err
+		}
+	}
+
+	var workers []v2.Worker
+	if workerID, ok := d.GetOk("worker_id"); ok {
+		worker, err := wkClient.Workers().Get(cls.ID, workerID.(string), targetEnv)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error getting container vpc worker node: %s", err)
+		}
+		workers = append(workers, worker)
+	} else {
+		workers, err = getVpcWorkersByNodeLabel(wkClient.Workers(), clientset, cls.ID, labelSelector, targetEnv)
+		if err != nil {
+			return err
+		}
+		if len(workers) == 0 {
+			return fmt.Errorf("[ERROR] No worker of cluster %s matches the label selector %s", cls.ID, labelSelector)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cls.ID, time.Now().UTC().Format(time.RFC3339)))
+
+	results := make([]map[string]interface{}, 0, len(workers))
+	for _, worker := range workers {
+		nodeName := getVpcWorkerNodeName(worker)
+		result := map[string]interface{}{
+			"worker_id": worker.ID,
+			"node_name": nodeName,
+		}
+
+		if drain {
+			log.Printf("[INFO] Draining node %s of worker %s", nodeName, worker.ID)
+			if err := cordonNode(clientset, nodeName, true); err != nil {
+				return err
+			}
+			if err := drainNode(clientset, nodeName, drainTimeout, forceDrain); err != nil {
+				return err
+			}
+		}
+
+		if action == workerActionReplace {
+			newWorker, err := replaceVpcWorker(d, meta, cls.ID, worker, targetEnv)
+			if err != nil {
+				return err
+			}
+			result["new_worker_id"] = newWorker.ID
+			result["state"] = newWorker.Health.State
+			nodeName = getVpcWorkerNodeName(newWorker)
+		} else {
+			_worker, err := performVpcWorkerAction(d, meta, cls.ID, worker.ID, action, targetEnv)
+			if err != nil {
+				return err
+			}
+			result["state"] = _worker.Health.State
+		}
+
+		if clientset != nil {
+			if _, err := waitForNodeReady(clientset, nodeName, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return fmt.Errorf("[ERROR] Error waiting for node %s to be ready: %s", nodeName, err)
+			}
+			// A rebooted or reloaded worker keeps its cordoned node, a replaced one joins with a new, schedulable node
+			if drain && action != workerActionReplace {
+				if err := cordonNode(clientset, nodeName, false); err != nil {
+					return err
+				}
+			}
+		}
+		results = append(results, result)
+	}
+	d.Set("workers", results)
+
+	return resourceIBMContainerVpcWorkerActionRead(d, meta)
+}
+
+func resourceIBMContainerVpcWorkerActionRead(d *schema.ResourceData, meta interface{}) error {
+	// The action is performed once, there is nothing to refresh
+	return nil
+}
+
+func resourceIBMContainerVpcWorkerActionDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+// getVpcClusterClientset downloads the admin config of the cluster, the same way
+// as the ibm_container_cluster_config data source, and builds a clientset from it.
+func getVpcClusterClientset(d *schema.ResourceData, meta interface{}, clusterID string, targetEnv v2.ClusterTargetHeader) (*kubernetes.Clientset, error) {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	endpointType := d.Get("endpoint_type").(string)
+
+	configDir, err := os.MkdirTemp("", "ibm-container-worker-action")
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error creating the cluster config directory: %s", err)
+	}
+	defer os.RemoveAll(configDir)
+
+	mutexKey := "Cluster_Config_" + clusterID
+	conns.IbmMutexKV.Lock(mutexKey)
+	defer conns.IbmMutexKV.Unlock(mutexKey)
+
+	var clusterKeyDetails v1.ClusterKeyInfo
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var err error
+		clusterKeyDetails, err = csClient.Clusters().GetClusterConfigDetail(clusterID, configDir, true, targetEnv, endpointType)
+		if err != nil {
+			log.Printf("[DEBUG] Failed to fetch cluster config err %s", err)
+			if strings.Contains(err.Error(), "Could not login to openshift account runtime error:") {
+				return resource.RetryableError(err)
+			}
+			if intermittentUserLookupFailure, _ := regexp.MatchString("Error: lookup of user for \"(.+)\" failed", err.Error()); intermittentUserLookupFailure {
+				// Intermittent error resulting from synchronisation delay
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if conns.IsResourceTimeoutError(err) {
+		clusterKeyDetails, err = csClient.Clusters().GetClusterConfigDetail(clusterID, configDir, true, targetEnv, endpointType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error downloading the cluster config [%s]: %s", clusterID, err)
+	}
+
+	config, err := clientcmd.BuildConfigFromFlags("", clusterKeyDetails.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Invalid kubeconfig, failed to set context: %s", err)
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Invalid kubeconfig, failed to create clientset: %s", err)
+	}
+	return clientset, nil
+}
+
+// getVpcWorkerNodeName returns the name of the Kubernetes node of a VPC worker,
+// which is the address of its primary network interface.
+func getVpcWorkerNodeName(worker v2.Worker) string {
+	for _, network := range worker.NetworkInterfaces {
+		if network.Primary {
+			return network.IpAddress
+		}
+	}
+	if len(worker.NetworkInterfaces) > 0 {
+		return worker.NetworkInterfaces[0].IpAddress
+	}
+	return ""
+}
+
+func getVpcWorkersByNodeLabel(client v2.Workers, clientset *kubernetes.Clientset, clusterID, labelSelector string, targetEnv v2.ClusterTargetHeader) ([]v2.Worker, error) {
+	nodes, err := clientset.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing the nodes matching %s: %s", labelSelector, err)
+	}
+	allWorkers, err := client.ListWorkers(clusterID, false, targetEnv)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+	}
+
+	workers := make([]v2.Worker, 0, len(nodes.Items))
+	for _, node := range nodes.Items {
+		for _, worker := range allWorkers {
+			if node.Labels[workerIDNodeLabel] == worker.ID || node.Name == getVpcWorkerNodeName(worker) {
+				workers = append(workers, worker)
+				break
+			}
+		}
+	}
+	return workers, nil
+}
+
+func cordonNode(clientset *kubernetes.Clientset, nodeName string, unschedulable bool) error {
+	patch := []byte(fmt.Sprintf(`{"spec":{"unschedulable":%t}}`, unschedulable))
+	_, err := clientset.CoreV1().Nodes().Patch(context.TODO(), nodeName, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error setting node %s unschedulable to %t: %s", nodeName, unschedulable, err)
+	}
+	return nil
+}
+
+// getDrainablePods lists the pods of a node that a drain evicts, leaving out the
+// pods of daemon sets, the static pods and the pods that already completed.
+func getDrainablePods(clientset *kubernetes.Clientset, nodeName string) ([]corev1.Pod, error) {
+	pods, err := clientset.CoreV1().Pods("").List(context.TODO(), metav1.ListOptions{FieldSelector: "spec.nodeName=" + nodeName})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting Pods from worker node %s - %s", nodeName, err)
+	}
+	drainable := make([]corev1.Pod, 0, len(pods.Items))
+	for _, pod := range pods.Items {
+		if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+			continue
+		}
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		daemonSet := false
+		for _, owner := range pod.OwnerReferences {
+			if owner.Kind == "DaemonSet" {
+				daemonSet = true
+			}
+		}
+		if !daemonSet {
+			drainable = append(drainable, pod)
+		}
+	}
+	return drainable, nil
+}
+
+// drainNode evicts the pods of a node, retrying the evictions refused by a pod
+// disruption budget until the timeout, and waits for the pods to be gone.
+func drainNode(clientset *kubernetes.Clientset, nodeName string, timeout time.Duration, force bool) error {
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		pods, err := getDrainablePods(clientset, nodeName)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if len(pods) == 0 {
+			return nil
+		}
+		for _, pod := range pods {
+			if pod.DeletionTimestamp != nil {
+				continue
+			}
+			err := clientset.PolicyV1().Evictions(pod.Namespace).Evict(context.TODO(), &policyv1.Eviction{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      pod.Name,
+					Namespace: pod.Namespace,
+				},
+			})
+			if err != nil && !apierrors.IsNotFound(err) && !apierrors.IsTooManyRequests(err) {
+				return resource.NonRetryableError(fmt.Errorf("[ERROR] Error evicting pod %s/%s: %s", pod.Namespace, pod.Name, err))
+			}
+		}
+		return resource.RetryableError(fmt.Errorf("[ERROR] %d pods are still running on node %s", len(pods), nodeName))
+	})
+	if err == nil {
+		log.Printf("Node %s has been drained\n", nodeName)
+		return nil
+	}
+	if !force || !conns.IsResourceTimeoutError(err) {
+		return err
+	}
+
+	pods, err := getDrainablePods(clientset, nodeName)
+	if err != nil {
+		return err
+	}
+	for _, pod := range pods {
+		log.Printf("[WARN] Deleting pod %s/%s that could not be evicted from node %s", pod.Namespace, pod.Name, nodeName)
+		err := clientset.CoreV1().Pods(pod.Namespace).Delete(context.TODO(), pod.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("[ERROR] Error deleting pod %s/%s: %s", pod.Namespace, pod.Name, err)
+		}
+	}
+	return nil
+}
+
+func replaceVpcWorker(d *schema.ResourceData, meta interface{}, clusterID string, worker v2.Worker, targetEnv v2.ClusterTargetHeader) (v2.Worker, error) {
+	wkClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return v2.Worker{}, err
+	}
+
+	workers, err := wkClient.Workers().ListWorkers(clusterID, false, targetEnv)
+	if err != nil {
+		return v2.Worker{}, fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+	}
+	workersInfo := make(map[string]bool, len(workers))
+	for _, _worker := range workers {
+		workersInfo[_worker.ID] = true
+	}
+
+	_, err = wkClient.Workers().ReplaceWokerNode(clusterID, worker.ID, targetEnv)
+	// As API returns http response 204 NO CONTENT, error raised will be exempted.
+	if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
+		return v2.Worker{}, fmt.Errorf("[ERROR] Error replacing the worker node from the cluster: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"creating"},
+		Target:  []string{"created"},
+		Refresh: func() (interface{}, string, error) {
+			workers, err := wkClient.Workers().ListWorkers(clusterID, false, targetEnv)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error in retriving the list of worker nodes")
+			}
+			for _, _worker := range workers {
+				if !workersInfo[_worker.ID] && _worker.PoolID == worker.PoolID {
+					log.Println("found new replaced node: ", _worker.ID)
+					return _worker, "created", nil
+				}
+			}
+			return workers, "creating", nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	newWorker, err := stateConf.WaitForState()
+	if err != nil {
+		return v2.Worker{}, fmt.Errorf("[ERROR] Failed to spawn new worker node: %s", err)
+	}
+
+	// The new worker is only listed once it is provisioning, it cannot be normal before it is deployed
+	_worker, err := waitForVpcWorkerNormal(wkClient.Workers(), clusterID, newWorker.(v2.Worker).ID, targetEnv, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return v2.Worker{}, fmt.Errorf("[ERROR] Error waiting for worker %s to be normal: %s", newWorker.(v2.Worker).ID, err)
+	}
+	return _worker.(v2.Worker), nil
+}
+
+// waitForVpcWorkerNormal waits for a worker to have no pending operation and a normal health
+func waitForVpcWorkerNormal(client v2.Workers, clusterID, workerID string, targetEnv v2.ClusterTargetHeader, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry", versionUpdating},
+		Target:  []string{workerNormal},
+		Refresh: func() (interface{}, string, error) {
+			worker, err := client.Get(clusterID, workerID, targetEnv)
+			if err != nil {
+				return nil, "retry", fmt.Errorf("[ERROR] Error retrieving worker of container vpc cluster: %s", err)
+			}
+			if worker.Health.State == workerNormal && worker.LifeCycle.PendingOperation == "" {
+				return worker, workerNormal, nil
+			}
+			return worker, versionUpdating, nil
+		},
+		Timeout:                   timeout,
+		Delay:                     30 * time.Second,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+	return stateConf.WaitForState()
+}
+
+// performVpcWorkerAction reboots or reloads a worker through the worker update API, then waits for the worker
+// to pick up the action and to be normal again
+func performVpcWorkerAction(d *schema.ResourceData, meta interface{}, clusterID, workerID, action string, targetEnv v2.ClusterTargetHeader) (v2.Worker, error) {
+	csClient, err := meta.(conns.ClientSession).ContainerAPI()
+	if err != nil {
+		return v2.Worker{}, err
+	}
+	wkClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return v2.Worker{}, err
+	}
+	v1TargetEnv, err := getClusterTargetHeader(d, meta)
+	if err != nil {
+		return v2.Worker{}, err
+	}
+
+	params := v1.WorkerUpdateParam{
+		Action: action,
+	}
+	if err := csClient.Workers().Update(clusterID, workerID, params, v1TargetEnv); err != nil {
+		return v2.Worker{}, fmt.Errorf("[ERROR] Error performing %s on worker %s: %s", action, workerID, err)
+	}
+
+	// The worker is still normal right after the request, wait for the action to start before waiting for its end
+	if _, err := waitForVpcWorkerActionStarted(wkClient.Workers(), clusterID, workerID, targetEnv, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return v2.Worker{}, fmt.Errorf("[ERROR] Error waiting for %s of worker %s to start: %s", action, workerID, err)
+	}
+	_worker, err := waitForVpcWorkerNormal(wkClient.Workers(), clusterID, workerID, targetEnv, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return v2.Worker{}, fmt.Errorf("[ERROR] Error waiting for worker %s to be normal after %s: %s", workerID, action, err)
+	}
+	return _worker.(v2.Worker), nil
+}
+
+func waitForVpcWorkerActionStarted(client v2.Workers, clusterID, workerID string, targetEnv v2.ClusterTargetHeader, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry", workerActionNotStarted},
+		Target:  []string{workerActionStarted},
+		Refresh: func() (interface{}, string, error) {
+			worker, err := client.Get(clusterID, workerID, targetEnv)
+			if err != nil {
+				return nil, "retry", fmt.Errorf("[ERROR] Error retrieving worker of container vpc cluster: %s", err)
+			}
+			if worker.Health.State != workerNormal || worker.LifeCycle.PendingOperation != "" {
+				return worker, workerActionStarted, nil
+			}
+			return worker, workerActionNotStarted, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForState()
+}
+
+func waitForNodeReady(clientset *kubernetes.Clientset, nodeName string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{nodeNotReady},
+		Target:  []string{nodeReady},
+		Refresh: func() (interface{}, string, error) {
+			node, err := clientset.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					return &corev1.Node{}, nodeNotReady, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting node %s: %s", nodeName, err)
+			}
+			for _, condition := range node.Status.Conditions {
+				if condition.Type == corev1.NodeReady && condition.Status == corev1.ConditionTrue {
+					return node, nodeReady, nil
+				}
+			}
+			return node, nodeNotReady, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForState()
+}
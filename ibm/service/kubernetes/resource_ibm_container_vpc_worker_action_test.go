@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerVpcWorkerActionReboot(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerVpcWorkerActionReboot(acc.ClusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_container_vpc_worker_action.reboot", "workers.#", "1"),
+					resource.TestCheckResourceAttrSet("ibm_container_vpc_worker_action.reboot", "workers.0.node_name"),
+					resource.TestCheckResourceAttr("ibm_container_vpc_worker_action.reboot", "workers.0.new_worker_id", ""),
+					resource.TestCheckResourceAttr("ibm_container_vpc_worker_action.reboot", "workers.0.state", "normal"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMContainerVpcWorkerActionReplace(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerVpcWorkerActionReplace(acc.ClusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_container_vpc_worker_action.replace", "workers.#", "1"),
+					resource.TestCheckResourceAttrSet("ibm_container_vpc_worker_action.replace", "workers.0.node_name"),
+					resource.TestCheckResourceAttrSet("ibm_container_vpc_worker_action.replace", "workers.0.new_worker_id"),
+					resource.TestCheckResourceAttr("ibm_container_vpc_worker_action.replace", "workers.0.state", "normal"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerVpcWorkerActionReboot(clusterName string) string {
+	return fmt.Sprintf(`
+	data "ibm_container_vpc_cluster" "cluster" {
+		name = "%[1]s"
+	}
+
+	resource "ibm_container_vpc_worker_action" "reboot" {
+		cluster_name_id = data.ibm_container_vpc_cluster.cluster.id
+		worker_id       = data.ibm_container_vpc_cluster.cluster.workers[0]
+		action          = "reboot"
+		drain_timeout   = "5m"
+	}`, clusterName)
+}
+
+func testAccCheckIBMContainerVpcWorkerActionReplace(clusterName string) string {
+	return fmt.Sprintf(`
+	data "ibm_container_vpc_cluster" "cluster" {
+		name = "%[1]s"
+	}
+
+	resource "ibm_container_vpc_worker_action" "replace" {
+		cluster_name_id = data.ibm_container_vpc_cluster.cluster.id
+		worker_id       = data.ibm_container_vpc_cluster.cluster.workers[0]
+		action          = "replace"
+		drain_timeout   = "5m"
+	}`, clusterName)
+}
@@ -10,6 +10,13 @@ const (
 	Arg_AffinityVolume                      = "pi_affinity_volume"
 	Arg_AntiAffinityInstances               = "pi_anti_affinity_instances"
 	Arg_AntiAffinityVolumes                 = "pi_anti_affinity_volumes"
+	Arg_BackupType                          = "pi_backup_type"
+	Arg_CaptureCloudStorageAccessKey        = "pi_capture_cloud_storage_access_key"
+	Arg_CaptureCloudStorageRegion           = "pi_capture_cloud_storage_region"
+	Arg_CaptureCloudStorageSecretKey        = "pi_capture_cloud_storage_secret_key"
+	Arg_CaptureDestination                  = "pi_capture_destination"
+	Arg_CaptureStorageImagePath             = "pi_capture_storage_image_path"
+	Arg_CatalogImageIDs                     = "pi_catalog_image_ids"
 	Arg_Cidr                                = "pi_cidr"
 	Arg_CloudConnectionID                   = "pi_cloud_connection_id"
 	Arg_CloudConnectionName                 = "pi_cloud_connection_name"
@@ -19,10 +26,13 @@ const (
 	Arg_DeploymentTarget                    = "pi_deployment_target"
 	Arg_DeploymentType                      = "pi_deployment_type"
 	Arg_Description                         = "pi_description"
+	Arg_Dhcp                                = "pi_dhcp"
 	Arg_DhcpID                              = "pi_dhcp_id"
 	Arg_DhcpName                            = "pi_dhcp_name"
 	Arg_DhcpSnatEnabled                     = "pi_dhcp_snat_enabled"
+	Arg_DNS                                 = "pi_dns"
 	Arg_DnsServer                           = "pi_dns_server"
+	Arg_DryRun                              = "pi_dry_run"
 	Arg_HealthStatus                        = "pi_health_status"
 	Arg_Host                                = "pi_host"
 	Arg_HostGroupID                         = "pi_host_group_id"
@@ -35,23 +45,31 @@ const (
 	Arg_ImageImportDetails                  = "pi_image_import_details"
 	Arg_ImageName                           = "pi_image_name"
 	Arg_InstanceID                          = "pi_instance_id"
+	Arg_InstanceIDs                         = "pi_instance_ids"
 	Arg_InstanceName                        = "pi_instance_name"
+	Arg_Instances                           = "pi_instances"
+	Arg_IntervalHours                       = "pi_interval_hours"
 	Arg_Key                                 = "pi_ssh_key"
 	Arg_KeyName                             = "pi_key_name"
 	Arg_KeyPairName                         = "pi_key_pair_name"
 	Arg_LanguageCode                        = "pi_language_code"
 	Arg_LicenseRepositoryCapacity           = "pi_license_repository_capacity"
 	Arg_Memory                              = "pi_memory"
+	Arg_Mode                                = "pi_mode"
 	Arg_Name                                = "pi_name"
 	Arg_Network                             = "pi_network"
+	Arg_NetworkID                           = "pi_network_id"
 	Arg_NetworkName                         = "pi_network_name"
 	Arg_PinPolicy                           = "pi_pin_policy"
 	Arg_PlacementGroupID                    = "pi_placement_group_id"
 	Arg_PlacementGroupName                  = "pi_placement_group_name"
 	Arg_PlacementGroupPolicy                = "pi_placement_group_policy"
 	Arg_Plan                                = "pi_plan"
+	Arg_PrivateNetwork                      = "pi_private_network"
 	Arg_Processors                          = "pi_processors"
 	Arg_ProcType                            = "pi_proc_type"
+	Arg_PruneOnDelete                       = "pi_prune_on_delete"
+	Arg_PublicNetwork                       = "pi_public_network"
 	Arg_PVMInstanceActionType               = "pi_action"
 	Arg_PVMInstanceHealthStatus             = "pi_health_status"
 	Arg_PVMInstanceId                       = "pi_instance_id"
@@ -61,6 +79,7 @@ const (
 	Arg_ReplicationPolicy                   = "pi_replication_policy"
 	Arg_ReplicationScheme                   = "pi_replication_scheme"
 	Arg_ResourceGroupID                     = "pi_resource_group_id"
+	Arg_Retention                           = "pi_retention"
 	Arg_SAP                                 = "sap"
 	Arg_SAPDeploymentType                   = "pi_sap_deployment_type"
 	Arg_SAPProfileID                        = "pi_sap_profile_id"
@@ -73,6 +92,7 @@ const (
 	Arg_SharedProcessorPoolReservedCores    = "pi_shared_processor_pool_reserved_cores"
 	Arg_SnapshotID                          = "pi_snapshot_id"
 	Arg_SnapShotName                        = "pi_snap_shot_name"
+	Arg_SourceCRN                           = "pi_source_crn"
 	Arg_SPPPlacementGroupID                 = "pi_spp_placement_group_id"
 	Arg_SPPPlacementGroupName               = "pi_spp_placement_group_name"
 	Arg_SPPPlacementGroupPolicy             = "pi_spp_placement_group_policy"
@@ -82,7 +102,10 @@ const (
 	Arg_StoragePoolAffinity                 = "pi_storage_pool_affinity"
 	Arg_StorageType                         = "pi_storage_type"
 	Arg_SysType                             = "pi_sys_type"
+	Arg_TargetCloudInstanceID               = "pi_target_cloud_instance_id"
+	Arg_TargetInstanceID                    = "pi_target_instance_id"
 	Arg_TargetStorageTier                   = "pi_target_storage_tier"
+	Arg_TransitGatewayID                    = "pi_transit_gateway_id"
 	Arg_UserData                            = "pi_user_data"
 	Arg_VirtualCoresAssigned                = "pi_virtual_cores_assigned"
 	Arg_VirtualOpticalDevice                = "pi_virtual_optical_device"
@@ -118,6 +141,7 @@ const (
 	Attr_AvailableHosts                              = "available_hosts"
 	Attr_AvailableIPCount                            = "available_ip_count"
 	Attr_AvailableMemory                             = "available_memory"
+	Attr_BackupID                                    = "backup_id"
 	Attr_Bootable                                    = "bootable"
 	Attr_BootVolumeID                                = "boot_volume_id"
 	Attr_Capabilities                                = "capabilities"
@@ -158,9 +182,11 @@ const (
 	Attr_DeleteOnTermination                         = "delete_on_termination"
 	Attr_DeploymentType                              = "deployment_type"
 	Attr_Description                                 = "description"
+	Attr_Destination                                 = "destination"
 	Attr_Details                                     = "details"
 	Attr_DhcpID                                      = "dhcp_id"
 	Attr_DhcpManaged                                 = "dhcp_managed"
+	Attr_DhcpNetworkID                               = "dhcp_network_id"
 	Attr_DisasterRecoveryLocations                   = "disaster_recovery_locations"
 	Attr_DiskFormat                                  = "disk_format"
 	Attr_DiskType                                    = "disk_type"
@@ -168,6 +194,7 @@ const (
 	Attr_DNS                                         = "dns"
 	Attr_Enabled                                     = "enabled"
 	Attr_Endianness                                  = "endianness"
+	Attr_EndTime                                     = "end_time"
 	Attr_ExternalIP                                  = "external_ip"
 	Attr_FailureMessage                              = "failure_message"
 	Attr_FailureReason                               = "failure_reason"
@@ -197,6 +224,7 @@ const (
 	Attr_IBMiRDSUsers                                = "ibmi_rds_users"
 	Attr_ID                                          = "id"
 	Attr_ImageID                                     = "image_id"
+	Attr_ImageIDs                                    = "image_ids"
 	Attr_ImageInfo                                   = "image_info"
 	Attr_Images                                      = "images"
 	Attr_ImageType                                   = "image_type"
@@ -207,6 +235,7 @@ const (
 	Attr_Instances                                   = "instances"
 	Attr_InstanceSnapshots                           = "instance_snapshots"
 	Attr_InstanceVolumes                             = "instance_volumes"
+	Attr_Inventory                                   = "inventory"
 	Attr_IOThrottleRate                              = "io_throttle_rate"
 	Attr_IP                                          = "ip"
 	Attr_IPAddress                                   = "ip_address"
@@ -258,7 +287,9 @@ const (
 	Attr_NetworkName                                 = "network_name"
 	Attr_NetworkPorts                                = "network_ports"
 	Attr_Networks                                    = "networks"
+	Attr_NextRun                                     = "next_run"
 	Attr_NumberOfVolumes                             = "number_of_volumes"
+	Attr_OnboardingID                                = "onboarding_id"
 	Attr_Onboardings                                 = "onboardings"
 	Attr_OperatingSystem                             = "operating_system"
 	Attr_OSType                                      = "os_type"
@@ -274,6 +305,7 @@ const (
 	Attr_PowerEdgeRouter                             = "power_edge_router"
 	Attr_Primary                                     = "primary"
 	Attr_PrimaryRole                                 = "primary_role"
+	Attr_PrivateNetworkID                            = "private_network_id"
 	Attr_Processors                                  = "processors"
 	Attr_ProcType                                    = "proctype"
 	Attr_Product                                     = "product"
@@ -281,6 +313,7 @@ const (
 	Attr_Profiles                                    = "profiles"
 	Attr_Progress                                    = "progress"
 	Attr_PublicIP                                    = "public_ip"
+	Attr_PublicNetworkID                             = "public_network_id"
 	Attr_PVMInstanceID                               = "pvm_instance_id"
 	Attr_PVMInstances                                = "pvm_instances"
 	Attr_PVMSnapshots                                = "pvm_snapshots"
@@ -288,6 +321,7 @@ const (
 	Attr_RemoteCopyID                                = "remote_copy_id"
 	Attr_RemoteCopyRelationshipNames                 = "remote_copy_relationship_names"
 	Attr_RemoteCopyRelationships                     = "remote_copy_relationships"
+	Attr_Replicants                                  = "replicants"
 	Attr_ReplicationEnabled                          = "replication_enabled"
 	Attr_ReplicationSites                            = "replication_sites"
 	Attr_ReplicationStatus                           = "replication_status"
@@ -323,6 +357,7 @@ const (
 	Attr_SharedProcessorPoolStatusDetail             = "status_detail"
 	Attr_Size                                        = "size"
 	Attr_SnapshotID                                  = "snapshot_id"
+	Attr_SourceInstanceID                            = "source_instance_id"
 	Attr_SourceVolumeID                              = "source_volume_id"
 	Attr_SourceVolumeName                            = "source_volume_name"
 	Attr_Speed                                       = "speed"
@@ -337,6 +372,7 @@ const (
 	Attr_Status                                      = "status"
 	Attr_StatusDescriptionErrors                     = "status_description_errors"
 	Attr_StatusDetail                                = "status_detail"
+	Attr_Steps                                       = "steps"
 	Attr_StoragePool                                 = "storage_pool"
 	Attr_StoragePoolAffinity                         = "storage_pool_affinity"
 	Attr_StoragePoolsCapacity                        = "storage_pools_capacity"
@@ -349,6 +385,8 @@ const (
 	Attr_Systems                                     = "systems"
 	Attr_SysType                                     = "sys_type"
 	Attr_Systype                                     = "systype"
+	Attr_Target                                      = "target"
+	Attr_TargetInstanceID                            = "target_instance_id"
 	Attr_TargetVolumeName                            = "target_volume_name"
 	Attr_TaskID                                      = "task_id"
 	Attr_TenantID                                    = "tenant_id"
@@ -361,6 +399,7 @@ const (
 	Attr_TotalProcessorsConsumed                     = "total_processors_consumed"
 	Attr_TotalSSDStorageConsumed                     = "total_ssd_storage_consumed"
 	Attr_TotalStandardStorageConsumed                = "total_standard_storage_consumed"
+	Attr_TransitGatewayConnectionID                  = "transit_gateway_connection_id"
 	Attr_Type                                        = "type"
 	Attr_Uncapped                                    = "uncapped"
 	Attr_URL                                         = "url"
@@ -415,18 +454,23 @@ const (
 	Affinity                  = "affinity"
 	AntiAffinity              = "anti-affinity"
 	Attach                    = "attach"
+	Aux                       = "aux"
 	BYOL                      = "byol"
 	Capped                    = "capped"
+	Capture                   = "capture"
 	Critical                  = "CRITICAL"
 	CUSTOM_VIRTUAL_CORES      = "custom-virtualcores"
 	Dedicated                 = "dedicated"
 	DeploymentTypeEpic        = "EPIC"
 	DeploymentTypeVMNoStorage = "VMNoStorage"
 	DHCPVlan                  = "dhcp-vlan"
+	Failback                  = "failback"
+	Failover                  = "failover"
 	Hana                      = "Hana"
 	Hard                      = "hard"
 	Host                      = "host"
 	HostGroup                 = "hostGroup"
+	Master                    = "master"
 	Netweaver                 = "Netweaver"
 	None                      = "none"
 	OK                        = "OK"
@@ -436,6 +480,7 @@ const (
 	PubVlan                   = "pub-vlan"
 	SAP                       = "SAP"
 	Shared                    = "shared"
+	Snapshot                  = "snapshot"
 	Soft                      = "soft"
 	Suffix                    = "suffix"
 	Vlan                      = "vlan"
@@ -456,10 +501,12 @@ const (
 	State_ACTIVE             = "ACTIVE"
 	State_Added              = "added"
 	State_Adding             = "adding"
+	State_Attached           = "attached"
 	State_Available          = "available"
 	State_Build              = "build"
 	State_Building           = "building"
 	State_Completed          = "completed"
+	State_ConsistentSynced   = "consistent_synchronized"
 	State_Creating           = "creating"
 	State_Deleted            = "deleted"
 	State_Deleting           = "deleting"
@@ -475,6 +522,7 @@ const (
 	State_Pending            = "pending"
 	State_PENDING            = "PENDING"
 	State_PendingReclamation = "pending_reclamation"
+	State_Planned            = "planned"
 	State_Provisioning       = "provisioning"
 	State_Removed            = "removed"
 	State_Resize             = "resize"
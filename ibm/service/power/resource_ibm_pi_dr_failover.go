@@ -0,0 +1,866 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Actions of the steps of a failover or failback.
+const (
+	drStepCreateInstance   = "create_instance"
+	drStepOnboardVolumes   = "onboard_volumes"
+	drStepStartInstance    = "start_instance"
+	drStepStartReplication = "start_replication"
+	drStepStopInstance     = "stop_instance"
+	drStepStopReplication  = "stop_replication"
+	drStepWaitSynchronized = "wait_synchronized"
+)
+
+const (
+	drOnboardingStatusFailed  = "FAILED"
+	drOnboardingStatusSuccess = "SUCCESS"
+	drStepStatusSkipped       = "skipped"
+)
+
+func ResourceIBMPIDRFailover() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIDRFailoverCreate,
+		ReadContext:   resourceIBMPIDRFailoverRead,
+		DeleteContext: resourceIBMPIDRFailoverDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(180 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description: "The GUID of the primary workspace, which holds the master volumes of the replicated volume groups.",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_DryRun: {
+				Default:     false,
+				Description: "Only compute the ordered steps of the operation without performing them.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			Arg_Instances: {
+				Description: "The instances to fail over or fail back, with their replicated volume groups.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Arg_InstanceID: {
+							Description: "The ID of the instance in the primary workspace.",
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+						Arg_InstanceName: {
+							Description: "The name of the instance recreated in the secondary workspace; defaults to the name of the instance in the primary workspace.",
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						Arg_NetworkID: {
+							Description: "The ID of the network of the secondary workspace to attach the recreated instance to; required for failover.",
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						Arg_SysType: {
+							Description: "The system type of the instance recreated in the secondary workspace; defaults to the system type of the instance in the primary workspace.",
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						Arg_TargetInstanceID: {
+							Description: "The ID of the instance in the secondary workspace; required for failback.",
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						Arg_VolumeGroupID: {
+							Description: "The ID of the replicated volume group of the instance in the primary workspace.",
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				ForceNew: true,
+				MinItems: 1,
+				Required: true,
+				Type:     schema.TypeList,
+			},
+			Arg_Mode: {
+				Default:      Failover,
+				Description:  "The operation to perform, either failover to the secondary workspace or failback to the primary workspace.",
+				ForceNew:     true,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{Failover, Failback}),
+			},
+			Arg_SourceCRN: {
+				Description: "The CRN of the primary workspace, used to onboard the auxiliary volumes in the secondary workspace; required for failover.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_TargetCloudInstanceID: {
+				Description: "The GUID of the secondary workspace.",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+
+			// Attributes
+			Attr_Instances: {
+				Computed:    true,
+				Description: "The outcome of the operation for each instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_BootVolumeID: {
+							Computed:    true,
+							Description: "The ID of the onboarded boot volume the instance was recreated from.",
+							Type:        schema.TypeString,
+						},
+						Attr_OnboardingID: {
+							Computed:    true,
+							Description: "The ID of the volume onboarding operation in the secondary workspace.",
+							Type:        schema.TypeString,
+						},
+						Attr_SourceInstanceID: {
+							Computed:    true,
+							Description: "The ID of the instance in the primary workspace.",
+							Type:        schema.TypeString,
+						},
+						Attr_TargetInstanceID: {
+							Computed:    true,
+							Description: "The ID of the instance in the secondary workspace.",
+							Type:        schema.TypeString,
+						},
+						Attr_VolumeIDs: {
+							Computed:    true,
+							Description: "The IDs of the volumes onboarded in the secondary workspace.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Type:        schema.TypeList,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
+			Attr_Steps: {
+				Computed:    true,
+				Description: "The ordered steps of the operation with their outcome.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_Action: {
+							Computed:    true,
+							Description: "The action performed by the step.",
+							Type:        schema.TypeString,
+						},
+						Attr_EndTime: {
+							Computed:    true,
+							Description: "The time the step ended.",
+							Type:        schema.TypeString,
+						},
+						Attr_Message: {
+							Computed:    true,
+							Description: "The error message of a failed step, or a note that a previous attempt already completed the step.",
+							Type:        schema.TypeString,
+						},
+						Attr_StartTime: {
+							Computed:    true,
+							Description: "The time the step started.",
+							Type:        schema.TypeString,
+						},
+						Attr_Status: {
+							Computed:    true,
+							Description: "The status of the step, either planned, completed, failed or skipped.",
+							Type:        schema.TypeString,
+						},
+						Attr_Target: {
+							Computed:    true,
+							Description: "The instance or volume group the step acts on.",
+							Type:        schema.TypeString,
+						},
+						Attr_Workspace: {
+							Computed:    true,
+							Description: "The GUID of the workspace the step runs in.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
+		},
+	}
+}
+
+// drInstance tracks a single instance through a failover or failback.
+type drInstance struct {
+	sourceID      string
+	targetID      string
+	volumeGroupID string
+	name          string
+	networkID     string
+	sysType       string
+	source        *models.PVMInstance
+	volumes       []*models.VolumeReference
+	onboardingID  string
+	bootVolumeID  string
+	volumeIDs     []string
+}
+
+// drStep is a single operation of a failover or failback. done, when set, tells from the
+// workspaces whether a previous attempt of the operation already performed the step.
+type drStep struct {
+	action    string
+	workspace string
+	target    string
+	done      func() (bool, error)
+	run       func() error
+	status    string
+	message   string
+	startTime string
+	endTime   string
+}
+
+func resourceIBMPIDRFailoverCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	targetCloudInstanceID := d.Get(Arg_TargetCloudInstanceID).(string)
+	mode := d.Get(Arg_Mode).(string)
+	instances := expandDRInstances(d.Get(Arg_Instances).([]interface{}))
+
+	var steps []*drStep
+	if mode == Failback {
+		steps, err = planDRFailback(ctx, d, sess, instances)
+	} else {
+		steps, err = planDRFailover(ctx, d, sess, instances)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The resource is stored before the steps run, so that a failed operation keeps the record of
+	// its steps; the operation is performed again by the next apply, which skips the completed steps.
+	d.SetId(fmt.Sprintf("%s/%s/%s", cloudInstanceID, targetCloudInstanceID, mode))
+	if !d.Get(Arg_DryRun).(bool) {
+		err = runDRSteps(steps)
+	}
+	d.Set(Attr_Steps, flattenDRSteps(steps))
+	d.Set(Attr_Instances, flattenDRInstances(instances))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPIDRFailoverRead(ctx, d, meta)
+}
+
+func resourceIBMPIDRFailoverRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The steps and instances are a record of the operation, there is nothing to refresh
+	return nil
+}
+
+func resourceIBMPIDRFailoverDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// There is no delete or unset concept for a failover, a failback is a separate operation
+	d.SetId("")
+	return nil
+}
+
+// planDRFailover validates the instances and returns the steps to stop them, swap the replication
+// roles of their volume groups, onboard their auxiliary volumes in the secondary workspace and
+// recreate them there from the onboarded volumes.
+func planDRFailover(ctx context.Context, d *schema.ResourceData, sess *ibmpisession.IBMPISession, instances []*drInstance) ([]*drStep, error) {
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	targetCloudInstanceID := d.Get(Arg_TargetCloudInstanceID).(string)
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	sourceCRN := d.Get(Arg_SourceCRN).(string)
+	if sourceCRN == "" {
+		return nil, fmt.Errorf("%s is required when %s is %s", Arg_SourceCRN, Arg_Mode, Failover)
+	}
+
+	client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	volumeClient := instance.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
+	vgClient := instance.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	targetClient := instance.NewIBMPIInstanceClient(ctx, sess, targetCloudInstanceID)
+	targetVolumeClient := instance.NewIBMPIVolumeClient(ctx, sess, targetCloudInstanceID)
+	onboardingClient := instance.NewIBMPIVolumeOnboardingClient(ctx, sess, targetCloudInstanceID)
+
+	for _, ins := range instances {
+		if ins.networkID == "" {
+			return nil, fmt.Errorf("%s is required for instance %s when %s is %s", Arg_NetworkID, ins.sourceID, Arg_Mode, Failover)
+		}
+		pvm, err := client.Get(ins.sourceID)
+		if err != nil {
+			return nil, err
+		}
+		ins.source = pvm
+		if ins.name == "" {
+			ins.name = *pvm.ServerName
+		}
+		if ins.sysType == "" {
+			ins.sysType = pvm.SysType
+		}
+		vols, err := volumeClient.GetAllInstanceVolumes(ins.sourceID)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range vols.Volumes {
+			if v.GroupID != ins.volumeGroupID || v.AuxVolumeName == "" {
+				return nil, fmt.Errorf("volume %s of instance %s is not replicated in volume group %s", *v.VolumeID, ins.sourceID, ins.volumeGroupID)
+			}
+		}
+		ins.volumes = vols.Volumes
+	}
+
+	var steps []*drStep
+	for _, ins := range instances {
+		ins := ins
+		steps = append(steps, &drStep{
+			action:    drStepStopInstance,
+			workspace: cloudInstanceID,
+			target:    ins.sourceID,
+			run: func() error {
+				return stopDRInstance(ctx, client, ins.sourceID, timeout)
+			},
+		})
+	}
+	for _, vgID := range drVolumeGroupIDs(instances) {
+		vgID := vgID
+		// The roles are only swapped by the start of the replication, so both steps are done once swapped
+		swapped := func() (bool, error) {
+			return isDRVolumeGroupPrimaryRole(vgClient, vgID, Aux)
+		}
+		steps = append(steps, &drStep{
+			action:    drStepStopReplication,
+			workspace: cloudInstanceID,
+			target:    vgID,
+			done:      swapped,
+			run: func() error {
+				body := &models.VolumeGroupAction{Stop: &models.VolumeGroupActionStop{Access: flex.PtrToBool(true)}}
+				return performDRVolumeGroupAction(ctx, vgClient, vgID, body, timeout)
+			},
+		}, &drStep{
+			action:    drStepStartReplication,
+			workspace: cloudInstanceID,
+			target:    vgID,
+			done:      swapped,
+			run: func() error {
+				body := &models.VolumeGroupAction{Start: &models.VolumeGroupActionStart{Source: flex.PtrToString(Aux)}}
+				err := performDRVolumeGroupAction(ctx, vgClient, vgID, body, timeout)
+				if err != nil {
+					return err
+				}
+				_, err = isWaitForPIVolumeGroupPrimaryRole(ctx, vgClient, vgID, Aux, timeout)
+				return err
+			},
+		})
+	}
+	for _, ins := range instances {
+		ins := ins
+		steps = append(steps, &drStep{
+			action:    drStepOnboardVolumes,
+			workspace: targetCloudInstanceID,
+			target:    ins.volumeGroupID,
+			done: func() (bool, error) {
+				return findDROnboardedVolumes(targetVolumeClient, ins)
+			},
+			run: func() error {
+				return onboardDRVolumes(ctx, onboardingClient, targetVolumeClient, ins, sourceCRN, timeout)
+			},
+		})
+	}
+	for _, ins := range instances {
+		ins := ins
+		steps = append(steps, &drStep{
+			action:    drStepCreateInstance,
+			workspace: targetCloudInstanceID,
+			target:    ins.name,
+			done: func() (bool, error) {
+				return findDRInstance(targetClient, ins)
+			},
+			run: func() error {
+				return createDRInstance(ctx, targetClient, ins, timeout)
+			},
+		})
+	}
+	return steps, nil
+}
+
+// planDRFailback validates the instances and returns the steps to stop them in the secondary
+// workspace, swap the replication roles of their volume groups back once synchronized and start
+// the original instances in the primary workspace.
+func planDRFailback(ctx context.Context, d *schema.ResourceData, sess *ibmpisession.IBMPISession, instances []*drInstance) ([]*drStep, error) {
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	targetCloudInstanceID := d.Get(Arg_TargetCloudInstanceID).(string)
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	vgClient := instance.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	targetClient := instance.NewIBMPIInstanceClient(ctx, sess, targetCloudInstanceID)
+
+	for _, ins := range instances {
+		if ins.targetID == "" {
+			return nil, fmt.Errorf("%s is required for instance %s when %s is %s", Arg_TargetInstanceID, ins.sourceID, Arg_Mode, Failback)
+		}
+		if _, err := client.Get(ins.sourceID); err != nil {
+			return nil, err
+		}
+		if _, err := targetClient.Get(ins.targetID); err != nil {
+			return nil, err
+		}
+	}
+
+	var steps []*drStep
+	for _, ins := range instances {
+		ins := ins
+		steps = append(steps, &drStep{
+			action:    drStepStopInstance,
+			workspace: targetCloudInstanceID,
+			target:    ins.targetID,
+			run: func() error {
+				return stopDRInstance(ctx, targetClient, ins.targetID, timeout)
+			},
+		})
+	}
+	for _, vgID := range drVolumeGroupIDs(instances) {
+		vgID := vgID
+		// The roles are only swapped back by the start of the replication, so the steps are done once swapped back
+		swapped := func() (bool, error) {
+			return isDRVolumeGroupPrimaryRole(vgClient, vgID, Master)
+		}
+		steps = append(steps, &drStep{
+			action:    drStepWaitSynchronized,
+			workspace: cloudInstanceID,
+			target:    vgID,
+			done:      swapped,
+			run: func() error {
+				_, err := isWaitForPIVolumeGroupSynchronized(ctx, vgClient, vgID, timeout)
+				return err
+			},
+		}, &drStep{
+			action:    drStepStopReplication,
+			workspace: cloudInstanceID,
+			target:    vgID,
+			done:      swapped,
+			run: func() error {
+				body := &models.VolumeGroupAction{Stop: &models.VolumeGroupActionStop{Access: flex.PtrToBool(true)}}
+				return performDRVolumeGroupAction(ctx, vgClient, vgID, body, timeout)
+			},
+		}, &drStep{
+			action:    drStepStartReplication,
+			workspace: cloudInstanceID,
+			target:    vgID,
+			done:      swapped,
+			run: func() error {
+				body := &models.VolumeGroupAction{Start: &models.VolumeGroupActionStart{Source: flex.PtrToString(Master)}}
+				err := performDRVolumeGroupAction(ctx, vgClient, vgID, body, timeout)
+				if err != nil {
+					return err
+				}
+				_, err = isWaitForPIVolumeGroupPrimaryRole(ctx, vgClient, vgID, Master, timeout)
+				return err
+			},
+		})
+	}
+	for _, ins := range instances {
+		ins := ins
+		steps = append(steps, &drStep{
+			action:    drStepStartInstance,
+			workspace: cloudInstanceID,
+			target:    ins.sourceID,
+			done: func() (bool, error) {
+				pvm, err := client.Get(ins.sourceID)
+				if err != nil {
+					return false, err
+				}
+				return pvm.Status != nil && strings.ToLower(*pvm.Status) == State_Active, nil
+			},
+			run: func() error {
+				return startDRInstance(ctx, client, ins.sourceID, timeout)
+			},
+		})
+	}
+	return steps, nil
+}
+
+// runDRSteps runs the steps in order, skipping the steps a previous attempt already completed, and stops
+// at the first failure, leaving the remaining steps skipped.
+func runDRSteps(steps []*drStep) error {
+	for i, step := range steps {
+		step.startTime = time.Now().UTC().Format(time.RFC3339)
+		var err error
+		done := false
+		if step.done != nil {
+			done, err = step.done()
+		}
+		if err == nil && done {
+			log.Printf("[INFO] %s on %s in workspace %s is already completed", step.action, step.target, step.workspace)
+			step.message = "already completed by a previous attempt"
+		} else if err == nil {
+			log.Printf("[INFO] running %s on %s in workspace %s", step.action, step.target, step.workspace)
+			err = step.run()
+		}
+		step.endTime = time.Now().UTC().Format(time.RFC3339)
+		if err != nil {
+			step.status = State_Failed
+			step.message = err.Error()
+			for _, s := range steps[i+1:] {
+				s.status = drStepStatusSkipped
+			}
+			return fmt.Errorf("failed to %s %s in workspace %s: %v", strings.ReplaceAll(step.action, "_", " "), step.target, step.workspace, err)
+		}
+		step.status = State_Completed
+	}
+	return nil
+}
+
+// isDRVolumeGroupPrimaryRole returns whether the primary role of a volume group is already role.
+func isDRVolumeGroupPrimaryRole(client *instance.IBMPIVolumeGroupClient, id, role string) (bool, error) {
+	vg, err := client.GetVolumeGroupLiveDetails(id)
+	if err != nil {
+		return false, err
+	}
+	return vg.PrimaryRole == role, nil
+}
+
+// findDROnboardedVolumes looks up the volumes of an instance already onboarded in the secondary workspace,
+// under the names of their master volumes, and identifies the onboarded boot volume.
+func findDROnboardedVolumes(client *instance.IBMPIVolumeClient, ins *drInstance) (bool, error) {
+	vols, err := client.GetAll()
+	if err != nil {
+		return false, err
+	}
+	byName := map[string]string{}
+	for _, v := range vols.Volumes {
+		if v.Name != nil && v.VolumeID != nil {
+			byName[*v.Name] = *v.VolumeID
+		}
+	}
+	var bootVolumeID string
+	var volumeIDs []string
+	for _, v := range ins.volumes {
+		id, ok := byName[*v.Name]
+		if !ok {
+			return false, nil
+		}
+		if v.BootVolume != nil && *v.BootVolume {
+			bootVolumeID = id
+		} else {
+			volumeIDs = append(volumeIDs, id)
+		}
+	}
+	if bootVolumeID == "" {
+		return false, nil
+	}
+	ins.bootVolumeID = bootVolumeID
+	ins.volumeIDs = volumeIDs
+	return true, nil
+}
+
+// findDRInstance looks up the instance already recreated in the secondary workspace by its name.
+func findDRInstance(client *instance.IBMPIInstanceClient, ins *drInstance) (bool, error) {
+	pvms, err := client.GetAll()
+	if err != nil {
+		return false, err
+	}
+	for _, pvm := range pvms.PvmInstances {
+		if pvm.ServerName != nil && *pvm.ServerName == ins.name && pvm.PvmInstanceID != nil {
+			ins.targetID = *pvm.PvmInstanceID
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func stopDRInstance(ctx context.Context, client *instance.IBMPIInstanceClient, id string, timeout time.Duration) error {
+	pvm, err := client.Get(id)
+	if err != nil {
+		return err
+	}
+	if strings.ToLower(*pvm.Status) == State_Shutoff {
+		log.Printf("[INFO] the lpar %s is already in the shutoff state", id)
+		return nil
+	}
+	err = client.Action(id, &models.PVMInstanceAction{Action: flex.PtrToString(Action_ImmediateShutdown)})
+	if err != nil {
+		return err
+	}
+	_, err = isWaitForPIInstanceStopped(ctx, client, id, timeout)
+	return err
+}
+
+func startDRInstance(ctx context.Context, client *instance.IBMPIInstanceClient, id string, timeout time.Duration) error {
+	err := client.Action(id, &models.PVMInstanceAction{Action: flex.PtrToString(Action_Start)})
+	if err != nil {
+		return err
+	}
+	_, err = isWaitForPIInstanceAvailable(ctx, client, id, OK, timeout)
+	return err
+}
+
+func performDRVolumeGroupAction(ctx context.Context, client *instance.IBMPIVolumeGroupClient, id string, body *models.VolumeGroupAction, timeout time.Duration) error {
+	_, err := client.VolumeGroupAction(id, body)
+	if err != nil {
+		return err
+	}
+	_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, id, timeout)
+	return err
+}
+
+// onboardDRVolumes onboards the auxiliary volumes of an instance in the secondary workspace under the
+// names of their master volumes, and identifies the onboarded boot volume.
+func onboardDRVolumes(ctx context.Context, client *instance.IBMPIVolumeOnboardingClient, volumeClient *instance.IBMPIVolumeClient, ins *drInstance, sourceCRN string, timeout time.Duration) error {
+	auxVolumes := make([]*models.AuxiliaryVolumeForOnboarding, 0, len(ins.volumes))
+	var bootVolumeName string
+	for _, v := range ins.volumes {
+		auxVolumes = append(auxVolumes, &models.AuxiliaryVolumeForOnboarding{
+			AuxVolumeName: flex.PtrToString(v.AuxVolumeName),
+			Name:          *v.Name,
+		})
+		if v.BootVolume != nil && *v.BootVolume {
+			bootVolumeName = *v.Name
+		}
+	}
+	body := &models.VolumeOnboardingCreate{
+		Description: fmt.Sprintf("Failover of instance %s", ins.sourceID),
+		Volumes: []*models.AuxiliaryVolumesForOnboarding{
+			{
+				AuxiliaryVolumes: auxVolumes,
+				SourceCRN:        &sourceCRN,
+			},
+		},
+	}
+	resOnboarding, err := client.CreateVolumeOnboarding(body)
+	if err != nil {
+		return err
+	}
+	ins.onboardingID = resOnboarding.ID
+
+	onboardingData, err := isWaitForPIVolumeOnboardingCompleted(ctx, client, ins.onboardingID, timeout)
+	if err != nil {
+		return err
+	}
+	onboarded := onboardingData.(*models.VolumeOnboarding).Results.OnboardedVolumes
+
+	vols, err := volumeClient.GetAll()
+	if err != nil {
+		return err
+	}
+	for _, o := range onboarded {
+		for _, v := range vols.Volumes {
+			if *v.VolumeID != o && *v.Name != o {
+				continue
+			}
+			if *v.Name == bootVolumeName {
+				ins.bootVolumeID = *v.VolumeID
+			} else {
+				ins.volumeIDs = append(ins.volumeIDs, *v.VolumeID)
+			}
+		}
+	}
+	if ins.bootVolumeID == "" {
+		return fmt.Errorf("boot volume %s of instance %s was not onboarded", bootVolumeName, ins.sourceID)
+	}
+	return nil
+}
+
+// createDRInstance deploys the instance in the secondary workspace from its onboarded boot volume,
+// with the same compute configuration as the instance in the primary workspace.
+func createDRInstance(ctx context.Context, client *instance.IBMPIInstanceClient, ins *drInstance, timeout time.Duration) error {
+	body := &models.PVMInstanceCreate{
+		ImageID:    flex.PtrToString(ins.bootVolumeID),
+		Memory:     ins.source.Memory,
+		Networks:   []*models.PVMInstanceAddNetwork{{NetworkID: flex.PtrToString(ins.networkID)}},
+		ProcType:   ins.source.ProcType,
+		Processors: ins.source.Processors,
+		ServerName: flex.PtrToString(ins.name),
+		SysType:    ins.sysType,
+		VolumeIDs:  ins.volumeIDs,
+	}
+	pvmList, err := client.Create(body)
+	if err != nil {
+		return fmt.Errorf("failed to provision: %v", err)
+	}
+	if pvmList == nil || len(*pvmList) == 0 {
+		return fmt.Errorf("failed to provision")
+	}
+	ins.targetID = *(*pvmList)[0].PvmInstanceID
+	_, err = isWaitForPIInstanceAvailable(ctx, client, ins.targetID, OK, timeout)
+	return err
+}
+
+func isWaitForPIVolumeGroupPrimaryRole(ctx context.Context, client *instance.IBMPIVolumeGroupClient, id, role string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for Volume Group (%s) primary role to be %s", id, role)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{State_InProgress},
+		Target:     []string{role},
+		Refresh:    isPIVolumeGroupPrimaryRoleRefreshFunc(client, id, role),
+		Delay:      10 * time.Second,
+		MinTimeout: Timeout_Active,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isPIVolumeGroupPrimaryRoleRefreshFunc(client *instance.IBMPIVolumeGroupClient, id, role string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vg, err := client.GetVolumeGroupLiveDetails(id)
+		if err != nil {
+			return nil, "", err
+		}
+		if vg.PrimaryRole == role {
+			return vg, role, nil
+		}
+		return vg, State_InProgress, nil
+	}
+}
+
+func isWaitForPIVolumeGroupSynchronized(ctx context.Context, client *instance.IBMPIVolumeGroupClient, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for Volume Group (%s) to be synchronized", id)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{State_InProgress},
+		Target:     []string{State_ConsistentSynced},
+		Refresh:    isPIVolumeGroupSynchronizedRefreshFunc(client, id),
+		Delay:      10 * time.Second,
+		MinTimeout: Timeout_Active,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isPIVolumeGroupSynchronizedRefreshFunc(client *instance.IBMPIVolumeGroupClient, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vg, err := client.GetVolumeGroupLiveDetails(id)
+		if err != nil {
+			return nil, "", err
+		}
+		if vg.State == State_ConsistentSynced {
+			return vg, State_ConsistentSynced, nil
+		}
+		return vg, State_InProgress, nil
+	}
+}
+
+func isWaitForPIVolumeOnboardingCompleted(ctx context.Context, client *instance.IBMPIVolumeOnboardingClient, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for Volume Onboarding (%s) to be completed", id)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{State_InProgress},
+		Target:     []string{State_Completed},
+		Refresh:    isPIVolumeOnboardingRefreshFunc(client, id),
+		Delay:      10 * time.Second,
+		MinTimeout: Timeout_Active,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isPIVolumeOnboardingRefreshFunc(client *instance.IBMPIVolumeOnboardingClient, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		onboarding, err := client.Get(id)
+		if err != nil {
+			return nil, "", err
+		}
+		switch strings.ToUpper(onboarding.Status) {
+		case drOnboardingStatusSuccess:
+			return onboarding, State_Completed, nil
+		case drOnboardingStatusFailed:
+			failures := []string{}
+			if onboarding.Results != nil {
+				for _, f := range onboarding.Results.VolumeOnboardingFailures {
+					failures = append(failures, fmt.Sprintf("%s: %s", strings.Join(f.Volumes, ", "), f.FailureMessage))
+				}
+			}
+			return onboarding, State_Failed, fmt.Errorf("failed to onboard the volumes: %s", strings.Join(failures, "; "))
+		}
+		return onboarding, State_InProgress, nil
+	}
+}
+
+// drVolumeGroupIDs returns the distinct volume groups of the instances, in order.
+func drVolumeGroupIDs(instances []*drInstance) []string {
+	seen := map[string]bool{}
+	ids := []string{}
+	for _, ins := range instances {
+		if !seen[ins.volumeGroupID] {
+			seen[ins.volumeGroupID] = true
+			ids = append(ids, ins.volumeGroupID)
+		}
+	}
+	return ids
+}
+
+func expandDRInstances(data []interface{}) []*drInstance {
+	instances := make([]*drInstance, 0, len(data))
+	for _, v := range data {
+		m := v.(map[string]interface{})
+		instances = append(instances, &drInstance{
+			sourceID:      m[Arg_InstanceID].(string),
+			targetID:      m[Arg_TargetInstanceID].(string),
+			volumeGroupID: m[Arg_VolumeGroupID].(string),
+			name:          m[Arg_InstanceName].(string),
+			networkID:     m[Arg_NetworkID].(string),
+			sysType:       m[Arg_SysType].(string),
+		})
+	}
+	return instances
+}
+
+func flattenDRInstances(instances []*drInstance) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(instances))
+	for _, ins := range instances {
+		result = append(result, map[string]interface{}{
+			Attr_BootVolumeID:     ins.bootVolumeID,
+			Attr_OnboardingID:     ins.onboardingID,
+			Attr_SourceInstanceID: ins.sourceID,
+			Attr_TargetInstanceID: ins.targetID,
+			Attr_VolumeIDs:        ins.volumeIDs,
+		})
+	}
+	return result
+}
+
+func flattenDRSteps(steps []*drStep) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(steps))
+	for _, step := range steps {
+		status := step.status
+		if status == "" {
+			status = State_Planned
+		}
+		result = append(result, map[string]interface{}{
+			Attr_Action:    step.action,
+			Attr_EndTime:   step.endTime,
+			Attr_Message:   step.message,
+			Attr_StartTime: step.startTime,
+			Attr_Status:    status,
+			Attr_Target:    step.target,
+			Attr_Workspace: step.workspace,
+		})
+	}
+	return result
+}
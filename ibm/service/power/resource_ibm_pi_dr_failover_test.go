@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMPIDRFailoverDryRun(t *testing.T) {
+	failoverRes := "ibm_pi_dr_failover.dr_failover"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIDRFailoverDryRunConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(failoverRes, "id"),
+					resource.TestCheckResourceAttr(failoverRes, "steps.#", "5"),
+					resource.TestCheckResourceAttr(failoverRes, "steps.0.action", "stop_instance"),
+					resource.TestCheckResourceAttr(failoverRes, "steps.0.status", "planned"),
+					resource.TestCheckResourceAttr(failoverRes, "steps.1.action", "stop_replication"),
+					resource.TestCheckResourceAttr(failoverRes, "steps.2.action", "start_replication"),
+					resource.TestCheckResourceAttr(failoverRes, "steps.3.action", "onboard_volumes"),
+					resource.TestCheckResourceAttr(failoverRes, "steps.4.action", "create_instance"),
+					resource.TestCheckResourceAttr(failoverRes, "instances.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIDRFailoverDryRunConfig() string {
+	return fmt.Sprintf(`
+	data "ibm_pi_instance" "instance" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_instance_name     = "%[4]s"
+	}
+
+	data "ibm_pi_network" "network" {
+		pi_cloud_instance_id = "%[3]s"
+		pi_network_name      = "%[5]s"
+	}
+
+	resource "ibm_pi_dr_failover" "dr_failover" {
+		pi_cloud_instance_id        = "%[1]s"
+		pi_dry_run                  = true
+		pi_source_crn               = "%[2]s"
+		pi_target_cloud_instance_id = "%[3]s"
+		pi_instances {
+			pi_instance_id     = data.ibm_pi_instance.instance.id
+			pi_network_id      = data.ibm_pi_network.network.id
+			pi_volume_group_id = "%[6]s"
+		}
+	}
+	`, acc.Pi_cloud_instance_id, acc.Pi_volume_onboarding_source_crn, acc.Pi_dr_target_cloud_instance_id, acc.Pi_instance_name, acc.Pi_network_name, acc.Pi_volume_group_id)
+}
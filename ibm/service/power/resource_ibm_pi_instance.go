@@ -6,17 +6,21 @@ package power
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -222,11 +226,11 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Type:          schema.TypeFloat,
 			},
 			Arg_Replicants: {
-				Default:     1,
-				Description: "PI Instance replicas count",
-				ForceNew:    true,
-				Optional:    true,
-				Type:        schema.TypeInt,
+				Default:      1,
+				Description:  "PI Instance replicas count; changing the count adds or removes replicants without recreating the existing ones",
+				Optional:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 			Arg_ReplicationPolicy: {
 				Default:      None,
@@ -389,6 +393,69 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Description: "Progress of the operation",
 				Type:        schema.TypeFloat,
 			},
+			Attr_Replicants: {
+				Computed:    true,
+				Description: "List of all the PI Instance replicants, in creation order",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_HealthStatus: {
+							Computed:    true,
+							Description: "The health status of the replicant",
+							Type:        schema.TypeString,
+						},
+						Attr_InstanceID: {
+							Computed:    true,
+							Description: "The unique identifier of the replicant",
+							Type:        schema.TypeString,
+						},
+						Attr_Name: {
+							Computed:    true,
+							Description: "The name of the replicant",
+							Type:        schema.TypeString,
+						},
+						Attr_Networks: {
+							Computed:    true,
+							Description: "The networks attached to the replicant",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									Attr_ExternalIP: {
+										Computed:    true,
+										Description: "The external IP address of the network",
+										Type:        schema.TypeString,
+									},
+									Attr_IPAddress: {
+										Computed:    true,
+										Description: "The IP address of the network",
+										Type:        schema.TypeString,
+									},
+									Attr_MacAddress: {
+										Computed:    true,
+										Description: "The MAC address of the network",
+										Type:        schema.TypeString,
+									},
+									Attr_NetworkID: {
+										Computed:    true,
+										Description: "The ID of the network",
+										Type:        schema.TypeString,
+									},
+									Attr_NetworkName: {
+										Computed:    true,
+										Description: "The name of the network",
+										Type:        schema.TypeString,
+									},
+								},
+							},
+							Type: schema.TypeList,
+						},
+						Attr_Status: {
+							Computed:    true,
+							Description: "The status of the replicant",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
 			Attr_SharedProcessorPoolID: {
 				Computed:    true,
 				Description: "Shared Processor Pool ID the instance is deployed on",
@@ -419,72 +486,28 @@ func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 	sapClient := instance.NewIBMPISAPInstanceClient(ctx, sess, cloudInstanceID)
 	imageClient := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
 
+	name := d.Get(Arg_InstanceName).(string)
+	replicants := d.Get(Arg_Replicants).(int)
 	var pvmList *models.PVMInstanceList
 	if _, ok := d.GetOk(Arg_SAPProfileID); ok {
-		pvmList, err = createSAPInstance(d, sapClient)
+		pvmList, err = createSAPInstance(d, sapClient, name, replicants)
 	} else {
-		pvmList, err = createPVMInstance(d, client, imageClient)
+		pvmList, err = createPVMInstance(d, client, imageClient, name, replicants)
 	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	var instanceReadyStatus string
-	if r, ok := d.GetOk(Arg_HealthStatus); ok {
-		instanceReadyStatus = r.(string)
-	}
-
 	// id is a combination of the cloud instance id and all of the pvm instance ids
-	id := cloudInstanceID
+	instanceIDs := make([]string, 0, len(*pvmList))
 	for _, pvm := range *pvmList {
-		id += "/" + *pvm.PvmInstanceID
-	}
-
-	d.SetId(id)
-
-	for _, s := range *pvmList {
-		if dt, ok := d.GetOk(Arg_DeploymentType); ok && dt.(string) == DeploymentTypeVMNoStorage {
-			_, err = isWaitForPIInstanceShutoff(ctx, client, *s.PvmInstanceID, instanceReadyStatus, d.Timeout(schema.TimeoutCreate))
-			if err != nil {
-				return diag.FromErr(err)
-			}
-		} else {
-			_, err = isWaitForPIInstanceAvailable(ctx, client, *s.PvmInstanceID, instanceReadyStatus, d.Timeout(schema.TimeoutCreate))
-			if err != nil {
-				return diag.FromErr(err)
-			}
-		}
+		instanceIDs = append(instanceIDs, *pvm.PvmInstanceID)
 	}
+	d.SetId(strings.Join(append([]string{cloudInstanceID}, instanceIDs...), "/"))
 
-	// If Storage Pool Affinity is given as false we need to update the vm instance.
-	// Default value is true which indicates that all volumes attached to the server
-	// must reside in the same storage pool.
-	storagePoolAffinity := d.Get(Arg_StoragePoolAffinity).(bool)
-	if !storagePoolAffinity {
-		for _, s := range *pvmList {
-			body := &models.PVMInstanceUpdate{
-				StoragePoolAffinity: &storagePoolAffinity,
-			}
-			// This is a synchronous process hence no need to check for health status
-			_, err = client.Update(*s.PvmInstanceID, body)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-		}
-	}
-	// If virtual optical device provided then update cloud initialization
-	if vod, ok := d.GetOk(Arg_VirtualOpticalDevice); ok {
-		for _, s := range *pvmList {
-			body := &models.PVMInstanceUpdate{
-				CloudInitialization: &models.CloudInitialization{
-					VirtualOpticalDevice: vod.(string),
-				},
-			}
-			_, err = client.Update(*s.PvmInstanceID, body)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-		}
+	err = waitForPIInstancesCreated(ctx, client, d, instanceIDs, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	return resourceIBMPIInstanceRead(ctx, d, meta)
@@ -587,17 +610,63 @@ func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 	} else {
 		d.Set(Attr_Fault, nil)
 	}
+
+	replicants := make([]map[string]interface{}, 0, len(idArr)-1)
+	found := make([]string, 0, len(idArr)-1)
+	for _, id := range idArr[1:] {
+		pvm := powervmdata
+		if id != instanceID {
+			pvm, err = client.Get(id)
+			if err != nil {
+				// A replicant deleted outside of Terraform is dropped from the ID, the count then plans its recreation
+				if message := strings.ToLower(err.Error()); strings.Contains(message, NotFound) || strings.Contains(message, "404") {
+					log.Printf("[WARN] replicant %s of instance %s was not found", id, instanceID)
+					continue
+				}
+				return diag.Errorf("failed to get replicant %s: %v", id, err)
+			}
+		}
+		replicants = append(replicants, flattenPIInstanceReplicant(pvm))
+		found = append(found, id)
+	}
+	if len(found) < len(idArr)-1 {
+		d.SetId(strings.Join(append([]string{cloudInstanceID}, found...), "/"))
+	}
+	d.Set(Attr_Replicants, replicants)
+	d.Set(Arg_Replicants, len(replicants))
 	return nil
 }
 
-func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-
-	name := d.Get(Arg_InstanceName).(string)
-	mem := d.Get(Arg_Memory).(float64)
-	procs := d.Get(Arg_Processors).(float64)
-	processortype := d.Get(Arg_ProcType).(string)
-	assignedVirtualCores := int64(d.Get(Arg_VirtualCoresAssigned).(int))
+func flattenPIInstanceReplicant(pvm *models.PVMInstance) map[string]interface{} {
+	networks := []map[string]interface{}{}
+	for _, n := range pvm.Networks {
+		if n != nil {
+			networks = append(networks, map[string]interface{}{
+				Attr_ExternalIP:  n.ExternalIP,
+				Attr_IPAddress:   n.IPAddress,
+				Attr_MacAddress:  n.MacAddress,
+				Attr_NetworkID:   n.NetworkID,
+				Attr_NetworkName: n.NetworkName,
+			})
+		}
+	}
+	replicant := map[string]interface{}{
+		Attr_InstanceID: *pvm.PvmInstanceID,
+		Attr_Networks:   networks,
+	}
+	if pvm.ServerName != nil {
+		replicant[Attr_Name] = *pvm.ServerName
+	}
+	if pvm.Status != nil {
+		replicant[Attr_Status] = *pvm.Status
+	}
+	if pvm.Health != nil {
+		replicant[Attr_HealthStatus] = pvm.Health.Status
+	}
+	return replicant
+}
 
+func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if d.Get(Attr_HealthStatus) == Warning {
 		return diag.Errorf("the operation cannot be performed when the lpar health in the WARNING State")
 	}
@@ -607,10 +676,12 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		return diag.Errorf("failed to get the session from the IBM Cloud Service")
 	}
 
-	cloudInstanceID, instanceID, err := splitID(d.Id())
+	idArr, err := flex.IdParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	cloudInstanceID := idArr[0]
+	instanceIDs := idArr[1:]
 
 	client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
 
@@ -622,33 +693,134 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 	}
 	cores_enabled := checkCloudInstanceCapability(cloudInstance, CUSTOM_VIRTUAL_CORES)
 
-	if d.HasChanges(Arg_InstanceName, Arg_VirtualOpticalDevice) {
+	// Replicants added by a scale up are created with the current configuration,
+	// so only the replicants that already existed need to be updated.
+	if d.HasChange(Arg_Replicants) {
+		instanceIDs, err = scalePIInstanceReplicants(ctx, d, sess, client, cloudInstanceID, instanceIDs)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// ResourceData is not safe for concurrent use, so every value is read before the
+	// replicants are updated concurrently.
+	changes := expandPIInstanceChanges(d)
+	count := d.Get(Arg_Replicants).(int)
+	scheme := d.Get(Arg_ReplicationScheme).(string)
+	instanceName := d.Get(Arg_InstanceName).(string)
+	err = forEachPIInstance(instanceIDs, func(index int, instanceID string) error {
+		name := replicantName(instanceName, scheme, index+1, count)
+		return updatePIInstance(ctx, changes, sess, client, cloudInstanceID, instanceID, name, cores_enabled)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPIInstanceRead(ctx, d, meta)
+}
+
+// piInstanceChanges holds the configuration and the changes of the resource that are applied to
+// every replicant.
+type piInstanceChanges struct {
+	memory                          float64
+	processors                      float64
+	procType                        string
+	virtualCoresAssigned            int64
+	virtualOpticalDevice            string
+	licenseRepositoryCapacity       int64
+	sapProfileID                    string
+	storagePoolAffinity             bool
+	placementGroupOld               string
+	placementGroupNew               string
+	ibmiCSS                         bool
+	ibmiPHA                         bool
+	ibmiRDSUsers                    int
+	instanceNameChanged             bool
+	virtualOpticalDeviceChanged     bool
+	procTypeChanged                 bool
+	virtualCoresAssignedChanged     bool
+	memoryOrProcessorsChanged       bool
+	licenseRepositoryCapacityChange bool
+	sapProfileIDChanged             bool
+	storagePoolAffinityChanged      bool
+	placementGroupChanged           bool
+	softwareLicensesChanged         bool
+	timeout                         time.Duration
+}
+
+// expandPIInstanceChanges reads the configuration and the changes of the resource.
+func expandPIInstanceChanges(d *schema.ResourceData) piInstanceChanges {
+	oldPG, newPG := d.GetChange(Arg_PlacementGroupID)
+	return piInstanceChanges{
+		memory:                          d.Get(Arg_Memory).(float64),
+		processors:                      d.Get(Arg_Processors).(float64),
+		procType:                        d.Get(Arg_ProcType).(string),
+		virtualCoresAssigned:            int64(d.Get(Arg_VirtualCoresAssigned).(int)),
+		virtualOpticalDevice:            d.Get(Arg_VirtualOpticalDevice).(string),
+		licenseRepositoryCapacity:       int64(d.Get(Arg_LicenseRepositoryCapacity).(int)),
+		sapProfileID:                    d.Get(Arg_SAPProfileID).(string),
+		storagePoolAffinity:             d.Get(Arg_StoragePoolAffinity).(bool),
+		placementGroupOld:               oldPG.(string),
+		placementGroupNew:               newPG.(string),
+		ibmiCSS:                         d.Get(Arg_IBMiCSS).(bool),
+		ibmiPHA:                         d.Get(Arg_IBMiPHA).(bool),
+		ibmiRDSUsers:                    d.Get(Arg_IBMiRDSUsers).(int),
+		instanceNameChanged:             d.HasChange(Arg_InstanceName),
+		virtualOpticalDeviceChanged:     d.HasChange(Arg_VirtualOpticalDevice),
+		procTypeChanged:                 d.HasChange(Arg_ProcType),
+		virtualCoresAssignedChanged:     d.HasChange(Arg_VirtualCoresAssigned),
+		memoryOrProcessorsChanged:       d.HasChange(Arg_Memory) || d.HasChange(Arg_Processors),
+		licenseRepositoryCapacityChange: d.HasChange(Arg_LicenseRepositoryCapacity),
+		sapProfileIDChanged:             d.HasChange(Arg_SAPProfileID),
+		storagePoolAffinityChanged:      d.HasChange(Arg_StoragePoolAffinity),
+		placementGroupChanged:           d.HasChange(Arg_PlacementGroupID),
+		softwareLicensesChanged:         d.HasChanges(Arg_IBMiCSS, Arg_IBMiPHA, Arg_IBMiRDSUsers),
+		timeout:                         d.Timeout(schema.TimeoutUpdate),
+	}
+}
+
+// updatePIInstance applies the changes of the resource configuration to a single replicant.
+// It is called concurrently for the replicants and must not access the ResourceData.
+func updatePIInstance(ctx context.Context, c piInstanceChanges, sess *ibmpisession.IBMPISession, client *instance.IBMPIInstanceClient, cloudInstanceID, instanceID, name string, cores_enabled bool) error {
+	mem := c.memory
+	procs := c.processors
+	processortype := c.procType
+	assignedVirtualCores := c.virtualCoresAssigned
+
+	pvm, err := client.Get(instanceID)
+	if err != nil {
+		return err
+	}
+	status := *pvm.Status
+
+	if c.instanceNameChanged || c.virtualOpticalDeviceChanged {
 		body := &models.PVMInstanceUpdate{}
-		if d.HasChange(Arg_InstanceName) {
+		if c.instanceNameChanged {
 			body.ServerName = name
 		}
-		if d.HasChange(Arg_VirtualOpticalDevice) {
-			body.CloudInitialization.VirtualOpticalDevice = d.Get(Arg_VirtualOpticalDevice).(string)
+		if c.virtualOpticalDeviceChanged {
+			body.CloudInitialization = &models.CloudInitialization{
+				VirtualOpticalDevice: c.virtualOpticalDevice,
+			}
 		}
 		_, err = client.Update(instanceID, body)
 		if err != nil {
-			return diag.Errorf("failed to update the lpar: %v", err)
+			return fmt.Errorf("failed to update the lpar: %v", err)
 		}
-		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, OK, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, OK, c.timeout)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 	}
 
-	if d.HasChange(Arg_ProcType) {
+	if c.procTypeChanged {
 		// Stop the lpar
-		status := d.Get(Attr_Status).(string)
 		if strings.ToLower(status) == State_Shutoff {
 			log.Printf("the lpar is in the shutoff state. Nothing to do . Moving on ")
 		} else {
-			err := stopLparForResourceChange(ctx, client, instanceID, d)
+			err := stopLparForResourceChange(ctx, client, instanceID, c.timeout)
 			if err != nil {
-				return diag.FromErr(err)
+				return err
 			}
 		}
 
@@ -663,40 +835,40 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 		_, err = client.Update(instanceID, updatebody)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
-		_, err = isWaitForPIInstanceStopped(ctx, client, instanceID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForPIInstanceStopped(ctx, client, instanceID, c.timeout)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 
 		// Start the lpar
-		err := startLparAfterResourceChange(ctx, client, instanceID, d)
+		err := startLparAfterResourceChange(ctx, client, instanceID, c.timeout)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 	}
 
 	// Virtual core will be updated only if service instance capability is enabled
-	if d.HasChange(Arg_VirtualCoresAssigned) {
+	if c.virtualCoresAssignedChanged {
 		body := &models.PVMInstanceUpdate{
 			VirtualCores: &models.VirtualCores{Assigned: &assignedVirtualCores},
 		}
 		_, err = client.Update(instanceID, body)
 		if err != nil {
-			return diag.Errorf("failed to update the lpar with the change for virtual cores: %v", err)
+			return fmt.Errorf("failed to update the lpar with the change for virtual cores: %v", err)
 		}
-		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, OK, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, OK, c.timeout)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 	}
 
 	// Start of the change for Memory and Processors
-	if d.HasChange(Arg_Memory) || d.HasChange(Arg_Processors) {
+	if c.memoryOrProcessorsChanged {
 
-		maxMemLpar := d.Get(Attr_MaxMemory).(float64)
-		maxCPULpar := d.Get(Attr_MaxProcessors).(float64)
+		maxMemLpar := pvm.Maxmem
+		maxCPULpar := pvm.Maxproc
 
 		if mem > maxMemLpar || procs > maxCPULpar {
 			log.Printf("Will require a shutdown to perform the change")
@@ -705,13 +877,13 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 			log.Printf("maxCPULpar is set to %f", maxCPULpar)
 		}
 
-		instanceState := d.Get(Attr_Status).(string)
+		instanceState := status
 		log.Printf("the instance state is %s", instanceState)
 
 		if (mem > maxMemLpar || procs > maxCPULpar) && strings.ToLower(instanceState) != State_Shutoff {
-			err = performChangeAndReboot(ctx, client, d, instanceID, mem, procs)
+			err = performChangeAndReboot(ctx, client, c.timeout, instanceID, mem, procs)
 			if err != nil {
-				return diag.FromErr(err)
+				return err
 			}
 
 		} else {
@@ -728,17 +900,17 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 
 			_, err = client.Update(instanceID, body)
 			if err != nil {
-				return diag.Errorf("failed to update the lpar with the change %v", err)
+				return fmt.Errorf("failed to update the lpar with the change %v", err)
 			}
 			if strings.ToLower(instanceState) == State_Shutoff {
-				_, err = isWaitforPIInstanceUpdate(ctx, client, instanceID, d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitforPIInstanceUpdate(ctx, client, instanceID, c.timeout)
 				if err != nil {
-					return diag.FromErr(err)
+					return err
 				}
 			} else {
-				_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, Arg_HealthStatus, d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, Arg_HealthStatus, c.timeout)
 				if err != nil {
-					return diag.FromErr(err)
+					return err
 				}
 			}
 		}
@@ -746,73 +918,71 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 
 	// License repository capacity will be updated only if service instance is a vtl instance
 	// might need to check if lrc was set
-	if d.HasChange(Arg_LicenseRepositoryCapacity) {
-		lrc := d.Get(Arg_LicenseRepositoryCapacity).(int64)
+	if c.licenseRepositoryCapacityChange {
+		lrc := c.licenseRepositoryCapacity
 		body := &models.PVMInstanceUpdate{
 			LicenseRepositoryCapacity: lrc,
 		}
 		_, err = client.Update(instanceID, body)
 		if err != nil {
-			return diag.Errorf("failed to update the lpar with the change for license repository capacity %s", err)
+			return fmt.Errorf("failed to update the lpar with the change for license repository capacity %s", err)
 		}
-		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, OK, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, OK, c.timeout)
 		if err != nil {
-			diag.FromErr(err)
+			return err
 		}
 	}
 
-	if d.HasChange(Arg_SAPProfileID) {
+	if c.sapProfileIDChanged {
 		// Stop the lpar
-		status := d.Get(Attr_Status).(string)
 		if strings.ToLower(status) == State_Shutoff {
 			log.Printf("the lpar is in the shutoff state. Nothing to do... Moving on ")
 		} else {
-			err := stopLparForResourceChange(ctx, client, instanceID, d)
+			err := stopLparForResourceChange(ctx, client, instanceID, c.timeout)
 			if err != nil {
-				return diag.FromErr(err)
+				return err
 			}
 		}
 
 		// Update the profile id
-		profileID := d.Get(Arg_SAPProfileID).(string)
+		profileID := c.sapProfileID
 		body := &models.PVMInstanceUpdate{
 			SapProfileID: profileID,
 		}
 		_, err = client.Update(instanceID, body)
 		if err != nil {
-			return diag.Errorf("failed to update the lpar with the change for sap profile: %v", err)
+			return fmt.Errorf("failed to update the lpar with the change for sap profile: %v", err)
 		}
 
 		// Wait for the resize to complete and status to reset
-		_, err = isWaitForPIInstanceStopped(ctx, client, instanceID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForPIInstanceStopped(ctx, client, instanceID, c.timeout)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 
 		// Start the lpar
-		err := startLparAfterResourceChange(ctx, client, instanceID, d)
+		err := startLparAfterResourceChange(ctx, client, instanceID, c.timeout)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 	}
-	if d.HasChange(Arg_StoragePoolAffinity) {
-		storagePoolAffinity := d.Get(Arg_StoragePoolAffinity).(bool)
+	if c.storagePoolAffinityChanged {
+		storagePoolAffinity := c.storagePoolAffinity
 		body := &models.PVMInstanceUpdate{
 			StoragePoolAffinity: &storagePoolAffinity,
 		}
 		// This is a synchronous process hence no need to check for health status
 		_, err = client.Update(instanceID, body)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 	}
 
-	if d.HasChange(Arg_PlacementGroupID) {
+	if c.placementGroupChanged {
 		pgClient := instance.NewIBMPIPlacementGroupClient(ctx, sess, cloudInstanceID)
 
-		oldRaw, newRaw := d.GetChange(Arg_PlacementGroupID)
-		old := oldRaw.(string)
-		new := newRaw.(string)
+		old := c.placementGroupOld
+		new := c.placementGroupNew
 
 		if len(strings.TrimSpace(old)) > 0 {
 			placementGroupID := old
@@ -824,12 +994,12 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 			if err != nil {
 				// ignore delete member error where the server is already not in the PG
 				if !strings.Contains(err.Error(), "is not part of placement-group") {
-					return diag.FromErr(err)
+					return err
 				}
 			} else {
-				_, err = isWaitForPIInstancePlacementGroupDelete(ctx, pgClient, *pgID.ID, instanceID, d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitForPIInstancePlacementGroupDelete(ctx, pgClient, *pgID.ID, instanceID, c.timeout)
 				if err != nil {
-					return diag.FromErr(err)
+					return err
 				}
 			}
 		}
@@ -842,32 +1012,31 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 			}
 			pgID, err := pgClient.AddMember(placementGroupID, body)
 			if err != nil {
-				return diag.FromErr(err)
+				return err
 			} else {
-				_, err = isWaitForPIInstancePlacementGroupAdd(ctx, pgClient, *pgID.ID, instanceID, d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitForPIInstancePlacementGroupAdd(ctx, pgClient, *pgID.ID, instanceID, c.timeout)
 				if err != nil {
-					return diag.FromErr(err)
+					return err
 				}
 			}
 		}
 	}
-	if d.HasChanges(Arg_IBMiCSS, Arg_IBMiPHA, Arg_IBMiRDSUsers) {
-		status := d.Get(Attr_Status).(string)
+	if c.softwareLicensesChanged {
 		if strings.ToLower(status) == State_Active {
 			log.Printf("the lpar is in the Active state, continuing with update")
 		} else {
-			_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, OK, d.Timeout(schema.TimeoutUpdate))
+			_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, OK, c.timeout)
 			if err != nil {
-				return diag.FromErr(err)
+				return err
 			}
 		}
 
 		sl := &models.SoftwareLicenses{}
-		sl.IbmiCSS = flex.PtrToBool(d.Get(Arg_IBMiCSS).(bool))
-		sl.IbmiPHA = flex.PtrToBool(d.Get(Arg_IBMiPHA).(bool))
-		ibmrdsUsers := d.Get(Arg_IBMiRDSUsers).(int)
+		sl.IbmiCSS = flex.PtrToBool(c.ibmiCSS)
+		sl.IbmiPHA = flex.PtrToBool(c.ibmiPHA)
+		ibmrdsUsers := c.ibmiRDSUsers
 		if ibmrdsUsers < 0 {
-			return diag.Errorf("request with  IBM i Rational Dev Studio property requires IBM i Rational Dev Studio number of users")
+			return fmt.Errorf("request with  IBM i Rational Dev Studio property requires IBM i Rational Dev Studio number of users")
 		}
 		sl.IbmiRDS = flex.PtrToBool(ibmrdsUsers > 0)
 		sl.IbmiRDSUsers = int64(ibmrdsUsers)
@@ -875,14 +1044,14 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		updatebody := &models.PVMInstanceUpdate{SoftwareLicenses: sl}
 		_, err = client.Update(instanceID, updatebody)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
-		_, err = isWaitForPIInstanceSoftwareLicenses(ctx, client, instanceID, sl, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForPIInstanceSoftwareLicenses(ctx, client, instanceID, sl, c.timeout)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 	}
-	return resourceIBMPIInstanceRead(ctx, d, meta)
+	return nil
 }
 
 func resourceIBMPIInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -898,22 +1067,172 @@ func resourceIBMPIInstanceDelete(ctx context.Context, d *schema.ResourceData, me
 
 	cloudInstanceID := idArr[0]
 	client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
-	for _, instanceID := range idArr[1:] {
-		err = client.Delete(instanceID)
+	err = deletePIInstanceReplicants(ctx, client, idArr[1:], d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// deletePIInstanceReplicants deletes the replicants concurrently and waits for all of them to be gone.
+func deletePIInstanceReplicants(ctx context.Context, client *instance.IBMPIInstanceClient, instanceIDs []string, timeout time.Duration) error {
+	return forEachPIInstance(instanceIDs, func(_ int, instanceID string) error {
+		err := client.Delete(instanceID)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
+		_, err = isWaitForPIInstanceDeleted(ctx, client, instanceID, timeout)
+		return err
+	})
+}
+
+// waitForPIInstancesCreated waits for newly provisioned replicants to be ready and then applies
+// the settings that can only be set after provisioning, for all replicants concurrently.
+func waitForPIInstancesCreated(ctx context.Context, client *instance.IBMPIInstanceClient, d *schema.ResourceData, instanceIDs []string, timeout time.Duration) error {
+	var instanceReadyStatus string
+	if r, ok := d.GetOk(Arg_HealthStatus); ok {
+		instanceReadyStatus = r.(string)
 	}
+	dt, _ := d.GetOk(Arg_DeploymentType)
+	storagePoolAffinity := d.Get(Arg_StoragePoolAffinity).(bool)
+	vod, vodOk := d.GetOk(Arg_VirtualOpticalDevice)
 
-	for _, instanceID := range idArr[1:] {
-		_, err = isWaitForPIInstanceDeleted(ctx, client, instanceID, d.Timeout(schema.TimeoutUpdate))
+	return forEachPIInstance(instanceIDs, func(_ int, instanceID string) error {
+		var err error
+		if dt == DeploymentTypeVMNoStorage {
+			_, err = isWaitForPIInstanceShutoff(ctx, client, instanceID, instanceReadyStatus, timeout)
+		} else {
+			_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, instanceReadyStatus, timeout)
+		}
 		if err != nil {
-			return diag.FromErr(err)
+			return err
+		}
+
+		// If Storage Pool Affinity is given as false we need to update the vm instance.
+		// Default value is true which indicates that all volumes attached to the server
+		// must reside in the same storage pool.
+		if !storagePoolAffinity {
+			body := &models.PVMInstanceUpdate{
+				StoragePoolAffinity: &storagePoolAffinity,
+			}
+			// This is a synchronous process hence no need to check for health status
+			_, err = client.Update(instanceID, body)
+			if err != nil {
+				return err
+			}
 		}
+		// If virtual optical device provided then update cloud initialization
+		if vodOk {
+			body := &models.PVMInstanceUpdate{
+				CloudInitialization: &models.CloudInitialization{
+					VirtualOpticalDevice: vod.(string),
+				},
+			}
+			_, err = client.Update(instanceID, body)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// scalePIInstanceReplicants adds or removes replicants so that their number matches pi_replicants.
+// Replicants are removed newest first and the existing ones are never recreated. It returns the ids
+// of the replicants that existed before the change and are kept.
+func scalePIInstanceReplicants(ctx context.Context, d *schema.ResourceData, sess *ibmpisession.IBMPISession, client *instance.IBMPIInstanceClient, cloudInstanceID string, instanceIDs []string) ([]string, error) {
+	count := d.Get(Arg_Replicants).(int)
+
+	if count < len(instanceIDs) {
+		kept, removed := instanceIDs[:count], instanceIDs[count:]
+		deleted := make([]bool, len(removed))
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		err := forEachPIInstance(removed, func(index int, instanceID string) error {
+			err := client.Delete(instanceID)
+			if err != nil {
+				return err
+			}
+			deleted[index] = true
+			_, err = isWaitForPIInstanceDeleted(ctx, client, instanceID, timeout)
+			return err
+		})
+		// keep track of the replicants that could not be deleted
+		remaining := append([]string{cloudInstanceID}, kept...)
+		for i, instanceID := range removed {
+			if !deleted[i] {
+				remaining = append(remaining, instanceID)
+			}
+		}
+		d.SetId(strings.Join(remaining, "/"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to remove replicants: %v", err)
+		}
+		return kept, nil
 	}
 
-	d.SetId("")
-	return nil
+	imageClient := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
+	sapClient := instance.NewIBMPISAPInstanceClient(ctx, sess, cloudInstanceID)
+	name := d.Get(Arg_InstanceName).(string)
+	scheme := d.Get(Arg_ReplicationScheme).(string)
+
+	var created []string
+	var err error
+	for i := len(instanceIDs); i < count; i++ {
+		var pvmList *models.PVMInstanceList
+		if _, ok := d.GetOk(Arg_SAPProfileID); ok {
+			pvmList, err = createSAPInstance(d, sapClient, replicantName(name, scheme, i+1, count), 1)
+		} else {
+			pvmList, err = createPVMInstance(d, client, imageClient, replicantName(name, scheme, i+1, count), 1)
+		}
+		if err != nil {
+			break
+		}
+		for _, pvm := range *pvmList {
+			created = append(created, *pvm.PvmInstanceID)
+		}
+	}
+	// record every replicant that was provisioned, even if a later one failed
+	d.SetId(strings.Join(append(append([]string{cloudInstanceID}, instanceIDs...), created...), "/"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to add replicants: %v", err)
+	}
+
+	err = waitForPIInstancesCreated(ctx, client, d, created, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return nil, err
+	}
+	return instanceIDs, nil
+}
+
+// replicantName returns the server name of the replicant at the given 1-based position, following
+// the naming scheme that the service applies when provisioning several replicants at once.
+func replicantName(name, scheme string, position, count int) string {
+	if count <= 1 {
+		return name
+	}
+	if scheme == Prefix {
+		return fmt.Sprintf("%d-%s", position, name)
+	}
+	return fmt.Sprintf("%s-%d", name, position)
+}
+
+// forEachPIInstance runs f concurrently for every instance and returns the errors of all failed calls.
+func forEachPIInstance(instanceIDs []string, f func(index int, instanceID string) error) error {
+	var wg sync.WaitGroup
+	errs := make([]error, len(instanceIDs))
+	for i, instanceID := range instanceIDs {
+		wg.Add(1)
+		go func(i int, instanceID string) {
+			defer wg.Done()
+			if err := f(i, instanceID); err != nil {
+				errs[i] = fmt.Errorf("[%s] %w", instanceID, err)
+			}
+		}(i, instanceID)
+	}
+	wg.Wait()
+	return errors.Join(errs...)
 }
 
 func isWaitForPIInstanceDeleted(ctx context.Context, client *instance.IBMPIInstanceClient, id string, timeout time.Duration) (interface{}, error) {
@@ -1184,7 +1503,7 @@ func isPIInstanceRefreshFuncOff(client *instance.IBMPIInstanceClient, id string)
 	}
 }
 
-func stopLparForResourceChange(ctx context.Context, client *instance.IBMPIInstanceClient, id string, d *schema.ResourceData) error {
+func stopLparForResourceChange(ctx context.Context, client *instance.IBMPIInstanceClient, id string, timeout time.Duration) error {
 	body := &models.PVMInstanceAction{
 		//Action: flex.PtrToString("stop"),
 		Action: flex.PtrToString(Action_ImmediateShutdown),
@@ -1194,13 +1513,13 @@ func stopLparForResourceChange(ctx context.Context, client *instance.IBMPIInstan
 		return fmt.Errorf("failed to perform the stop action on the pvm instance %v", err)
 	}
 
-	_, err = isWaitForPIInstanceStopped(ctx, client, id, d.Timeout(schema.TimeoutUpdate))
+	_, err = isWaitForPIInstanceStopped(ctx, client, id, timeout)
 
 	return err
 }
 
 // Start the lpar
-func startLparAfterResourceChange(ctx context.Context, client *instance.IBMPIInstanceClient, id string, d *schema.ResourceData) error {
+func startLparAfterResourceChange(ctx context.Context, client *instance.IBMPIInstanceClient, id string, timeout time.Duration) error {
 	body := &models.PVMInstanceAction{
 		Action: flex.PtrToString(Action_Start),
 	}
@@ -1209,13 +1528,13 @@ func startLparAfterResourceChange(ctx context.Context, client *instance.IBMPIIns
 		return fmt.Errorf("failed to perform the start action on the pvm instance %v", err)
 	}
 
-	_, err = isWaitForPIInstanceAvailable(ctx, client, id, OK, d.Timeout(schema.TimeoutUpdate))
+	_, err = isWaitForPIInstanceAvailable(ctx, client, id, OK, timeout)
 
 	return err
 }
 
 // Stop / Modify / Start only when the lpar is off limits
-func performChangeAndReboot(ctx context.Context, client *instance.IBMPIInstanceClient, d *schema.ResourceData, id string, mem, procs float64) error {
+func performChangeAndReboot(ctx context.Context, client *instance.IBMPIInstanceClient, timeout time.Duration, id string, mem, procs float64) error {
 	/*
 		These are the steps
 		1. Stop the lpar - Check if the lpar is SHUTOFF
@@ -1226,7 +1545,7 @@ func performChangeAndReboot(ctx context.Context, client *instance.IBMPIInstanceC
 	//Execute the stop
 
 	log.Printf("Calling the stop lpar for Resource Change code ..")
-	err := stopLparForResourceChange(ctx, client, id, d)
+	err := stopLparForResourceChange(ctx, client, id, timeout)
 	if err != nil {
 		return err
 	}
@@ -1241,14 +1560,14 @@ func performChangeAndReboot(ctx context.Context, client *instance.IBMPIInstanceC
 		return fmt.Errorf("failed to update the lpar with the change, %s", updateErr)
 	}
 
-	_, err = isWaitforPIInstanceUpdate(ctx, client, id, d.Timeout(schema.TimeoutUpdate))
+	_, err = isWaitforPIInstanceUpdate(ctx, client, id, timeout)
 	if err != nil {
 		return fmt.Errorf("failed to get an update from the Service after the resource change, %s", err)
 	}
 
 	// Now we can start the lpar
 	log.Printf("Calling the start lpar After the  Resource Change code ..")
-	err = startLparAfterResourceChange(ctx, client, id, d)
+	err = startLparAfterResourceChange(ctx, client, id, timeout)
 	if err != nil {
 		return err
 	}
@@ -1313,18 +1632,14 @@ func checkCloudInstanceCapability(cloudInstance *models.CloudInstance, custom_ca
 	return false
 }
 
-func createSAPInstance(d *schema.ResourceData, sapClient *instance.IBMPISAPInstanceClient) (*models.PVMInstanceList, error) {
+func createSAPInstance(d *schema.ResourceData, sapClient *instance.IBMPISAPInstanceClient, name string, count int) (*models.PVMInstanceList, error) {
 
-	name := d.Get(Arg_InstanceName).(string)
 	profileID := d.Get(Arg_SAPProfileID).(string)
 	imageid := d.Get(Arg_ImageID).(string)
 
 	pvmNetworks := expandPVMNetworks(d.Get(Arg_Network).([]interface{}))
 
-	var replicants int64
-	if r, ok := d.GetOk(Arg_Replicants); ok {
-		replicants = int64(r.(int))
-	}
+	replicants := int64(count)
 	var replicationpolicy string
 	if r, ok := d.GetOk(Arg_ReplicationPolicy); ok {
 		replicationpolicy = r.(string)
@@ -1427,9 +1742,8 @@ func createSAPInstance(d *schema.ResourceData, sapClient *instance.IBMPISAPInsta
 	return pvmList, nil
 }
 
-func createPVMInstance(d *schema.ResourceData, client *instance.IBMPIInstanceClient, imageClient *instance.IBMPIImageClient) (*models.PVMInstanceList, error) {
+func createPVMInstance(d *schema.ResourceData, client *instance.IBMPIInstanceClient, imageClient *instance.IBMPIImageClient, name string, count int) (*models.PVMInstanceList, error) {
 
-	name := d.Get(Arg_InstanceName).(string)
 	imageid := d.Get(Arg_ImageID).(string)
 
 	var mem, procs float64
@@ -1461,10 +1775,7 @@ func createPVMInstance(d *schema.ResourceData, client *instance.IBMPIInstanceCli
 	if v, ok := d.GetOk(Arg_VolumeIDs); ok {
 		volids = flex.ExpandStringList((v.(*schema.Set)).List())
 	}
-	var replicants float64
-	if r, ok := d.GetOk(Arg_Replicants); ok {
-		replicants = float64(r.(int))
-	}
+	replicants := float64(count)
 	var replicationpolicy string
 	if r, ok := d.GetOk(Arg_ReplicationPolicy); ok {
 		replicationpolicy = r.(string)
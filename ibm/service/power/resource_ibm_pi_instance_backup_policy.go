@@ -0,0 +1,650 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const bothDestination string = "both"
+
+// backupTimeLayout is the layout of the timestamp suffix of the backups created by a policy.
+const backupTimeLayout = "20060102150405"
+
+func ResourceIBMPIInstanceBackupPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIInstanceBackupPolicyCreate,
+		ReadContext:   resourceIBMPIInstanceBackupPolicyRead,
+		UpdateContext: resourceIBMPIInstanceBackupPolicyUpdate,
+		DeleteContext: resourceIBMPIInstanceBackupPolicyDelete,
+		CustomizeDiff: resourceIBMPIInstanceBackupPolicyCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_BackupType: {
+				Description:  "The type of the backups taken by the policy, either snapshot or capture.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{Capture, Snapshot}, false),
+			},
+			Arg_CaptureCloudStorageAccessKey: {
+				Description: "The cloud object storage access key; required when the capture destination is cloud-storage or both.",
+				Optional:    true,
+				Sensitive:   true,
+				Type:        schema.TypeString,
+			},
+			Arg_CaptureCloudStorageRegion: {
+				Description: "The cloud object storage region; required when the capture destination is cloud-storage or both.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_CaptureCloudStorageSecretKey: {
+				Description: "The cloud object storage secret key; required when the capture destination is cloud-storage or both.",
+				Optional:    true,
+				Sensitive:   true,
+				Type:        schema.TypeString,
+			},
+			Arg_CaptureDestination: {
+				Default:      imageCatalogDestination,
+				Description:  "The destination of the captures, either image-catalog, cloud-storage or both.",
+				ForceNew:     true,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{imageCatalogDestination, cloudStorageDestination, bothDestination}, false),
+			},
+			Arg_CaptureStorageImagePath: {
+				Description: "The cloud object storage image path (bucket-name [/folder/../..]); required when the capture destination is cloud-storage or both.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_InstanceIDs: {
+				Description: "The IDs of the instances protected by the policy.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				MinItems:    1,
+				Required:    true,
+				Set:         schema.HashString,
+				Type:        schema.TypeSet,
+			},
+			Arg_IntervalHours: {
+				Description:  "The minimum number of hours between two backups of an instance.",
+				Required:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			Arg_Name: {
+				Description:  "The name of the policy, used as the name prefix of its backups.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_PruneOnDelete: {
+				Default:     false,
+				Description: "Indicates whether the backups of the policy are deleted when the policy is destroyed.",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			Arg_Retention: {
+				Description:  "The number of backups kept for each instance and destination; older backups are deleted.",
+				Required:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			// Attributes
+			Attr_Inventory: {
+				Computed:    true,
+				Description: "The backups of the policy, newest first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_BackupID: {
+							Computed:    true,
+							Description: "The ID of the snapshot, the ID of the image or the key of the cloud object storage object.",
+							Type:        schema.TypeString,
+						},
+						Attr_CreationDate: {
+							Computed:    true,
+							Description: "The date the backup was taken.",
+							Type:        schema.TypeString,
+						},
+						Attr_Destination: {
+							Computed:    true,
+							Description: "The destination of the backup, either snapshot, image-catalog or cloud-storage.",
+							Type:        schema.TypeString,
+						},
+						Attr_InstanceID: {
+							Computed:    true,
+							Description: "The ID of the instance.",
+							Type:        schema.TypeString,
+						},
+						Attr_Name: {
+							Computed:    true,
+							Description: "The name of the backup.",
+							Type:        schema.TypeString,
+						},
+						Attr_Status: {
+							Computed:    true,
+							Description: "The status of the backup.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
+			Attr_NextRun: {
+				Computed:    true,
+				Description: "The time after which the next apply takes a new backup.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+// piInstanceBackup is a snapshot, an image or a cloud object storage object taken by a policy.
+type piInstanceBackup struct {
+	created     time.Time
+	destination string
+	id          string
+	instanceID  string
+	name        string
+	status      string
+}
+
+func resourceIBMPIInstanceBackupPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	name := d.Get(Arg_Name).(string)
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, name))
+
+	err = reconcilePIInstanceBackupPolicy(ctx, d, meta, sess, cloudInstanceID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPIInstanceBackupPolicyRead(ctx, d, meta)
+}
+
+func resourceIBMPIInstanceBackupPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	cloudInstanceID := parts[0]
+
+	backups, err := listPIInstanceBackups(ctx, d, meta, sess, cloudInstanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Arg_Name, parts[1])
+	d.Set(Attr_Inventory, flattenPIInstanceBackups(backups))
+	d.Set(Attr_NextRun, nextPIInstanceBackupRun(d, backups).Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceIBMPIInstanceBackupPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = reconcilePIInstanceBackupPolicy(ctx, d, meta, sess, parts[0], d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPIInstanceBackupPolicyRead(ctx, d, meta)
+}
+
+func resourceIBMPIInstanceBackupPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.Get(Arg_PruneOnDelete).(bool) {
+		log.Printf("[DEBUG] keeping the backups of policy %s", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	cloudInstanceID := parts[0]
+
+	backups, err := listPIInstanceBackups(ctx, d, meta, sess, cloudInstanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, backup := range backups {
+		err = deletePIInstanceBackup(ctx, d, meta, sess, cloudInstanceID, backup, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMPIInstanceBackupPolicyCustomizeDiff plans an update once the next run of the policy is due.
+func resourceIBMPIInstanceBackupPolicyCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	nextRun, err := time.Parse(time.RFC3339, diff.Get(Attr_NextRun).(string))
+	if err != nil || !time.Now().Before(nextRun) {
+		log.Printf("[DEBUG] backup policy %s is due", diff.Id())
+		if err := diff.SetNewComputed(Attr_Inventory); err != nil {
+			return err
+		}
+		return diff.SetNewComputed(Attr_NextRun)
+	}
+
+	return nil
+}
+
+// reconcilePIInstanceBackupPolicy takes a backup of every instance whose latest backup is older
+// than the interval of the policy, then deletes the backups beyond the retention of the policy.
+func reconcilePIInstanceBackupPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}, sess *ibmpisession.IBMPISession, cloudInstanceID string, timeout time.Duration) error {
+	if err := validatePIInstanceBackupCapture(d); err != nil {
+		return err
+	}
+
+	backups, err := listPIInstanceBackups(ctx, d, meta, sess, cloudInstanceID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	interval := time.Duration(d.Get(Arg_IntervalHours).(int)) * time.Hour
+	latest := latestPIInstanceBackups(backups)
+	instanceIDs := flex.ExpandStringList(d.Get(Arg_InstanceIDs).(*schema.Set).List())
+	sort.Strings(instanceIDs)
+	for _, instanceID := range instanceIDs {
+		if last, ok := latest[instanceID]; ok && now.Before(last.Add(interval)) {
+			log.Printf("[DEBUG] instance %s was backed up at %s", instanceID, last)
+			continue
+		}
+		err = createPIInstanceBackup(ctx, d, sess, cloudInstanceID, instanceID, now, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	backups, err = listPIInstanceBackups(ctx, d, meta, sess, cloudInstanceID)
+	if err != nil {
+		return err
+	}
+	for _, backup := range expiredPIInstanceBackups(backups, d.Get(Arg_Retention).(int)) {
+		err = deletePIInstanceBackup(ctx, d, meta, sess, cloudInstanceID, backup, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validatePIInstanceBackupCapture(d *schema.ResourceData) error {
+	if d.Get(Arg_BackupType).(string) != Capture || d.Get(Arg_CaptureDestination).(string) == imageCatalogDestination {
+		return nil
+	}
+	for _, arg := range []string{Arg_CaptureCloudStorageAccessKey, Arg_CaptureCloudStorageRegion, Arg_CaptureCloudStorageSecretKey, Arg_CaptureStorageImagePath} {
+		if _, ok := d.GetOk(arg); !ok {
+			return fmt.Errorf("%s is required when capture destination is %s", arg, d.Get(Arg_CaptureDestination).(string))
+		}
+	}
+	return nil
+}
+
+// piInstanceBackupPrefix returns the name prefix of the backups of an instance taken by the policy.
+func piInstanceBackupPrefix(name, instanceID string) string {
+	if len(instanceID) > 8 {
+		instanceID = instanceID[:8]
+	}
+	return fmt.Sprintf("%s-%s-", name, instanceID)
+}
+
+// parsePIInstanceBackupName returns the time a backup was taken from its name, or false if the
+// backup does not belong to the instance.
+func parsePIInstanceBackupName(name, prefix string) (time.Time, bool) {
+	suffix, ok := strings.CutPrefix(name, prefix)
+	if !ok || len(suffix) < len(backupTimeLayout) {
+		return time.Time{}, false
+	}
+	created, err := time.Parse(backupTimeLayout, suffix[:len(backupTimeLayout)])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return created, true
+}
+
+func listPIInstanceBackups(ctx context.Context, d *schema.ResourceData, meta interface{}, sess *ibmpisession.IBMPISession, cloudInstanceID string) ([]piInstanceBackup, error) {
+	name := d.Get(Arg_Name).(string)
+	instanceIDs := flex.ExpandStringList(d.Get(Arg_InstanceIDs).(*schema.Set).List())
+	backups := []piInstanceBackup{}
+
+	if d.Get(Arg_BackupType).(string) == Snapshot {
+		client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+		for _, instanceID := range instanceIDs {
+			snapshots, err := client.GetSnapShotVM(instanceID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list the snapshots of instance %s: %v", instanceID, err)
+			}
+			prefix := piInstanceBackupPrefix(name, instanceID)
+			for _, snapshot := range snapshots.Snapshots {
+				if snapshot.Name == nil || snapshot.SnapshotID == nil {
+					continue
+				}
+				if created, ok := parsePIInstanceBackupName(*snapshot.Name, prefix); ok {
+					backups = append(backups, piInstanceBackup{
+						created:     created,
+						destination: Snapshot,
+						id:          *snapshot.SnapshotID,
+						instanceID:  instanceID,
+						name:        *snapshot.Name,
+						status:      snapshot.Status,
+					})
+				}
+			}
+		}
+		return sortPIInstanceBackups(backups), nil
+	}
+
+	destination := d.Get(Arg_CaptureDestination).(string)
+	if destination != cloudStorageDestination {
+		client := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
+		images, err := client.GetAll()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the images: %v", err)
+		}
+		for _, image := range images.Images {
+			if image.Name == nil || image.ImageID == nil {
+				continue
+			}
+			for _, instanceID := range instanceIDs {
+				if created, ok := parsePIInstanceBackupName(*image.Name, piInstanceBackupPrefix(name, instanceID)); ok {
+					backups = append(backups, piInstanceBackup{
+						created:     created,
+						destination: imageCatalogDestination,
+						id:          *image.ImageID,
+						instanceID:  instanceID,
+						name:        *image.Name,
+						status:      aws.StringValue(image.State),
+					})
+				}
+			}
+		}
+	}
+	if destination != imageCatalogDestination {
+		client, bucket, folder, err := piInstanceBackupCOSClient(d, meta)
+		if err != nil {
+			return nil, err
+		}
+		for _, instanceID := range instanceIDs {
+			prefix := piInstanceBackupPrefix(name, instanceID)
+			input := &s3.ListObjectsV2Input{
+				Bucket: aws.String(bucket),
+				Prefix: aws.String(folder + prefix),
+			}
+			err = client.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+				for _, object := range page.Contents {
+					key := aws.StringValue(object.Key)
+					if created, ok := parsePIInstanceBackupName(strings.TrimPrefix(key, folder), prefix); ok {
+						backups = append(backups, piInstanceBackup{
+							created:     created,
+							destination: cloudStorageDestination,
+							id:          key,
+							instanceID:  instanceID,
+							name:        strings.TrimPrefix(key, folder),
+							status:      State_Available,
+						})
+					}
+				}
+				return true
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list the objects of bucket %s: %v", bucket, err)
+			}
+		}
+	}
+
+	return sortPIInstanceBackups(backups), nil
+}
+
+// piInstanceBackupCOSClient returns a client for the cloud object storage the captures are
+// exported to, along with the bucket and the key prefix of the image path. The endpoint follows
+// the region of the capture and the visibility configured for the provider.
+func piInstanceBackupCOSClient(d *schema.ResourceData, meta interface{}) (*s3.S3, string, string, error) {
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	region := d.Get(Arg_CaptureCloudStorageRegion).(string)
+	accessKey := d.Get(Arg_CaptureCloudStorageAccessKey).(string)
+	secretKey := d.Get(Arg_CaptureCloudStorageSecretKey).(string)
+	bucket, folder, _ := strings.Cut(strings.Trim(d.Get(Arg_CaptureStorageImagePath).(string), "/"), "/")
+	if bucket == "" {
+		return nil, "", "", fmt.Errorf("%s must start with a bucket name", Arg_CaptureStorageImagePath)
+	}
+	if folder != "" {
+		folder = folder + "/"
+	}
+
+	visibility := "public"
+	endpoint := fmt.Sprintf("s3.%s.cloud-object-storage.appdomain.cloud", region)
+	if bxSession.Config.Visibility == "private" || bxSession.Config.Visibility == "public-and-private" {
+		visibility = "private"
+		endpoint = fmt.Sprintf("s3.private.%s.cloud-object-storage.appdomain.cloud", region)
+	}
+	endpoint = conns.FileFallBack(bxSession.Config.EndpointsFile, visibility, "IBMCLOUD_COS_ENDPOINT", region, endpoint)
+	endpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, endpoint)
+
+	s3Conf := aws.NewConfig().WithEndpoint(endpoint).WithCredentials(credentials.NewStaticCredentials(accessKey, secretKey, "")).WithS3ForcePathStyle(true)
+	s3Sess, err := session.NewSession()
+	if err != nil {
+		return nil, "", "", fmt.Errorf("failed to create the cloud object storage session: %v", err)
+	}
+	return s3.New(s3Sess, s3Conf), bucket, folder, nil
+}
+
+func createPIInstanceBackup(ctx context.Context, d *schema.ResourceData, sess *ibmpisession.IBMPISession, cloudInstanceID, instanceID string, now time.Time, timeout time.Duration) error {
+	name := piInstanceBackupPrefix(d.Get(Arg_Name).(string), instanceID) + now.Format(backupTimeLayout)
+	description := fmt.Sprintf("Backup of instance %s taken by policy %s", instanceID, d.Get(Arg_Name).(string))
+	client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+
+	if d.Get(Arg_BackupType).(string) == Snapshot {
+		log.Printf("[DEBUG] taking snapshot %s of instance %s", name, instanceID)
+		snapshot, err := client.CreatePvmSnapShot(instanceID, &models.SnapshotCreate{Name: &name, Description: description})
+		if err != nil {
+			return fmt.Errorf("failed to take snapshot %s of instance %s: %v", name, instanceID, err)
+		}
+		snapshotClient := instance.NewIBMPISnapshotClient(ctx, sess, cloudInstanceID)
+		_, err = isWaitForPIInstanceSnapshotAvailable(ctx, snapshotClient, *snapshot.SnapshotID, timeout)
+		return err
+	}
+
+	destination := d.Get(Arg_CaptureDestination).(string)
+	body := &models.PVMInstanceCapture{
+		CaptureDestination: &destination,
+		CaptureName:        &name,
+	}
+	if destination != imageCatalogDestination {
+		body.CloudStorageAccessKey = d.Get(Arg_CaptureCloudStorageAccessKey).(string)
+		body.CloudStorageImagePath = d.Get(Arg_CaptureStorageImagePath).(string)
+		body.CloudStorageRegion = d.Get(Arg_CaptureCloudStorageRegion).(string)
+		body.CloudStorageSecretKey = d.Get(Arg_CaptureCloudStorageSecretKey).(string)
+	}
+	log.Printf("[DEBUG] capturing instance %s to %s as %s", instanceID, destination, name)
+	job, err := client.CaptureInstanceToImageCatalogV2(instanceID, body)
+	if err != nil {
+		return fmt.Errorf("failed to capture instance %s as %s: %v", instanceID, name, err)
+	}
+	jobClient := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
+	_, err = waitForIBMPIJobCompleted(ctx, jobClient, *job.ID, timeout)
+	return err
+}
+
+func deletePIInstanceBackup(ctx context.Context, d *schema.ResourceData, meta interface{}, sess *ibmpisession.IBMPISession, cloudInstanceID string, backup piInstanceBackup, timeout time.Duration) error {
+	log.Printf("[DEBUG] deleting %s backup %s of instance %s", backup.destination, backup.name, backup.instanceID)
+	switch backup.destination {
+	case Snapshot:
+		client := instance.NewIBMPISnapshotClient(ctx, sess, cloudInstanceID)
+		if err := client.Delete(backup.id); err != nil {
+			return fmt.Errorf("failed to delete snapshot %s: %v", backup.name, err)
+		}
+		_, err := isWaitForPIInstanceSnapshotDeleted(ctx, client, backup.id, timeout)
+		return err
+	case imageCatalogDestination:
+		client := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
+		if err := client.Delete(backup.id); err != nil {
+			return fmt.Errorf("failed to delete image %s: %v", backup.name, err)
+		}
+	case cloudStorageDestination:
+		client, bucket, _, err := piInstanceBackupCOSClient(d, meta)
+		if err != nil {
+			return err
+		}
+		_, err = client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{Bucket: aws.String(bucket), Key: aws.String(backup.id)})
+		if err != nil {
+			return fmt.Errorf("failed to delete object %s of bucket %s: %v", backup.id, bucket, err)
+		}
+	}
+	return nil
+}
+
+// sortPIInstanceBackups sorts the backups newest first.
+func sortPIInstanceBackups(backups []piInstanceBackup) []piInstanceBackup {
+	sort.SliceStable(backups, func(i, j int) bool {
+		if backups[i].created.Equal(backups[j].created) {
+			return backups[i].name < backups[j].name
+		}
+		return backups[i].created.After(backups[j].created)
+	})
+	return backups
+}
+
+// latestPIInstanceBackups returns the time of the latest usable backup of each instance.
+func latestPIInstanceBackups(backups []piInstanceBackup) map[string]time.Time {
+	latest := map[string]time.Time{}
+	for _, backup := range backups {
+		if !isPIInstanceBackupUsable(backup) {
+			continue
+		}
+		if last, ok := latest[backup.instanceID]; !ok || backup.created.After(last) {
+			latest[backup.instanceID] = backup.created
+		}
+	}
+	return latest
+}
+
+// expiredPIInstanceBackups returns the backups older than the newest retention usable backups of
+// each instance and destination. The sorted backups are expected.
+func expiredPIInstanceBackups(backups []piInstanceBackup, retention int) []piInstanceBackup {
+	kept := map[string]int{}
+	expired := []piInstanceBackup{}
+	for _, backup := range backups {
+		key := backup.instanceID + "/" + backup.destination
+		if kept[key] >= retention {
+			expired = append(expired, backup)
+			continue
+		}
+		if isPIInstanceBackupUsable(backup) {
+			kept[key]++
+		}
+	}
+	return expired
+}
+
+func isPIInstanceBackupUsable(backup piInstanceBackup) bool {
+	status := strings.ToLower(backup.status)
+	return status == State_Available || status == State_Active
+}
+
+// nextPIInstanceBackupRun returns the earliest time an instance of the policy is due for a backup.
+func nextPIInstanceBackupRun(d *schema.ResourceData, backups []piInstanceBackup) time.Time {
+	interval := time.Duration(d.Get(Arg_IntervalHours).(int)) * time.Hour
+	latest := latestPIInstanceBackups(backups)
+	next := time.Time{}
+	for _, instanceID := range flex.ExpandStringList(d.Get(Arg_InstanceIDs).(*schema.Set).List()) {
+		last, ok := latest[instanceID]
+		if !ok {
+			return time.Now().UTC()
+		}
+		if due := last.Add(interval); next.IsZero() || due.Before(next) {
+			next = due
+		}
+	}
+	return next.UTC()
+}
+
+func flattenPIInstanceBackups(backups []piInstanceBackup) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(backups))
+	for _, backup := range backups {
+		result = append(result, map[string]interface{}{
+			Attr_BackupID:     backup.id,
+			Attr_CreationDate: backup.created.Format(time.RFC3339),
+			Attr_Destination:  backup.destination,
+			Attr_InstanceID:   backup.instanceID,
+			Attr_Name:         backup.name,
+			Attr_Status:       backup.status,
+		})
+	}
+	return result
+}
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMPIInstanceBackupPolicySnapshot(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-backup-policy-%d", acctest.RandIntRange(10, 100))
+	policyRes := "ibm_pi_instance_backup_policy.power_backup_policy"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIInstanceBackupPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIInstanceBackupPolicyConfig(name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(policyRes, "pi_name", name),
+					resource.TestCheckResourceAttr(policyRes, "inventory.#", "1"),
+					resource.TestCheckResourceAttr(policyRes, "inventory.0.destination", power.Snapshot),
+					resource.TestCheckResourceAttr(policyRes, "inventory.0.status", power.State_Available),
+					resource.TestCheckResourceAttrSet(policyRes, "next_run"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIInstanceBackupPolicyConfig(name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(policyRes, "pi_retention", "2"),
+					resource.TestCheckResourceAttr(policyRes, "inventory.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstanceBackupPolicyDestroy(s *terraform.State) error {
+	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_instance_backup_policy" {
+			continue
+		}
+		cloudInstanceID, name, err := splitID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client := instance.NewIBMPISnapshotClient(context.Background(), sess, cloudInstanceID)
+		snapshots, err := client.GetAll()
+		if err != nil {
+			return err
+		}
+		for _, snapshot := range snapshots.Snapshots {
+			if snapshot.Name != nil && strings.HasPrefix(*snapshot.Name, name+"-") {
+				return fmt.Errorf("PI Instance Backup Policy snapshot still exists: %s", *snapshot.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMPIInstanceBackupPolicyConfig(name string, retention int) string {
+	return testAccCheckIBMPIInstanceConfig(name, power.OK) + fmt.Sprintf(`
+		resource "ibm_pi_instance_backup_policy" "power_backup_policy" {
+			pi_backup_type       = "snapshot"
+			pi_cloud_instance_id = "%[1]s"
+			pi_instance_ids      = [ibm_pi_instance.power_instance.instance_id]
+			pi_interval_hours    = 24
+			pi_name              = "%[2]s"
+			pi_prune_on_delete   = true
+			pi_retention         = %[3]d
+		}`, acc.Pi_cloud_instance_id, name, retention)
+}
@@ -207,7 +207,7 @@ func testAccIBMPIInstanceVTLConfig(name string) string {
 	`, acc.Pi_cloud_instance_id, name, acc.Pi_image)
 }
 
-func testAccCheckIBMPIInstanceReplicantConfig(name string) string {
+func testAccCheckIBMPIInstanceReplicantConfig(name string, replicants int) string {
 	return fmt.Sprintf(`
 	resource "ibm_pi_volume" "power_volume" {
 		pi_cloud_instance_id = "%[1]s"
@@ -223,7 +223,7 @@ func testAccCheckIBMPIInstanceReplicantConfig(name string) string {
 		pi_memory            = "2"
 		pi_proc_type         = "shared"
 		pi_processors        = "1"
-		pi_replicants         = 3
+		pi_replicants         = %[5]d
 		pi_replication_policy = "affinity"
 		pi_replication_scheme = "suffix"
 		pi_sys_type          = "s922"
@@ -232,7 +232,7 @@ func testAccCheckIBMPIInstanceReplicantConfig(name string) string {
 			network_id = "%[4]s"
 		  }
 	  }
-	`, acc.Pi_cloud_instance_id, name, acc.Pi_image, acc.Pi_network_name)
+	`, acc.Pi_cloud_instance_id, name, acc.Pi_image, acc.Pi_network_name, replicants)
 }
 
 func testAccCheckIBMPIInstanceDeplomentTargetConfig(name string) string {
@@ -416,12 +416,23 @@ func TestAccIBMPIInstanceReplicant(t *testing.T) {
 		CheckDestroy: testAccCheckIBMPIInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCheckIBMPIInstanceReplicantConfig(name),
+				Config: testAccCheckIBMPIInstanceReplicantConfig(name, 3),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckIBMPIInstanceExists(instanceRes),
 					resource.TestCheckResourceAttr(instanceRes, "pi_replicants", "3"),
 					resource.TestCheckResourceAttr(instanceRes, "pi_replication_policy", power.Affinity),
 					resource.TestCheckResourceAttr(instanceRes, "pi_replication_scheme", "suffix"),
+					resource.TestCheckResourceAttr(instanceRes, "replicants.#", "3"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckIBMPIInstanceReplicantConfig(name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "pi_replicants", "2"),
+					resource.TestCheckResourceAttr(instanceRes, "replicants.#", "2"),
+					resource.TestCheckResourceAttrSet(instanceRes, "replicants.1.instance_id"),
 				),
 				ExpectNonEmptyPlan: true,
 			},
@@ -0,0 +1,873 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const powerVirtualServerNetworkType = "power_virtual_server"
+
+func ResourceIBMPIWorkspaceBaseline() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIWorkspaceBaselineCreate,
+		ReadContext:   resourceIBMPIWorkspaceBaselineRead,
+		UpdateContext: resourceIBMPIWorkspaceBaselineUpdate,
+		DeleteContext: resourceIBMPIWorkspaceBaselineDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CatalogImageIDs: {
+				Description: "The IDs of the catalog stock images to import in the workspace.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Set:         schema.HashString,
+				Type:        schema.TypeSet,
+			},
+			Arg_Datacenter: {
+				Description:  "Target location or environment to create the workspace.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_Dhcp: {
+				Description: "The DHCP server of the workspace.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Arg_Cidr: {
+							Description: "The CIDR of the DHCP private network.",
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						Arg_DhcpName: {
+							Description: "The name of the DHCP service.",
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						Arg_DhcpSnatEnabled: {
+							Default:     true,
+							Description: "Indicates if SNAT will be enabled for the DHCP service.",
+							Optional:    true,
+							Type:        schema.TypeBool,
+						},
+						Arg_DnsServer: {
+							Description: "The DNS server of the DHCP service.",
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				MaxItems: 1,
+				Optional: true,
+				Type:     schema.TypeList,
+			},
+			Arg_KeyName: {
+				Description:  "The name of the SSH key of the workspace.",
+				Optional:     true,
+				RequiredWith: []string{Arg_SSHKey},
+				Type:         schema.TypeString,
+			},
+			Arg_Name: {
+				Description:  "A descriptive name used to identify the workspace.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_Plan: {
+				Default:      Public,
+				Description:  "Plan associated with the offering; Valid values are public or private.",
+				ForceNew:     true,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{Private, Public}),
+			},
+			Arg_PrivateNetwork: {
+				Description: "The private network of the workspace.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Arg_Cidr: {
+							Description:  "The CIDR of the network.",
+							Required:     true,
+							Type:         schema.TypeString,
+							ValidateFunc: validation.IsCIDR,
+						},
+						Arg_DNS: {
+							Description: "The DNS servers of the network.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Optional:    true,
+							Set:         schema.HashString,
+							Type:        schema.TypeSet,
+						},
+						Arg_NetworkName: {
+							Description:  "The name of the network.",
+							Required:     true,
+							Type:         schema.TypeString,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+				MaxItems: 1,
+				Optional: true,
+				Type:     schema.TypeList,
+			},
+			Arg_PublicNetwork: {
+				Description: "The public network of the workspace.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Arg_DNS: {
+							Description: "The DNS servers of the network.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Optional:    true,
+							Set:         schema.HashString,
+							Type:        schema.TypeSet,
+						},
+						Arg_NetworkName: {
+							Description:  "The name of the network.",
+							Required:     true,
+							Type:         schema.TypeString,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+				MaxItems: 1,
+				Optional: true,
+				Type:     schema.TypeList,
+			},
+			Arg_ResourceGroupID: {
+				Description:  "The ID of the resource group where you want to create the workspace. You can retrieve the value from data source ibm_resource_group.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_SSHKey: {
+				Description:  "The SSH RSA key of the workspace.",
+				Optional:     true,
+				RequiredWith: []string{Arg_KeyName},
+				Type:         schema.TypeString,
+			},
+			Arg_TransitGatewayID: {
+				Description: "The ID of the transit gateway to connect the workspace to.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+
+			// Attributes
+			Attr_CRN: {
+				Computed:    true,
+				Description: "The CRN of the workspace.",
+				Type:        schema.TypeString,
+			},
+			Attr_DhcpID: {
+				Computed:    true,
+				Description: "The ID of the DHCP server.",
+				Type:        schema.TypeString,
+			},
+			Attr_DhcpNetworkID: {
+				Computed:    true,
+				Description: "The ID of the private network of the DHCP server.",
+				Type:        schema.TypeString,
+			},
+			Attr_ImageIDs: {
+				Computed:    true,
+				Description: "The IDs of the imported images, by catalog stock image ID.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeMap,
+			},
+			Attr_PrivateNetworkID: {
+				Computed:    true,
+				Description: "The ID of the private network.",
+				Type:        schema.TypeString,
+			},
+			Attr_PublicNetworkID: {
+				Computed:    true,
+				Description: "The ID of the public network.",
+				Type:        schema.TypeString,
+			},
+			Attr_TransitGatewayConnectionID: {
+				Computed:    true,
+				Description: "The ID of the transit gateway connection.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func resourceIBMPIWorkspaceBaselineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get(Arg_Name).(string)
+	datacenter := d.Get(Arg_Datacenter).(string)
+	resourceGroup := d.Get(Arg_ResourceGroupID).(string)
+	plan := d.Get(Arg_Plan).(string)
+
+	client := instance.NewIBMPIWorkspacesClient(ctx, sess, "")
+	controller, _, err := client.Create(name, datacenter, resourceGroup, plan)
+	if err != nil {
+		log.Printf("[DEBUG] create workspace failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := *controller.GUID
+	d.SetId(cloudInstanceID)
+	_, err = waitForResourceInstanceCreate(ctx, client, cloudInstanceID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set(Attr_CRN, controller.CRN)
+
+	// The components are created in dependency order, each one is recorded as soon as it is
+	// available so that a failed apply resumes where it stopped.
+	if _, ok := d.GetOk(Arg_KeyName); ok {
+		if err := ensurePIWorkspaceBaselineKey(ctx, d, sess, cloudInstanceID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if _, ok := d.GetOk(Arg_PrivateNetwork); ok {
+		if err := ensurePIWorkspaceBaselineNetwork(ctx, d, sess, cloudInstanceID, Arg_PrivateNetwork, Attr_PrivateNetworkID, Vlan, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if _, ok := d.GetOk(Arg_PublicNetwork); ok {
+		if err := ensurePIWorkspaceBaselineNetwork(ctx, d, sess, cloudInstanceID, Arg_PublicNetwork, Attr_PublicNetworkID, PubVlan, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if _, ok := d.GetOk(Arg_Dhcp); ok {
+		if err := ensurePIWorkspaceBaselineDhcp(ctx, d, sess, cloudInstanceID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if v, ok := d.GetOk(Arg_CatalogImageIDs); ok {
+		catalogImageIDs := flex.ExpandStringList(v.(*schema.Set).List())
+		if err := ensurePIWorkspaceBaselineImages(ctx, d, sess, cloudInstanceID, catalogImageIDs, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if _, ok := d.GetOk(Arg_TransitGatewayID); ok {
+		if err := ensurePIWorkspaceBaselineConnection(ctx, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMPIWorkspaceBaselineRead(ctx, d, meta)
+}
+
+func resourceIBMPIWorkspaceBaselineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Id()
+	client := instance.NewIBMPIWorkspacesClient(ctx, sess, cloudInstanceID)
+	controller, response, err := client.GetRC(cloudInstanceID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if controller.State != nil && (*controller.State == State_Removed || *controller.State == State_PendingReclamation) {
+		log.Printf("[DEBUG] workspace %s is %s", cloudInstanceID, *controller.State)
+		d.SetId("")
+		return nil
+	}
+	d.Set(Arg_Name, controller.Name)
+	d.Set(Arg_ResourceGroupID, controller.ResourceGroupID)
+	d.Set(Attr_CRN, controller.CRN)
+
+	// The datacenter forces a new workspace, so it is refreshed from the location of the workspace
+	workspace, err := client.Get(cloudInstanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if workspace.Location != nil && workspace.Location.Region != nil {
+		d.Set(Arg_Datacenter, *workspace.Location.Region)
+	}
+
+	// A component that is configured but missing, either deleted outside of Terraform or not
+	// created yet, is removed from the state so that the next apply creates or adopts it.
+	if _, ok := d.GetOk(Arg_KeyName); ok {
+		keyClient := instance.NewIBMPIKeyClient(ctx, sess, cloudInstanceID)
+		key, err := keyClient.Get(d.Get(Arg_KeyName).(string))
+		if err != nil {
+			if !isPIWorkspaceBaselineNotFound(err) {
+				return diag.FromErr(err)
+			}
+			d.Set(Arg_KeyName, "")
+			d.Set(Arg_SSHKey, "")
+		} else {
+			d.Set(Arg_SSHKey, key.SSHKey)
+		}
+	}
+
+	networkClient := instance.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
+	for arg, attr := range map[string]string{Arg_PrivateNetwork: Attr_PrivateNetworkID, Arg_PublicNetwork: Attr_PublicNetworkID} {
+		if _, ok := d.GetOk(arg); !ok {
+			continue
+		}
+		networkID := d.Get(attr).(string)
+		if networkID == "" {
+			d.Set(arg, nil)
+			continue
+		}
+		network, err := networkClient.Get(networkID)
+		if err != nil {
+			if !isPIWorkspaceBaselineNotFound(err) {
+				return diag.FromErr(err)
+			}
+			d.Set(arg, nil)
+			d.Set(attr, "")
+			continue
+		}
+		d.Set(arg, flattenPIWorkspaceBaselineNetwork(d.Get(arg).([]interface{})[0].(map[string]interface{}), network))
+	}
+
+	if _, ok := d.GetOk(Arg_Dhcp); ok {
+		dhcpID := d.Get(Attr_DhcpID).(string)
+		if dhcpID == "" {
+			d.Set(Arg_Dhcp, nil)
+		} else {
+			dhcpClient := instance.NewIBMPIDhcpClient(ctx, sess, cloudInstanceID)
+			dhcpServer, err := dhcpClient.Get(dhcpID)
+			if err != nil {
+				if !isPIWorkspaceBaselineNotFound(err) {
+					return diag.FromErr(err)
+				}
+				d.Set(Arg_Dhcp, nil)
+				d.Set(Attr_DhcpID, "")
+				d.Set(Attr_DhcpNetworkID, "")
+			} else if dhcpServer.Network != nil {
+				d.Set(Attr_DhcpNetworkID, dhcpServer.Network.ID)
+			}
+		}
+	}
+
+	if _, ok := d.GetOk(Arg_CatalogImageIDs); ok {
+		imageClient := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
+		imageIDs := map[string]interface{}{}
+		catalogImageIDs := []string{}
+		for catalogImageID, imageID := range d.Get(Attr_ImageIDs).(map[string]interface{}) {
+			_, err := imageClient.Get(imageID.(string))
+			if err != nil {
+				if !isPIWorkspaceBaselineNotFound(err) {
+					return diag.FromErr(err)
+				}
+				continue
+			}
+			imageIDs[catalogImageID] = imageID
+			catalogImageIDs = append(catalogImageIDs, catalogImageID)
+		}
+		d.Set(Attr_ImageIDs, imageIDs)
+		d.Set(Arg_CatalogImageIDs, catalogImageIDs)
+	}
+
+	if _, ok := d.GetOk(Arg_TransitGatewayID); ok {
+		connectionID := d.Get(Attr_TransitGatewayConnectionID).(string)
+		if connectionID == "" {
+			d.Set(Arg_TransitGatewayID, "")
+		} else {
+			tgClient, err := meta.(conns.ClientSession).TransitGatewayV1API()
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			getOptions := &transitgatewayapisv1.GetTransitGatewayConnectionOptions{}
+			getOptions.SetTransitGatewayID(d.Get(Arg_TransitGatewayID).(string))
+			getOptions.SetID(connectionID)
+			_, response, err := tgClient.GetTransitGatewayConnectionWithContext(ctx, getOptions)
+			if err != nil {
+				if response == nil || response.StatusCode != 404 {
+					return diag.Errorf("failed to get transit gateway connection %s: %s\n%s", connectionID, err, response)
+				}
+				d.Set(Arg_TransitGatewayID, "")
+				d.Set(Attr_TransitGatewayConnectionID, "")
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMPIWorkspaceBaselineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Id()
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	// A component removed from the configuration is released, not deleted, so that it can be
+	// imported in its own resource. A changed component is replaced.
+	if d.HasChanges(Arg_KeyName, Arg_SSHKey) {
+		oldName, _ := d.GetChange(Arg_KeyName)
+		if _, ok := d.GetOk(Arg_KeyName); ok {
+			if oldName.(string) != "" {
+				keyClient := instance.NewIBMPIKeyClient(ctx, sess, cloudInstanceID)
+				if err := keyClient.Delete(oldName.(string)); err != nil && !isPIWorkspaceBaselineNotFound(err) {
+					return diag.FromErr(err)
+				}
+			}
+			if err := ensurePIWorkspaceBaselineKey(ctx, d, sess, cloudInstanceID); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	for _, network := range []struct{ arg, attr, networkType string }{
+		{Arg_PrivateNetwork, Attr_PrivateNetworkID, Vlan},
+		{Arg_PublicNetwork, Attr_PublicNetworkID, PubVlan},
+	} {
+		if !d.HasChange(network.arg) {
+			continue
+		}
+		if _, ok := d.GetOk(network.arg); !ok {
+			d.Set(network.attr, "")
+			continue
+		}
+		if err := updatePIWorkspaceBaselineNetwork(ctx, d, sess, cloudInstanceID, network.arg, network.attr, network.networkType, timeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(Arg_Dhcp) {
+		if _, ok := d.GetOk(Arg_Dhcp); ok {
+			dhcpClient := instance.NewIBMPIDhcpClient(ctx, sess, cloudInstanceID)
+			if dhcpID := d.Get(Attr_DhcpID).(string); dhcpID != "" {
+				if err := dhcpClient.Delete(dhcpID); err != nil && !isPIWorkspaceBaselineNotFound(err) {
+					return diag.FromErr(err)
+				}
+				if _, err := waitForIBMPIDhcpDeleted(ctx, dhcpClient, dhcpID, timeout); err != nil {
+					return diag.FromErr(err)
+				}
+				d.Set(Attr_DhcpID, "")
+				d.Set(Attr_DhcpNetworkID, "")
+			}
+			if err := ensurePIWorkspaceBaselineDhcp(ctx, d, sess, cloudInstanceID, timeout); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			d.Set(Attr_DhcpID, "")
+			d.Set(Attr_DhcpNetworkID, "")
+		}
+	}
+
+	if d.HasChange(Arg_CatalogImageIDs) {
+		oldSet, newSet := d.GetChange(Arg_CatalogImageIDs)
+		removed := flex.ExpandStringList(oldSet.(*schema.Set).Difference(newSet.(*schema.Set)).List())
+		added := flex.ExpandStringList(newSet.(*schema.Set).Difference(oldSet.(*schema.Set)).List())
+		imageIDs := d.Get(Attr_ImageIDs).(map[string]interface{})
+		for _, catalogImageID := range removed {
+			delete(imageIDs, catalogImageID)
+		}
+		d.Set(Attr_ImageIDs, imageIDs)
+		if err := ensurePIWorkspaceBaselineImages(ctx, d, sess, cloudInstanceID, added, timeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(Arg_TransitGatewayID) {
+		if _, ok := d.GetOk(Arg_TransitGatewayID); ok {
+			oldGatewayID, _ := d.GetChange(Arg_TransitGatewayID)
+			if err := deletePIWorkspaceBaselineConnection(ctx, d, meta, oldGatewayID.(string), timeout); err != nil {
+				return diag.FromErr(err)
+			}
+			if err := ensurePIWorkspaceBaselineConnection(ctx, d, meta, timeout); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			d.Set(Attr_TransitGatewayConnectionID, "")
+		}
+	}
+
+	return resourceIBMPIWorkspaceBaselineRead(ctx, d, meta)
+}
+
+func resourceIBMPIWorkspaceBaselineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The transit gateway connection lives outside of the workspace, the other components are
+	// deleted along with the workspace.
+	if gatewayID, ok := d.GetOk(Arg_TransitGatewayID); ok {
+		err = deletePIWorkspaceBaselineConnection(ctx, d, meta, gatewayID.(string), d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	cloudInstanceID := d.Id()
+	client := instance.NewIBMPIWorkspacesClient(ctx, sess, cloudInstanceID)
+	response, err := client.Delete(cloudInstanceID)
+	if err != nil && response != nil && response.StatusCode == 410 {
+		return nil
+	}
+	_, err = waitForResourceInstanceDelete(ctx, client, cloudInstanceID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func isPIWorkspaceBaselineNotFound(err error) bool {
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, NotFound) || strings.Contains(message, "404")
+}
+
+// ensurePIWorkspaceBaselineKey adopts the SSH key of the same name or creates it.
+func ensurePIWorkspaceBaselineKey(ctx context.Context, d *schema.ResourceData, sess *ibmpisession.IBMPISession, cloudInstanceID string) error {
+	name := d.Get(Arg_KeyName).(string)
+	sshKey := d.Get(Arg_SSHKey).(string)
+	client := instance.NewIBMPIKeyClient(ctx, sess, cloudInstanceID)
+	if _, err := client.Get(name); err == nil {
+		log.Printf("[DEBUG] adopting SSH key %s", name)
+		return nil
+	}
+	_, err := client.Create(&models.SSHKey{Name: &name, SSHKey: &sshKey})
+	if err != nil {
+		return fmt.Errorf("failed to create SSH key %s: %v", name, err)
+	}
+	return nil
+}
+
+// ensurePIWorkspaceBaselineNetwork adopts the network of the same name or creates it.
+func ensurePIWorkspaceBaselineNetwork(ctx context.Context, d *schema.ResourceData, sess *ibmpisession.IBMPISession, cloudInstanceID, arg, attr, networkType string, timeout time.Duration) error {
+	config := d.Get(arg).([]interface{})[0].(map[string]interface{})
+	name := config[Arg_NetworkName].(string)
+	client := instance.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
+
+	networks, err := client.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to list networks: %v", err)
+	}
+	for _, network := range networks.Networks {
+		if network.Name != nil && *network.Name == name && network.NetworkID != nil {
+			log.Printf("[DEBUG] adopting network %s", name)
+			d.Set(attr, *network.NetworkID)
+			return nil
+		}
+	}
+
+	body := &models.NetworkCreate{
+		Name: name,
+		Type: &networkType,
+	}
+	if dns, ok := config[Arg_DNS]; ok {
+		body.DNSServers = flex.ExpandStringList(dns.(*schema.Set).List())
+	}
+	if networkType == Vlan {
+		cidr := config[Arg_Cidr].(string)
+		gateway, firstIP, lastIP, err := generateIPData(cidr)
+		if err != nil {
+			return err
+		}
+		body.Cidr = cidr
+		body.Gateway = gateway
+		body.IPAddressRanges = []*models.IPAddressRange{{EndingIPAddress: &lastIP, StartingIPAddress: &firstIP}}
+	}
+	network, err := client.Create(body)
+	if err != nil {
+		return fmt.Errorf("failed to create network %s: %v", name, err)
+	}
+	d.Set(attr, *network.NetworkID)
+
+	_, err = isWaitForIBMPINetworkAvailable(ctx, client, *network.NetworkID, timeout)
+	return err
+}
+
+// updatePIWorkspaceBaselineNetwork updates the name and DNS servers of a network in place and
+// replaces it when its CIDR changes.
+func updatePIWorkspaceBaselineNetwork(ctx context.Context, d *schema.ResourceData, sess *ibmpisession.IBMPISession, cloudInstanceID, arg, attr, networkType string, timeout time.Duration) error {
+	client := instance.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
+	networkID := d.Get(attr).(string)
+	oldList, newList := d.GetChange(arg)
+
+	if networkID != "" && len(oldList.([]interface{})) > 0 && len(newList.([]interface{})) > 0 {
+		oldConfig := oldList.([]interface{})[0].(map[string]interface{})
+		newConfig := newList.([]interface{})[0].(map[string]interface{})
+		if oldConfig[Arg_Cidr] == newConfig[Arg_Cidr] {
+			name := newConfig[Arg_NetworkName].(string)
+			body := &models.NetworkUpdate{
+				DNSServers: flex.ExpandStringList(newConfig[Arg_DNS].(*schema.Set).List()),
+				Name:       &name,
+			}
+			if _, err := client.Update(networkID, body); err != nil {
+				return fmt.Errorf("failed to update network %s: %v", networkID, err)
+			}
+			return nil
+		}
+	}
+
+	if networkID != "" {
+		if err := client.Delete(networkID); err != nil && !isPIWorkspaceBaselineNotFound(err) {
+			return fmt.Errorf("failed to delete network %s: %v", networkID, err)
+		}
+		if _, err := isWaitForIBMPINetworkDeleted(ctx, client, networkID, timeout); err != nil {
+			return err
+		}
+		d.Set(attr, "")
+	}
+	if len(newList.([]interface{})) > 0 {
+		return ensurePIWorkspaceBaselineNetwork(ctx, d, sess, cloudInstanceID, arg, attr, networkType, timeout)
+	}
+	return nil
+}
+
+// ensurePIWorkspaceBaselineDhcp adopts the DHCP server whose network holds the configured name,
+// or the only DHCP server of the workspace when no name is configured, or creates it.
+func ensurePIWorkspaceBaselineDhcp(ctx context.Context, d *schema.ResourceData, sess *ibmpisession.IBMPISession, cloudInstanceID string, timeout time.Duration) error {
+	config := d.Get(Arg_Dhcp).([]interface{})[0].(map[string]interface{})
+	name := config[Arg_DhcpName].(string)
+	client := instance.NewIBMPIDhcpClient(ctx, sess, cloudInstanceID)
+
+	servers, err := client.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to list DHCP servers: %v", err)
+	}
+	for _, server := range servers {
+		if server.ID == nil || server.Network == nil || server.Network.Name == nil {
+			continue
+		}
+		if (name != "" && strings.Contains(*server.Network.Name, name)) || (name == "" && len(servers) == 1) {
+			log.Printf("[DEBUG] adopting DHCP server %s", *server.ID)
+			d.Set(Attr_DhcpID, *server.ID)
+			d.Set(Attr_DhcpNetworkID, server.Network.ID)
+			return nil
+		}
+	}
+
+	body := &models.DHCPServerCreate{}
+	if cidr := config[Arg_Cidr].(string); cidr != "" {
+		body.Cidr = &cidr
+	}
+	if dnsServer := config[Arg_DnsServer].(string); dnsServer != "" {
+		body.DNSServer = &dnsServer
+	}
+	if name != "" {
+		body.Name = &name
+	}
+	snatEnabled := config[Arg_DhcpSnatEnabled].(bool)
+	body.SnatEnabled = &snatEnabled
+
+	server, err := client.Create(body)
+	if err != nil {
+		return fmt.Errorf("failed to create DHCP server: %v", err)
+	}
+	d.Set(Attr_DhcpID, *server.ID)
+	if server.Network != nil {
+		d.Set(Attr_DhcpNetworkID, server.Network.ID)
+	}
+
+	_, err = waitForIBMPIDhcpStatus(ctx, client, *server.ID, timeout)
+	return err
+}
+
+// ensurePIWorkspaceBaselineImages adopts the images named after the catalog stock images or
+// imports them.
+func ensurePIWorkspaceBaselineImages(ctx context.Context, d *schema.ResourceData, sess *ibmpisession.IBMPISession, cloudInstanceID string, catalogImageIDs []string, timeout time.Duration) error {
+	if len(catalogImageIDs) == 0 {
+		return nil
+	}
+	client := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
+	images, err := client.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to list images: %v", err)
+	}
+	existing := map[string]string{}
+	for _, image := range images.Images {
+		if image.Name != nil && image.ImageID != nil {
+			existing[*image.Name] = *image.ImageID
+		}
+	}
+
+	imageIDs := d.Get(Attr_ImageIDs).(map[string]interface{})
+	for _, catalogImageID := range catalogImageIDs {
+		stockImage, err := client.GetStockImage(catalogImageID)
+		if err != nil {
+			return fmt.Errorf("failed to get catalog image %s: %v", catalogImageID, err)
+		}
+		name := *stockImage.Name
+		if imageID, ok := existing[name]; ok {
+			log.Printf("[DEBUG] adopting image %s", name)
+			imageIDs[catalogImageID] = imageID
+			d.Set(Attr_ImageIDs, imageIDs)
+			continue
+		}
+
+		source := "root-project"
+		image, err := client.Create(&models.CreateImage{ImageID: catalogImageID, ImageName: name, Source: &source})
+		if err != nil {
+			return fmt.Errorf("failed to import catalog image %s: %v", catalogImageID, err)
+		}
+		imageIDs[catalogImageID] = *image.ImageID
+		d.Set(Attr_ImageIDs, imageIDs)
+
+		if _, err = isWaitForIBMPIImageAvailable(ctx, client, *image.ImageID, timeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensurePIWorkspaceBaselineConnection adopts the connection of the transit gateway to the
+// workspace or creates it.
+func ensurePIWorkspaceBaselineConnection(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	client, err := meta.(conns.ClientSession).TransitGatewayV1API()
+	if err != nil {
+		return err
+	}
+	gatewayID := d.Get(Arg_TransitGatewayID).(string)
+	crn := d.Get(Attr_CRN).(string)
+
+	listOptions := &transitgatewayapisv1.ListTransitGatewayConnectionsOptions{}
+	listOptions.SetTransitGatewayID(gatewayID)
+	for {
+		connections, response, err := client.ListTransitGatewayConnectionsWithContext(ctx, listOptions)
+		if err != nil {
+			return fmt.Errorf("failed to list the connections of transit gateway %s: %s\n%s", gatewayID, err, response)
+		}
+		for _, connection := range connections.Connections {
+			if connection.NetworkID != nil && *connection.NetworkID == crn {
+				log.Printf("[DEBUG] adopting transit gateway connection %s", *connection.ID)
+				d.Set(Attr_TransitGatewayConnectionID, *connection.ID)
+				return nil
+			}
+		}
+		if connections.Next == nil || connections.Next.Start == nil {
+			break
+		}
+		listOptions.SetStart(*connections.Next.Start)
+	}
+
+	createOptions := &transitgatewayapisv1.CreateTransitGatewayConnectionOptions{}
+	createOptions.SetTransitGatewayID(gatewayID)
+	createOptions.SetName(d.Get(Arg_Name).(string))
+	createOptions.SetNetworkType(powerVirtualServerNetworkType)
+	createOptions.SetNetworkID(crn)
+	connection, response, err := client.CreateTransitGatewayConnectionWithContext(ctx, createOptions)
+	if err != nil {
+		return fmt.Errorf("failed to connect the workspace to transit gateway %s: %s\n%s", gatewayID, err, response)
+	}
+	d.Set(Attr_TransitGatewayConnectionID, *connection.ID)
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{State_Pending},
+		Target:  []string{State_Attached},
+		Refresh: func() (interface{}, string, error) {
+			getOptions := &transitgatewayapisv1.GetTransitGatewayConnectionOptions{}
+			getOptions.SetTransitGatewayID(gatewayID)
+			getOptions.SetID(*connection.ID)
+			tgConnection, response, err := client.GetTransitGatewayConnectionWithContext(ctx, getOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to get transit gateway connection %s: %s\n%s", *connection.ID, err, response)
+			}
+			if *tgConnection.Status == State_Failed {
+				return tgConnection, State_Failed, fmt.Errorf("transit gateway connection %s failed", *connection.ID)
+			}
+			if *tgConnection.Status == State_Attached {
+				return tgConnection, State_Attached, nil
+			}
+			return tgConnection, State_Pending, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func deletePIWorkspaceBaselineConnection(ctx context.Context, d *schema.ResourceData, meta interface{}, gatewayID string, timeout time.Duration) error {
+	connectionID := d.Get(Attr_TransitGatewayConnectionID).(string)
+	if gatewayID == "" || connectionID == "" {
+		return nil
+	}
+	client, err := meta.(conns.ClientSession).TransitGatewayV1API()
+	if err != nil {
+		return err
+	}
+
+	deleteOptions := &transitgatewayapisv1.DeleteTransitGatewayConnectionOptions{}
+	deleteOptions.SetTransitGatewayID(gatewayID)
+	deleteOptions.SetID(connectionID)
+	response, err := client.DeleteTransitGatewayConnectionWithContext(ctx, deleteOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.Set(Attr_TransitGatewayConnectionID, "")
+			return nil
+		}
+		return fmt.Errorf("failed to delete transit gateway connection %s: %s\n%s", connectionID, err, response)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{State_Deleting},
+		Target:  []string{State_Deleted},
+		Refresh: func() (interface{}, string, error) {
+			getOptions := &transitgatewayapisv1.GetTransitGatewayConnectionOptions{}
+			getOptions.SetTransitGatewayID(gatewayID)
+			getOptions.SetID(connectionID)
+			tgConnection, response, err := client.GetTransitGatewayConnectionWithContext(ctx, getOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return connectionID, State_Deleted, nil
+				}
+				return nil, "", fmt.Errorf("failed to get transit gateway connection %s: %s\n%s", connectionID, err, response)
+			}
+			return tgConnection, State_Deleting, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return err
+	}
+	d.Set(Attr_TransitGatewayConnectionID, "")
+	return nil
+}
+
+func flattenPIWorkspaceBaselineNetwork(config map[string]interface{}, network *models.Network) []map[string]interface{} {
+	result := map[string]interface{}{
+		Arg_DNS:         network.DNSServers,
+		Arg_NetworkName: *network.Name,
+	}
+	if _, ok := config[Arg_Cidr]; ok && network.Cidr != nil {
+		result[Arg_Cidr] = *network.Cidr
+	}
+	return []map[string]interface{}{result}
+}
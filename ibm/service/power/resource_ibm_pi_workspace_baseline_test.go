@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMPIWorkspaceBaselineBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-baseline-%d", acctest.RandIntRange(10, 100))
+	baselineRes := "ibm_pi_workspace_baseline.baseline"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccIBMPIWorkspaceBaselineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIWorkspaceBaselineConfig(name, "192.168.10.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIWorkspaceExists(baselineRes),
+					resource.TestCheckResourceAttrSet(baselineRes, "crn"),
+					resource.TestCheckResourceAttrSet(baselineRes, "private_network_id"),
+					resource.TestCheckResourceAttrSet(baselineRes, "public_network_id"),
+					resource.TestCheckResourceAttr(baselineRes, "pi_private_network.0.pi_cidr", "192.168.10.0/24"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIWorkspaceBaselineConfig(name, "192.168.20.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(baselineRes, "private_network_id"),
+					resource.TestCheckResourceAttr(baselineRes, "pi_private_network.0.pi_cidr", "192.168.20.0/24"),
+				),
+			},
+			{
+				ResourceName:            baselineRes,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pi_datacenter", "pi_key_name", "pi_plan", "pi_private_network", "pi_public_network", "pi_ssh_key", "private_network_id", "public_network_id"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIWorkspaceBaselineConfig(name, cidr string) string {
+	return fmt.Sprintf(`
+		resource "ibm_pi_workspace_baseline" "baseline" {
+			pi_datacenter        = "dal12"
+			pi_key_name          = "%[1]s"
+			pi_name              = "%[1]s"
+			pi_resource_group_id = "%[2]s"
+			pi_ssh_key           = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR"
+			pi_private_network {
+				pi_cidr         = "%[3]s"
+				pi_dns          = ["127.0.0.1"]
+				pi_network_name = "%[1]s-private"
+			}
+			pi_public_network {
+				pi_network_name = "%[1]s-public"
+			}
+		}
+	`, name, acc.Pi_resource_group_id, cidr)
+}
+
+func testAccIBMPIWorkspaceBaselineDestroy(s *terraform.State) error {
+	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_workspace_baseline" {
+			continue
+		}
+		cloudInstanceID := rs.Primary.ID
+		client := st.NewIBMPIWorkspacesClient(context.Background(), sess, cloudInstanceID)
+		workspace, _, err := client.GetRC(cloudInstanceID)
+		if err == nil && workspace.State != nil && *workspace.State == power.State_Active {
+			return fmt.Errorf("Resource Instance still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
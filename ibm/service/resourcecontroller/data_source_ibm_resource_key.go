@@ -111,26 +111,59 @@ func DataSourceIBMResourceKeyValidator() *validate.ResourceValidator {
 }
 
 func dataSourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error {
-	rsContClient, err := meta.(conns.ClientSession).ResourceControllerAPI()
+	key, err := findResourceKey(meta, d.Get("name").(string), d.Get("resource_instance_id").(string), d.Get("resource_alias_id").(string), d.Get("most_recent").(bool))
 	if err != nil {
 		return err
 	}
+
+	d.SetId(key.ID)
+
+	if redacted, ok := key.Credentials["redacted"].(string); ok {
+		log.Printf("Credentials are redacted with code: %s.The User doesn't have the correct access to view the credentials. Refer to the API documentation for additional details.", redacted)
+	}
+	if roleCrn, ok := key.Parameters["role_crn"].(string); ok {
+		d.Set("role", roleCrn[strings.LastIndex(roleCrn, ":")+1:])
+	} else if roleCrn, ok := key.Credentials["iam_role_crn"].(string); ok {
+		d.Set("role", roleCrn[strings.LastIndex(roleCrn, ":")+1:])
+	}
+
+	// ### Modification for onetime_credientails
+	d.Set("onetime_credentials", key.OnetimeCredentials)
+	d.Set("credentials", flex.Flatten(key.Credentials))
+	creds, err := json.Marshal(key.Credentials)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error marshalling resource key credentials: %s", err)
+	}
+	if err = d.Set("credentials_json", string(creds)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting the credentials json: %s", err)
+	}
+	d.Set("status", key.State)
+	d.Set("crn", key.Crn.String())
+	return nil
+}
+
+// findResourceKey looks up a resource key by name, optionally restricted to the keys of a
+// resource instance or alias
+func findResourceKey(meta interface{}, name, resourceInstanceID, resourceAliasID string, mostRecent bool) (models.ServiceKey, error) {
+	var key models.ServiceKey
+	rsContClient, err := meta.(conns.ClientSession).ResourceControllerAPI()
+	if err != nil {
+		return key, err
+	}
 	rkAPI := rsContClient.ResourceServiceKey()
-	name := d.Get("name").(string)
-	mostRecent := d.Get("most_recent").(bool)
 
 	keys, err := rkAPI.GetKeys(name)
 	if err != nil {
-		return err
+		return key, err
 	}
 	var filteredKeys []models.ServiceKey
 
-	if d.Get("resource_instance_id") == "" {
+	if resourceInstanceID == "" {
 		filteredKeys = keys
 	} else {
-		crn, err := getCRN(d, meta)
+		crn, err := getCRN(meta, resourceInstanceID, resourceAliasID)
 		if err != nil {
-			return err
+			return key, err
 		}
 		for _, key := range keys {
 			if key.SourceCrn == *crn {
@@ -141,58 +174,32 @@ func dataSourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if len(filteredKeys) == 0 {
-		return fmt.Errorf("[ERROR] No resource keys found with name [%s]", name)
+		return key, fmt.Errorf("[ERROR] No resource keys found with name [%s]", name)
 	}
 
-	var key models.ServiceKey
-
 	if len(filteredKeys) > 1 {
 		if mostRecent {
 			key = mostRecentResourceKey(filteredKeys)
 		} else {
-			return fmt.Errorf("[ERROR] More than one resource key found with name matching [%s]. "+
+			return key, fmt.Errorf("[ERROR] More than one resource key found with name matching [%s]. "+
 				"Set 'most_recent' to true in your configuration to force the most recent resource key "+
 				"to be used", name)
 		}
 	} else {
 		key = filteredKeys[0]
 	}
-
-	d.SetId(key.ID)
-
-	if redacted, ok := key.Credentials["redacted"].(string); ok {
-		log.Printf("Credentials are redacted with code: %s.The User doesn't have the correct access to view the credentials. Refer to the API documentation for additional details.", redacted)
-	}
-	if roleCrn, ok := key.Parameters["role_crn"].(string); ok {
-		d.Set("role", roleCrn[strings.LastIndex(roleCrn, ":")+1:])
-	} else if roleCrn, ok := key.Credentials["iam_role_crn"].(string); ok {
-		d.Set("role", roleCrn[strings.LastIndex(roleCrn, ":")+1:])
-	}
-
-	// ### Modification for onetime_credientails
-	d.Set("onetime_credentials", key.OnetimeCredentials)
-	d.Set("credentials", flex.Flatten(key.Credentials))
-	creds, err := json.Marshal(key.Credentials)
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error marshalling resource key credentials: %s", err)
-	}
-	if err = d.Set("credentials_json", string(creds)); err != nil {
-		return fmt.Errorf("[ERROR] Error setting the credentials json: %s", err)
-	}
-	d.Set("status", key.State)
-	d.Set("crn", key.Crn.String())
-	return nil
+	return key, nil
 }
 
-func getCRN(d *schema.ResourceData, meta interface{}) (*crn.CRN, error) {
+func getCRN(meta interface{}, resourceInstanceID, resourceAliasID string) (*crn.CRN, error) {
 
 	rsContClient, err := meta.(conns.ClientSession).ResourceControllerAPI()
 	if err != nil {
 		return nil, err
 	}
 
-	if insID, ok := d.GetOk("resource_instance_id"); ok {
-		instance, err := rsContClient.ResourceServiceInstance().GetInstance(insID.(string))
+	if resourceInstanceID != "" {
+		instance, err := rsContClient.ResourceServiceInstance().GetInstance(resourceInstanceID)
 		if err != nil {
 			return nil, err
 		}
@@ -200,7 +207,7 @@ func getCRN(d *schema.ResourceData, meta interface{}) (*crn.CRN, error) {
 
 	}
 
-	alias, err := rsContClient.ResourceServiceAlias().Alias(d.Get("resource_alias_id").(string))
+	alias, err := rsContClient.ResourceServiceAlias().Alias(resourceAliasID)
 	if err != nil {
 		return nil, err
 	}
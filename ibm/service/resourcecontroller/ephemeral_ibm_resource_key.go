@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+// resourceKeyEphemeralResource reads the credentials of a resource key without storing
+// them in the plan or state
+type resourceKeyEphemeralResource struct {
+	provider *sdkschema.Provider
+}
+
+type resourceKeyEphemeralModel struct {
+	Name               types.String `tfsdk:"name"`
+	ResourceInstanceID types.String `tfsdk:"resource_instance_id"`
+	ResourceAliasID    types.String `tfsdk:"resource_alias_id"`
+	MostRecent         types.Bool   `tfsdk:"most_recent"`
+	ID                 types.String `tfsdk:"id"`
+	Crn                types.String `tfsdk:"crn"`
+	Role               types.String `tfsdk:"role"`
+	Status             types.String `tfsdk:"status"`
+	OnetimeCredentials types.Bool   `tfsdk:"onetime_credentials"`
+	Credentials        types.Map    `tfsdk:"credentials"`
+	CredentialsJSON    types.String `tfsdk:"credentials_json"`
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &resourceKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithValidateConfig = &resourceKeyEphemeralResource{}
+
+func EphemeralIBMResourceKey() ephemeral.EphemeralResource {
+	return &resourceKeyEphemeralResource{}
+}
+
+func (r *resourceKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_key"
+}
+
+func (r *resourceKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the credentials of a resource key without persisting them in the Terraform plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the resource key",
+			},
+			"resource_instance_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The id of the resource instance",
+			},
+			"resource_alias_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The id of the resource alias",
+			},
+			"most_recent": schema.BoolAttribute{
+				Optional: true,
+				Description: "If true and multiple entries are found, the most recently created resource key is used. " +
+					"If false, an error is returned",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource key",
+			},
+			"crn": schema.StringAttribute{
+				Computed:    true,
+				Description: "crn of resource key",
+			},
+			"role": schema.StringAttribute{
+				Computed:    true,
+				Description: "User role",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of resource key",
+			},
+			"onetime_credentials": schema.BoolAttribute{
+				Computed:    true,
+				Description: "onetime_credentials of resource key",
+			},
+			"credentials": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Credentials asociated with the key",
+			},
+			"credentials_json": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Credentials asociated with the key in json string",
+			},
+		},
+	}
+}
+
+func (r *resourceKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	provider, ok := req.ProviderData.(*sdkschema.Provider)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("Expected *schema.Provider, got %T", req.ProviderData))
+		return
+	}
+	r.provider = provider
+}
+
+func (r *resourceKeyEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var config resourceKeyEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.ResourceInstanceID.IsNull() && !config.ResourceAliasID.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("resource_alias_id"), "Conflicting configuration arguments",
+			"\"resource_alias_id\": conflicts with resource_instance_id")
+	}
+}
+
+func (r *resourceKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data resourceKeyEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.provider == nil || r.provider.Meta() == nil {
+		resp.Diagnostics.AddError("Provider not configured", "The provider must be configured before the resource key can be read.")
+		return
+	}
+
+	key, err := findResourceKey(r.provider.Meta(), data.Name.ValueString(), data.ResourceInstanceID.ValueString(), data.ResourceAliasID.ValueString(), data.MostRecent.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading resource key", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(key.ID)
+	data.Crn = types.StringValue(key.Crn.String())
+	data.Status = types.StringValue(key.State)
+	data.OnetimeCredentials = types.BoolValue(key.OnetimeCredentials)
+	data.Role = types.StringNull()
+	if roleCrn, ok := key.Parameters["role_crn"].(string); ok {
+		data.Role = types.StringValue(roleCrn[strings.LastIndex(roleCrn, ":")+1:])
+	} else if roleCrn, ok := key.Credentials["iam_role_crn"].(string); ok {
+		data.Role = types.StringValue(roleCrn[strings.LastIndex(roleCrn, ":")+1:])
+	}
+
+	credentials, diags := types.MapValueFrom(ctx, types.StringType, flex.Flatten(key.Credentials))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Credentials = credentials
+	creds, err := json.Marshal(key.Credentials)
+	if err != nil {
+		resp.Diagnostics.AddError("Error marshalling resource key credentials", err.Error())
+		return
+	}
+	data.CredentialsJSON = types.StringValue(string(creds))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMResourceKeyEphemeral_basic(t *testing.T) {
+	resourceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	resourceKey := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acc.TestAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceKeyEphemeralConfig(resourceName, resourceKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_resource_key.resourcekey", "name", resourceKey),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMResourceKeyEphemeralConfig(resourceName, resourceKey string) string {
+	return fmt.Sprintf(`
+
+resource "ibm_resource_instance" "resource" {
+  name     = "%s"
+  service  = "cloud-object-storage"
+  plan     = "standard"
+  location = "global"
+}
+
+resource "ibm_resource_key" "resourcekey" {
+  name                 = "%s"
+  role                 = "Writer"
+  resource_instance_id = ibm_resource_instance.resource.id
+}
+
+ephemeral "ibm_resource_key" "testacc_ephemeral_resource_key" {
+  name                 = ibm_resource_key.resourcekey.name
+  resource_instance_id = ibm_resource_instance.resource.id
+}
+`, resourceName, resourceKey)
+
+}
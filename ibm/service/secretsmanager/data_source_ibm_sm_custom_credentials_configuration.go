@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func DataSourceIbmSmCustomCredentialsConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmSmCustomCredentialsConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the configuration.",
+			},
+			"config_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The configuration type.",
+			},
+			"api_key_ref": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the IAM credentials secret that is used by the Code Engine job to call back to Secrets Manager.",
+			},
+			"code_engine": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Code Engine job that creates and deletes the credentials.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"project_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Code Engine project ID.",
+						},
+						"job_name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Code Engine job name.",
+						},
+						"region": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The region of the Code Engine project.",
+						},
+					},
+				},
+			},
+			"task_timeout": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The maximum time that a job can run, for example `10m` or `1h`.",
+			},
+			"schema": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The parameters and credentials that the Code Engine job declares.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"parameters": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The parameters that are passed to the job.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the parameter.",
+									},
+									"format": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The format of the parameter, for example `required:true type:string`.",
+									},
+									"env_variable_name": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The environment variable that the job reads the parameter from.",
+									},
+									"required": &schema.Schema{
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the parameter is required.",
+									},
+								},
+							},
+						},
+						"credentials": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The credentials that the job returns.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the credential.",
+									},
+									"format": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The format of the credential.",
+									},
+									"required": &schema.Schema{
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the job always returns the credential.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"secret_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The secret type.",
+			},
+			"created_by": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier that is associated with the entity that created the secret.",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when a resource was created. The date format follows RFC 3339.",
+			},
+			"updated_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when a resource was recently modified. The date format follows RFC 3339.",
+			},
+		},
+	}
+}
+
+func dataSourceIbmSmCustomCredentialsConfigurationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	configName := d.Get("name").(string)
+	configuration, response, err := getCustomCredentialsConfiguration(context, secretsManagerClient, configName)
+	if err != nil {
+		log.Printf("[DEBUG] GetConfigurationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetConfigurationWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, configName))
+
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = setCustomCredentialsConfiguration(d, configuration); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmCustomCredentialsConfigurationDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmCustomCredentialsConfigurationDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_sm_custom_credentials_configuration.sm_custom_credentials_configuration", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_sm_custom_credentials_configuration.sm_custom_credentials_configuration", "config_type"),
+					resource.TestCheckResourceAttrSet("data.ibm_sm_custom_credentials_configuration.sm_custom_credentials_configuration", "task_timeout"),
+					resource.TestCheckResourceAttrSet("data.ibm_sm_custom_credentials_configuration.sm_custom_credentials_configuration", "code_engine.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_sm_custom_credentials_configuration.sm_custom_credentials_configuration", "schema.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmCustomCredentialsConfigurationDataSourceConfigBasic() string {
+	return testAccCheckIbmSmCustomCredentialsConfigurationConfigBasic("5m") + fmt.Sprintf(`
+		data "ibm_sm_custom_credentials_configuration" "sm_custom_credentials_configuration" {
+			instance_id = "%s"
+			region      = "%s"
+			name        = ibm_sm_custom_credentials_configuration.sm_custom_credentials_configuration.name
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
@@ -0,0 +1,228 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func DataSourceIbmSmCustomCredentialsSecret() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmSmCustomCredentialsSecretRead,
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"secret_id", "name"},
+				Description:  "The ID of the secret.",
+			},
+			"created_by": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier that is associated with the entity that created the secret.",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when a resource was created. The date format follows RFC 3339.",
+			},
+			"crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A CRN that uniquely identifies an IBM Cloud resource.",
+			},
+			"custom_metadata": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The secret metadata that a user can customize.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "An extended description of your secret.To protect your privacy, do not use personal data, such as your name or location, as a description for your secret group.",
+			},
+			"downloaded": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether the secret data that is associated with a secret version was retrieved in a call to the service API.",
+			},
+			"labels": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Labels that you can use to search for secrets in your instance.Up to 30 labels can be created.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"locks_total": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of locks of the secret.",
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"secret_id", "name"},
+				RequiredWith: []string{"secret_group_name"},
+				Description:  "The human-readable name of your secret.",
+			},
+			"secret_group_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"name"},
+				Description:  "The human-readable name of your secret group.",
+			},
+			"secret_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A v4 UUID identifier, or `default` secret group.",
+			},
+			"secret_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The secret type.",
+			},
+			"state": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The secret state that is based on NIST SP 800-57. States are integers and correspond to the `Pre-activation = 0`, `Active = 1`,  `Suspended = 2`, `Deactivated = 3`, and `Destroyed = 5` values.",
+			},
+			"state_description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A text representation of the secret state.",
+			},
+			"updated_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when a resource was recently modified. The date format follows RFC 3339.",
+			},
+			"versions_total": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of versions of the secret.",
+			},
+			"expiration_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date a secret is expired. The date format follows RFC 3339.",
+			},
+			"next_rotation_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date that the secret is scheduled for automatic rotation.The service automatically creates a new version of the secret on its next rotation date. This field exists only for secrets that have an existing rotation policy.",
+			},
+			"configuration": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the custom credentials configuration.",
+			},
+			"parameters": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The values of the parameters that the configuration declares, keyed by parameter name.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"rotation": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Determines whether Secrets Manager rotates your secrets automatically.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_rotate": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines whether Secrets Manager rotates your secret automatically.Default is `false`. If `auto_rotate` is set to `true` the service rotates your secret based on the defined interval.",
+						},
+						"interval": &schema.Schema{
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The length of the secret rotation time interval.",
+						},
+						"unit": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The units for the secret rotation time interval.",
+						},
+					},
+				},
+			},
+			"credentials_content": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The credentials that the Code Engine job created, keyed by credential name.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIbmSmCustomCredentialsSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	secret := &customCredentialsSecret{}
+	var response *core.DetailedResponse
+	if secretId, ok := d.GetOk("secret_id"); ok {
+		secret, response, err = getCustomCredentialsSecret(context, secretsManagerClient, "/api/v2/secrets/{id}", secretId.(string))
+		if err != nil {
+			log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+		}
+	} else {
+		// Locate secret by name
+		response, err = smRawRequest(context, secretsManagerClient, core.GET, "/api/v2/secret_groups/{secret_group_name}/secret_types/{secret_type}/secrets/{name}",
+			map[string]string{
+				"secret_group_name": d.Get("secret_group_name").(string),
+				"secret_type":       CustomCredentialsSecretType,
+				"name":              d.Get("name").(string),
+			}, nil, nil, secret)
+		if err != nil {
+			log.Printf("[DEBUG] GetSecretByNameTypeWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("GetSecretByNameTypeWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *secret.ID))
+
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = d.Set("secret_id", secret.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_id: %s", err))
+	}
+	if err = setCustomCredentialsSecretMetadata(d, secret); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("credentials_content", flattenCustomCredentialsValues(secret.CredentialsContent)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting credentials_content: %s", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func DataSourceIbmSmCustomCredentialsSecretMetadata() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmSmCustomCredentialsSecretMetadataRead,
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the secret.",
+			},
+			"created_by": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier that is associated with the entity that created the secret.",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when a resource was created. The date format follows RFC 3339.",
+			},
+			"crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A CRN that uniquely identifies an IBM Cloud resource.",
+			},
+			"custom_metadata": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The secret metadata that a user can customize.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "An extended description of your secret.To protect your privacy, do not use personal data, such as your name or location, as a description for your secret group.",
+			},
+			"downloaded": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether the secret data that is associated with a secret version was retrieved in a call to the service API.",
+			},
+			"labels": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Labels that you can use to search for secrets in your instance.Up to 30 labels can be created.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"locks_total": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of locks of the secret.",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The human-readable name of your secret.",
+			},
+			"secret_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A v4 UUID identifier, or `default` secret group.",
+			},
+			"secret_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The secret type.",
+			},
+			"state": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The secret state that is based on NIST SP 800-57. States are integers and correspond to the `Pre-activation = 0`, `Active = 1`,  `Suspended = 2`, `Deactivated = 3`, and `Destroyed = 5` values.",
+			},
+			"state_description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A text representation of the secret state.",
+			},
+			"updated_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when a resource was recently modified. The date format follows RFC 3339.",
+			},
+			"versions_total": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of versions of the secret.",
+			},
+			"expiration_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date a secret is expired. The date format follows RFC 3339.",
+			},
+			"next_rotation_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date that the secret is scheduled for automatic rotation.The service automatically creates a new version of the secret on its next rotation date. This field exists only for secrets that have an existing rotation policy.",
+			},
+			"configuration": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the custom credentials configuration.",
+			},
+			"parameters": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The values of the parameters that the configuration declares, keyed by parameter name.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"rotation": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Determines whether Secrets Manager rotates your secrets automatically.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_rotate": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines whether Secrets Manager rotates your secret automatically.Default is `false`. If `auto_rotate` is set to `true` the service rotates your secret based on the defined interval.",
+						},
+						"interval": &schema.Schema{
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The length of the secret rotation time interval.",
+						},
+						"unit": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The units for the secret rotation time interval.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIbmSmCustomCredentialsSecretMetadataRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	secretId := d.Get("secret_id").(string)
+	secretMetadata, response, err := getCustomCredentialsSecret(context, secretsManagerClient, "/api/v2/secrets/{id}/metadata", secretId)
+	if err != nil {
+		log.Printf("[DEBUG] GetSecretMetadataWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetSecretMetadataWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, secretId))
+
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = setCustomCredentialsSecretMetadata(d, secretMetadata); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
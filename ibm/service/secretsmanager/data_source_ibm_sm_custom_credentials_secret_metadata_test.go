@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmCustomCredentialsSecretMetadataDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmCustomCredentialsSecretMetadataDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_sm_custom_credentials_secret_metadata.sm_custom_credentials_secret_metadata", "secret_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_sm_custom_credentials_secret_metadata.sm_custom_credentials_secret_metadata", "created_by"),
+					resource.TestCheckResourceAttrSet("data.ibm_sm_custom_credentials_secret_metadata.sm_custom_credentials_secret_metadata", "crn"),
+					resource.TestCheckResourceAttrSet("data.ibm_sm_custom_credentials_secret_metadata.sm_custom_credentials_secret_metadata", "configuration"),
+					resource.TestCheckResourceAttrSet("data.ibm_sm_custom_credentials_secret_metadata.sm_custom_credentials_secret_metadata", "rotation.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmCustomCredentialsSecretMetadataDataSourceConfigBasic() string {
+	return customCredentialsSecretConfig(customCredentialsSecretName, false) + fmt.Sprintf(`
+		data "ibm_sm_custom_credentials_secret_metadata" "sm_custom_credentials_secret_metadata" {
+			instance_id = "%s"
+			region      = "%s"
+			secret_id   = ibm_sm_custom_credentials_secret.sm_custom_credentials_secret.secret_id
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmCustomCredentialsSecretDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmCustomCredentialsSecretDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_sm_custom_credentials_secret.sm_custom_credentials_secret", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_sm_custom_credentials_secret.sm_custom_credentials_secret", "created_by"),
+					resource.TestCheckResourceAttrSet("data.ibm_sm_custom_credentials_secret.sm_custom_credentials_secret", "configuration"),
+					resource.TestCheckResourceAttrSet("data.ibm_sm_custom_credentials_secret.sm_custom_credentials_secret", "credentials_content.%"),
+					resource.TestCheckResourceAttrSet("data.ibm_sm_custom_credentials_secret.sm_custom_credentials_secret_by_name", "secret_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmCustomCredentialsSecretDataSourceConfigBasic() string {
+	return customCredentialsSecretConfig(customCredentialsSecretName, false) + fmt.Sprintf(`
+		data "ibm_sm_custom_credentials_secret" "sm_custom_credentials_secret" {
+			instance_id = "%[1]s"
+			region      = "%[2]s"
+			secret_id   = ibm_sm_custom_credentials_secret.sm_custom_credentials_secret.secret_id
+		}
+
+		data "ibm_sm_custom_credentials_secret" "sm_custom_credentials_secret_by_name" {
+			instance_id       = "%[1]s"
+			region            = "%[2]s"
+			name              = ibm_sm_custom_credentials_secret.sm_custom_credentials_secret.name
+			secret_group_name = "default"
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmArbitrarySecretEphemeralBasic(t *testing.T) {
+	resourceName := "ibm_sm_arbitrary_secret.sm_arbitrary_secret_copy"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acc.TestAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckIbmSmArbitrarySecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmSmArbitrarySecretEphemeralConfigBasic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "secret_id"),
+					resource.TestCheckResourceAttr(resourceName, "payload", ""),
+				),
+			},
+		},
+	})
+}
+
+// The ephemeral payload is copied to another secret through its write-only payload, so that
+// the value is never written to the state
+func testAccCheckIbmSmArbitrarySecretEphemeralConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_instance" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = "terraform-test-ephemeral-arbitrary-secret"
+			payload = "secret-credentials"
+		}
+
+		ephemeral "ibm_sm_arbitrary_secret" "sm_arbitrary_secret" {
+			instance_id   = "%s"
+			region        = "%s"
+			secret_id = ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance.secret_id
+			version_stage = "current"
+		}
+
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_copy" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = "terraform-test-ephemeral-arbitrary-secret-copy"
+			payload_wo = ephemeral.ibm_sm_arbitrary_secret.sm_arbitrary_secret.payload
+			payload_wo_version = 1
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
+		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
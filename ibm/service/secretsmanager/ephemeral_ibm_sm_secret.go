@@ -0,0 +1,438 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+const (
+	smPayloadString = iota
+	smPayloadMap
+	smPayloadList
+)
+
+// smPayloadAttribute describes an attribute of a secret version payload exposed by an
+// ephemeral secret
+type smPayloadAttribute struct {
+	name        string
+	kind        int
+	description string
+}
+
+// smSecretEphemeralResource reads a version of a secret without storing its payload in the
+// plan or state. All secret types share this implementation and only differ by the payload
+// attributes of their versions.
+type smSecretEphemeralResource struct {
+	typeName   string
+	secretType string
+	payload    []smPayloadAttribute
+	provider   *sdkschema.Provider
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &smSecretEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithValidateConfig = &smSecretEphemeralResource{}
+
+func EphemeralIbmSmArbitrarySecret() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:   "_sm_arbitrary_secret",
+		secretType: ArbitrarySecretType,
+		payload: []smPayloadAttribute{
+			{name: "payload", description: "The arbitrary secret's data payload."},
+		},
+	}
+}
+
+func EphemeralIbmSmCustomCredentialsSecret() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:   "_sm_custom_credentials_secret",
+		secretType: CustomCredentialsSecretType,
+		payload: []smPayloadAttribute{
+			{name: "credentials_content", kind: smPayloadMap, description: "The credentials that were generated for this secret."},
+		},
+	}
+}
+
+func EphemeralIbmSmIamCredentialsSecret() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:   "_sm_iam_credentials_secret",
+		secretType: IAMCredentialsSecretType,
+		payload: []smPayloadAttribute{
+			{name: "api_key", description: "The API key that is generated for this secret."},
+			{name: "api_key_id", description: "The ID of the API key that is generated for this secret."},
+			{name: "service_id", description: "The service ID under which the API key is created."},
+		},
+	}
+}
+
+func EphemeralIbmSmImportedCertificate() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:   "_sm_imported_certificate",
+		secretType: ImportedCertSecretType,
+		payload: []smPayloadAttribute{
+			{name: "certificate", description: "The PEM-encoded contents of your certificate."},
+			{name: "intermediate", description: "The PEM-encoded intermediate certificate."},
+			{name: "private_key", description: "The PEM-encoded private key."},
+		},
+	}
+}
+
+func EphemeralIbmSmKvSecret() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:   "_sm_kv_secret",
+		secretType: KvSecretType,
+		payload: []smPayloadAttribute{
+			{name: "data", kind: smPayloadMap, description: "The payload data of a key-value secret. Nested values are JSON encoded."},
+		},
+	}
+}
+
+func EphemeralIbmSmPrivateCertificate() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:   "_sm_private_certificate",
+		secretType: PrivateCertSecretType,
+		payload: []smPayloadAttribute{
+			{name: "certificate", description: "The PEM-encoded contents of your certificate."},
+			{name: "private_key", description: "The PEM-encoded private key."},
+			{name: "issuing_ca", description: "The PEM-encoded certificate of the certificate authority that signed and issued this certificate."},
+			{name: "ca_chain", kind: smPayloadList, description: "The chain of certificate authorities that are associated with the certificate."},
+		},
+	}
+}
+
+func EphemeralIbmSmPublicCertificate() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:   "_sm_public_certificate",
+		secretType: PublicCertSecretType,
+		payload: []smPayloadAttribute{
+			{name: "certificate", description: "The PEM-encoded contents of your certificate."},
+			{name: "intermediate", description: "The PEM-encoded intermediate certificate."},
+			{name: "private_key", description: "The PEM-encoded private key."},
+		},
+	}
+}
+
+func EphemeralIbmSmServiceCredentialsSecret() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:   "_sm_service_credentials_secret",
+		secretType: ServiceCredentialsSecretType,
+		payload: []smPayloadAttribute{
+			{name: "credentials", kind: smPayloadMap, description: "The properties of the service credentials secret payload. Nested values are JSON encoded."},
+		},
+	}
+}
+
+func EphemeralIbmSmUsernamePasswordSecret() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:   "_sm_username_password_secret",
+		secretType: UsernamePasswordSecretType,
+		payload: []smPayloadAttribute{
+			{name: "username", description: "The username that is assigned to the secret."},
+			{name: "password", description: "The password that is assigned to the secret."},
+		},
+	}
+}
+
+func (r *smSecretEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeName
+}
+
+func (r *smSecretEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"instance_id": schema.StringAttribute{
+			Required:    true,
+			Description: "The ID of the Secrets Manager instance.",
+		},
+		"region": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The region of the Secrets Manager instance.",
+		},
+		"endpoint_type": schema.StringAttribute{
+			Optional:    true,
+			Description: "public or private.",
+		},
+		"secret_id": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The ID of the secret.",
+		},
+		"name": schema.StringAttribute{
+			Optional:    true,
+			Description: "The human-readable name of your secret.",
+		},
+		"secret_group_name": schema.StringAttribute{
+			Optional:    true,
+			Description: "The human-readable name of your secret group.",
+		},
+		"version_id": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The ID of the secret version to read. Defaults to the current version.",
+		},
+		"version_stage": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The stage of the secret version to read, either `current` or `previous`. Conflicts with `version_id`.",
+		},
+		"secret_group_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "A v4 UUID identifier, or `default` secret group.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The date when the secret version was created. The date format follows RFC 3339.",
+		},
+		"expiration_date": schema.StringAttribute{
+			Computed:    true,
+			Description: "The date when the secret version material expires. The date format follows RFC 3339.",
+		},
+	}
+	for _, p := range r.payload {
+		switch p.kind {
+		case smPayloadMap:
+			attributes[p.name] = schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				Description: p.description,
+			}
+		case smPayloadList:
+			attributes[p.name] = schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				Description: p.description,
+			}
+		default:
+			attributes[p.name] = schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: p.description,
+			}
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Reads a version of a %s secret without persisting its payload in the Terraform plan or state.", r.secretType),
+		Attributes:  attributes,
+	}
+}
+
+func (r *smSecretEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	provider, ok := req.ProviderData.(*sdkschema.Provider)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("Expected *schema.Provider, got %T", req.ProviderData))
+		return
+	}
+	r.provider = provider
+}
+
+func (r *smSecretEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var secretId, name, groupName, versionId, versionStage types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_id"), &secretId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_group_name"), &groupName)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("version_id"), &versionId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("version_stage"), &versionStage)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !secretId.IsUnknown() && !name.IsUnknown() && secretId.IsNull() == name.IsNull() {
+		resp.Diagnostics.AddError("Invalid combination of arguments", "Exactly one of \"secret_id\" or \"name\" must be specified")
+	}
+	if !name.IsNull() && groupName.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("secret_group_name"), "Missing required argument",
+			"\"secret_group_name\" must be specified when \"name\" is specified")
+	}
+	if !versionId.IsNull() && !versionStage.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("version_stage"), "Conflicting configuration arguments",
+			"\"version_stage\": conflicts with version_id")
+	}
+	if !versionStage.IsNull() && !versionStage.IsUnknown() && versionStage.ValueString() != "current" && versionStage.ValueString() != "previous" {
+		resp.Diagnostics.AddAttributeError(path.Root("version_stage"), "Invalid value",
+			fmt.Sprintf("expected version_stage to be one of [current previous], got %s", versionStage.ValueString()))
+	}
+}
+
+func (r *smSecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var instanceId, region, endpointType, secretId, name, groupName, versionId, versionStage types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("instance_id"), &instanceId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("region"), &region)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("endpoint_type"), &endpointType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_id"), &secretId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_group_name"), &groupName)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("version_id"), &versionId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("version_stage"), &versionStage)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.provider == nil || r.provider.Meta() == nil {
+		resp.Diagnostics.AddError("Provider not configured", "The provider must be configured before the secret can be read.")
+		return
+	}
+
+	secretsManagerClient, err := r.provider.Meta().(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating the Secrets Manager client", err.Error())
+		return
+	}
+	if region.ValueString() == "" {
+		region = types.StringValue(getDefaultRegion(secretsManagerClient))
+	}
+	if endpointType.ValueString() == "" {
+		endpointType = types.StringValue(getDefaultEndpointType(secretsManagerClient))
+	}
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId.ValueString(), region.ValueString(), endpointType.ValueString())
+
+	if secretId.ValueString() == "" {
+		secret, response, err := r.getSecretByName(ctx, secretsManagerClient, groupName.ValueString(), name.ValueString())
+		if err != nil {
+			log.Printf("[DEBUG] GetSecretByNameTypeWithContext failed %s\n%s", err, response)
+			resp.Diagnostics.AddError("GetSecretByNameTypeWithContext failed", fmt.Sprintf("%s\n%s", err, response))
+			return
+		}
+		id, _ := secret["id"].(string)
+		secretId = types.StringValue(id)
+	}
+
+	version := versionId.ValueString()
+	if version == "" {
+		version = versionStage.ValueString()
+	}
+	if version == "" {
+		version = "current"
+	}
+
+	secretVersion, response, err := r.getSecretVersion(ctx, secretsManagerClient, secretId.ValueString(), version)
+	if err != nil {
+		log.Printf("[DEBUG] GetSecretVersionWithContext failed %s\n%s", err, response)
+		resp.Diagnostics.AddError("GetSecretVersionWithContext failed", fmt.Sprintf("%s\n%s", err, response))
+		return
+	}
+	if secretType, _ := secretVersion["secret_type"].(string); secretType != r.secretType {
+		resp.Diagnostics.AddError("Unexpected secret type",
+			fmt.Sprintf("The secret %s is of type %q, expected %q", secretId.ValueString(), secretType, r.secretType))
+		return
+	}
+
+	result := map[string]attr.Value{
+		"region":          region,
+		"secret_id":       secretId,
+		"version_id":      smStringValue(secretVersion["id"]),
+		"version_stage":   smStringValue(secretVersion["alias"]),
+		"secret_group_id": smStringValue(secretVersion["secret_group_id"]),
+		"created_at":      smStringValue(secretVersion["created_at"]),
+		"expiration_date": smStringValue(secretVersion["expiration_date"]),
+	}
+	for _, p := range r.payload {
+		var value attr.Value
+		var diags diag.Diagnostics
+		switch p.kind {
+		case smPayloadMap:
+			values, _ := secretVersion[p.name].(map[string]interface{})
+			value, diags = types.MapValueFrom(ctx, types.StringType, flattenCustomCredentialsValues(values))
+		case smPayloadList:
+			values, _ := secretVersion[p.name].([]interface{})
+			list := make([]string, 0, len(values))
+			for _, v := range values {
+				list = append(list, fmt.Sprint(v))
+			}
+			value, diags = types.ListValueFrom(ctx, types.StringType, list)
+		default:
+			value = smStringValue(secretVersion[p.name])
+		}
+		resp.Diagnostics.Append(diags...)
+		result[p.name] = value
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, value := range result {
+		resp.Diagnostics.Append(resp.Result.SetAttribute(ctx, path.Root(name), value)...)
+	}
+}
+
+// getSecretByName locates a secret by name and returns its attributes as they are named in the API
+func (r *smSecretEphemeralResource) getSecretByName(ctx context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, groupName string, name string) (map[string]interface{}, *core.DetailedResponse, error) {
+	if r.secretType == CustomCredentialsSecretType {
+		secret := map[string]interface{}{}
+		response, err := smRawRequest(ctx, secretsManagerClient, core.GET, "/api/v2/secret_groups/{secret_group_name}/secret_types/{secret_type}/secrets/{name}",
+			map[string]string{"secret_group_name": groupName, "secret_type": r.secretType, "name": name}, nil, nil, &secret)
+		return secret, response, err
+	}
+
+	getSecretByNameOptions := &secretsmanagerv2.GetSecretByNameTypeOptions{}
+	getSecretByNameOptions.SetSecretGroupName(groupName)
+	getSecretByNameOptions.SetSecretType(r.secretType)
+	getSecretByNameOptions.SetName(name)
+	secretIntf, response, err := secretsManagerClient.GetSecretByNameTypeWithContext(ctx, getSecretByNameOptions)
+	if err != nil {
+		return nil, response, err
+	}
+	secret, err := smModelToMap(secretIntf)
+	return secret, response, err
+}
+
+// getSecretVersion gets a version of a secret and returns its attributes as they are named in the API
+func (r *smSecretEphemeralResource) getSecretVersion(ctx context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, secretId string, version string) (map[string]interface{}, *core.DetailedResponse, error) {
+	if r.secretType == CustomCredentialsSecretType {
+		secretVersion := map[string]interface{}{}
+		response, err := smRawRequest(ctx, secretsManagerClient, core.GET, "/api/v2/secrets/{secret_id}/versions/{id}",
+			map[string]string{"secret_id": secretId, "id": version}, nil, nil, &secretVersion)
+		return secretVersion, response, err
+	}
+
+	getSecretVersionOptions := &secretsmanagerv2.GetSecretVersionOptions{}
+	getSecretVersionOptions.SetSecretID(secretId)
+	getSecretVersionOptions.SetID(version)
+	secretVersionIntf, response, err := secretsManagerClient.GetSecretVersionWithContext(ctx, getSecretVersionOptions)
+	if err != nil {
+		return nil, response, err
+	}
+	secretVersion, err := smModelToMap(secretVersionIntf)
+	return secretVersion, response, err
+}
+
+// smModelToMap converts an SDK model to a map that is keyed by the attribute names of the API, so that all the
+// secret types share the same payload handling
+func smModelToMap(model interface{}) (map[string]interface{}, error) {
+	result := map[string]interface{}{}
+	jsonData, err := json.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(jsonData, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func smStringValue(v interface{}) types.String {
+	if s, ok := v.(string); ok {
+		return types.StringValue(s)
+	}
+	return types.StringNull()
+}
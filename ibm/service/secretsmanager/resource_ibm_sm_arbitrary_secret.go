@@ -43,10 +43,25 @@ func ResourceIbmSmArbitrarySecret() *schema.Resource {
 				Description: "The secret type. Supported types are arbitrary, certificates (imported, public, and private), IAM credentials, key-value, and user credentials.",
 			},
 			"payload": &schema.Schema{
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
-				Description: "The arbitrary secret data payload.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"payload", "payload_wo"},
+				Description:  "The arbitrary secret data payload.",
+			},
+			"payload_wo": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				RequiredWith: []string{"payload_wo_version"},
+				Description:  "The arbitrary secret data payload, as a write-only value that is never stored in the Terraform plan or state. Requires Terraform 1.11 or later.",
+			},
+			"payload_wo_version": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"payload_wo"},
+				Description:  "The version of the write-only payload. Increment it to create a new secret version from the current `payload_wo` value.",
 			},
 			"custom_metadata": &schema.Schema{
 				Type:        schema.TypeMap,
@@ -301,8 +316,11 @@ func resourceIbmSmArbitrarySecretRead(context context.Context, d *schema.Resourc
 	if err = d.Set("expiration_date", DateTimeToRFC3339(secret.ExpirationDate)); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting expiration_date: %s", err))
 	}
-	if err = d.Set("payload", secret.Payload); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting payload: %s", err))
+	// The payload is not kept in the state when it is set through the write-only argument
+	if _, ok := d.GetOk("payload_wo_version"); !ok {
+		if err = d.Set("payload", secret.Payload); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting payload: %s", err))
+		}
 	}
 
 	// Call get version metadata API to get the current version_custom_metadata
@@ -394,9 +412,13 @@ func resourceIbmSmArbitrarySecretUpdate(context context.Context, d *schema.Resou
 	}
 
 	// Apply change in payload (if changed)
-	if d.HasChange("payload") {
+	if d.HasChange("payload") || d.HasChange("payload_wo_version") {
 		versionModel := &secretsmanagerv2.ArbitrarySecretVersionPrototype{}
-		versionModel.Payload = core.StringPtr(d.Get("payload").(string))
+		payload, err := getArbitrarySecretPayload(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		versionModel.Payload = core.StringPtr(payload)
 		if _, ok := d.GetOk("version_custom_metadata"); ok {
 			versionModel.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
 		}
@@ -475,8 +497,12 @@ func resourceIbmSmArbitrarySecretMapToArbitrarySecretPrototype(d *schema.Resourc
 	if _, ok := d.GetOk("name"); ok {
 		model.Name = core.StringPtr(d.Get("name").(string))
 	}
-	if _, ok := d.GetOk("payload"); ok {
-		model.Payload = core.StringPtr(d.Get("payload").(string))
+	payload, err := getArbitrarySecretPayload(d)
+	if err != nil {
+		return nil, err
+	}
+	if payload != "" {
+		model.Payload = core.StringPtr(payload)
 	}
 	if _, ok := d.GetOk("custom_metadata"); ok {
 		model.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
@@ -509,3 +535,12 @@ func resourceIbmSmArbitrarySecretMapToArbitrarySecretPrototype(d *schema.Resourc
 	}
 	return model, nil
 }
+
+// getArbitrarySecretPayload returns the payload from either the payload argument or its
+// write-only counterpart
+func getArbitrarySecretPayload(d *schema.ResourceData) (string, error) {
+	if _, ok := d.GetOk("payload_wo_version"); ok {
+		return getWriteOnlyString(d, "payload_wo")
+	}
+	return d.Get("payload").(string), nil
+}
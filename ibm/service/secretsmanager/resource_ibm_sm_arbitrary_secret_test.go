@@ -106,6 +106,34 @@ var arbitrarySecretFullConfigFormat = `
 			secret_group_id = "default"
 		}`
 
+func TestAccIbmSmArbitrarySecretWriteOnly(t *testing.T) {
+	resourceName := "ibm_sm_arbitrary_secret.sm_arbitrary_secret_wo"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acc.TestAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckIbmSmArbitrarySecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: arbitrarySecretConfigWriteOnly(payload, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "secret_id"),
+					resource.TestCheckResourceAttr(resourceName, "payload", ""),
+					resource.TestCheckResourceAttr(resourceName, "payload_wo_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "versions_total", "1"),
+				),
+			},
+			{
+				Config: arbitrarySecretConfigWriteOnly(modifiedPayload, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "payload", ""),
+					resource.TestCheckResourceAttr(resourceName, "payload_wo_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "versions_total", "2"),
+				),
+			},
+		},
+	})
+}
+
 func arbitrarySecretConfigBasic() string {
 	return fmt.Sprintf(arbitrarySecretBasicConfigFormat, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
 		arbitrarySecretName, payload)
@@ -121,6 +149,17 @@ func testAccCheckIbmSmArbitrarySecretConfigUpdated() string {
 		modifiedArbitrarySecretName, modifiedDescription, modifiedLabel, modifiedPayload, modifiedExpirationDate, modifiedCustomMetadata)
 }
 
+func arbitrarySecretConfigWriteOnly(payload string, payloadVersion int) string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_wo" {
+			instance_id = "%s"
+			region = "%s"
+			name = "%s"
+			payload_wo = "%s"
+			payload_wo_version = %d
+		}`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, arbitrarySecretName, payload, payloadVersion)
+}
+
 func testAccCheckIbmSmArbitrarySecretCreated(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		arbitrarySecretIntf, err := getSecret(s, n)
@@ -0,0 +1,447 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+const customCredentialsConfigType = "custom_credentials_configuration"
+
+// The SDK does not model the custom credentials engine yet, these follow the API
+type customCredentialsConfiguration struct {
+	ConfigType  *string                               `json:"config_type,omitempty"`
+	Name        *string                               `json:"name,omitempty"`
+	SecretType  *string                               `json:"secret_type,omitempty"`
+	CreatedBy   *string                               `json:"created_by,omitempty"`
+	CreatedAt   *strfmt.DateTime                      `json:"created_at,omitempty"`
+	UpdatedAt   *strfmt.DateTime                      `json:"updated_at,omitempty"`
+	ApiKeyRef   *string                               `json:"api_key_ref,omitempty"`
+	CodeEngine  *customCredentialsConfigurationEngine `json:"code_engine,omitempty"`
+	TaskTimeout *string                               `json:"task_timeout,omitempty"`
+	Schema      *customCredentialsConfigurationSchema `json:"schema,omitempty"`
+}
+
+type customCredentialsConfigurationEngine struct {
+	ProjectID *string `json:"project_id,omitempty"`
+	JobName   *string `json:"job_name,omitempty"`
+	Region    *string `json:"region,omitempty"`
+}
+
+type customCredentialsConfigurationSchema struct {
+	Parameters  []customCredentialsSchemaParameter  `json:"parameters,omitempty"`
+	Credentials []customCredentialsSchemaCredential `json:"credentials,omitempty"`
+}
+
+type customCredentialsSchemaParameter struct {
+	Name            *string `json:"name,omitempty"`
+	Format          *string `json:"format,omitempty"`
+	EnvVariableName *string `json:"env_variable_name,omitempty"`
+	Required        *bool   `json:"required,omitempty"`
+}
+
+type customCredentialsSchemaCredential struct {
+	Name     *string `json:"name,omitempty"`
+	Format   *string `json:"format,omitempty"`
+	Required *bool   `json:"required,omitempty"`
+}
+
+func ResourceIbmSmCustomCredentialsConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmCustomCredentialsConfigurationCreate,
+		ReadContext:   resourceIbmSmCustomCredentialsConfigurationRead,
+		UpdateContext: resourceIbmSmCustomCredentialsConfigurationUpdate,
+		DeleteContext: resourceIbmSmCustomCredentialsConfigurationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "A human-readable unique name to assign to your configuration.To protect your privacy, do not use personal data, such as your name or location, as an name for your secret.",
+			},
+			"config_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The configuration type.",
+			},
+			"api_key_ref": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the IAM credentials secret that is used by the Code Engine job to call back to Secrets Manager.",
+			},
+			"code_engine": &schema.Schema{
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Required:    true,
+				Description: "The Code Engine job that creates and deletes the credentials.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"project_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The Code Engine project ID.",
+						},
+						"job_name": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The Code Engine job name.",
+						},
+						"region": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The region of the Code Engine project.",
+						},
+					},
+				},
+			},
+			"task_timeout": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The maximum time that a job can run, for example `10m` or `1h`.",
+			},
+			"schema": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The parameters and credentials that the Code Engine job declares.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"parameters": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The parameters that are passed to the job.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the parameter.",
+									},
+									"format": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The format of the parameter, for example `required:true type:string`.",
+									},
+									"env_variable_name": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The environment variable that the job reads the parameter from.",
+									},
+									"required": &schema.Schema{
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the parameter is required.",
+									},
+								},
+							},
+						},
+						"credentials": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The credentials that the job returns.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the credential.",
+									},
+									"format": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The format of the credential.",
+									},
+									"required": &schema.Schema{
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the job always returns the credential.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"secret_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The secret type.",
+			},
+			"created_by": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier that is associated with the entity that created the secret.",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when a resource was created. The date format follows RFC 3339.",
+			},
+			"updated_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when a resource was recently modified. The date format follows RFC 3339.",
+			},
+		},
+	}
+}
+
+func resourceIbmSmCustomCredentialsConfigurationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	configurationPrototype := resourceIbmSmCustomCredentialsConfigurationMapToConfigurationPrototype(d)
+
+	configuration := &customCredentialsConfiguration{}
+	response, err := smRawRequest(context, secretsManagerClient, core.POST, "/api/v2/configurations", nil, nil, configurationPrototype, configuration)
+	if err != nil {
+		log.Printf("[DEBUG] CreateConfigurationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateConfigurationWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *configuration.Name))
+
+	return resourceIbmSmCustomCredentialsConfigurationRead(context, d, meta)
+}
+
+// getCustomCredentialsConfiguration gets a custom credentials configuration by name
+func getCustomCredentialsConfiguration(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, configName string) (*customCredentialsConfiguration, *core.DetailedResponse, error) {
+	configuration := &customCredentialsConfiguration{}
+	response, err := smRawRequest(context, secretsManagerClient, core.GET, "/api/v2/configurations/{name}",
+		map[string]string{"name": configName},
+		map[string]string{"X-Sm-Accept-Configuration-Type": customCredentialsConfigType},
+		nil, configuration)
+	if err != nil {
+		return nil, response, err
+	}
+	if configuration.ConfigType == nil || *configuration.ConfigType != customCredentialsConfigType {
+		return nil, response, fmt.Errorf("The configuration %s is not a custom credentials configuration", configName)
+	}
+	return configuration, response, nil
+}
+
+func resourceIbmSmCustomCredentialsConfigurationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := strings.Split(d.Id(), "/")
+	if len(id) != 3 {
+		return diag.Errorf("Wrong format of resource ID. To import custom credentials configuration use the format `<region>/<instance_id>/<name>`")
+	}
+	region := id[0]
+	instanceId := id[1]
+	configName := id[2]
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	configuration, response, err := getCustomCredentialsConfiguration(context, secretsManagerClient, configName)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetConfigurationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetConfigurationWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("instance_id", instanceId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance_id: %s", err))
+	}
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = setCustomCredentialsConfiguration(d, configuration); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// setCustomCredentialsConfiguration sets the attributes shared by the resource and the data source
+func setCustomCredentialsConfiguration(d *schema.ResourceData, configuration *customCredentialsConfiguration) error {
+	var err error
+	if err = d.Set("name", configuration.Name); err != nil {
+		return fmt.Errorf("Error setting name: %s", err)
+	}
+	if err = d.Set("config_type", configuration.ConfigType); err != nil {
+		return fmt.Errorf("Error setting config_type: %s", err)
+	}
+	if err = d.Set("secret_type", configuration.SecretType); err != nil {
+		return fmt.Errorf("Error setting secret_type: %s", err)
+	}
+	if err = d.Set("created_by", configuration.CreatedBy); err != nil {
+		return fmt.Errorf("Error setting created_by: %s", err)
+	}
+	if err = d.Set("created_at", DateTimeToRFC3339(configuration.CreatedAt)); err != nil {
+		return fmt.Errorf("Error setting created_at: %s", err)
+	}
+	if err = d.Set("updated_at", DateTimeToRFC3339(configuration.UpdatedAt)); err != nil {
+		return fmt.Errorf("Error setting updated_at: %s", err)
+	}
+	if err = d.Set("api_key_ref", configuration.ApiKeyRef); err != nil {
+		return fmt.Errorf("Error setting api_key_ref: %s", err)
+	}
+	if err = d.Set("task_timeout", configuration.TaskTimeout); err != nil {
+		return fmt.Errorf("Error setting task_timeout: %s", err)
+	}
+
+	codeEngine := []map[string]interface{}{}
+	if configuration.CodeEngine != nil {
+		codeEngine = append(codeEngine, map[string]interface{}{
+			"project_id": configuration.CodeEngine.ProjectID,
+			"job_name":   configuration.CodeEngine.JobName,
+			"region":     configuration.CodeEngine.Region,
+		})
+	}
+	if err = d.Set("code_engine", codeEngine); err != nil {
+		return fmt.Errorf("Error setting code_engine: %s", err)
+	}
+
+	configSchema := []map[string]interface{}{}
+	if configuration.Schema != nil {
+		parameters := []map[string]interface{}{}
+		for _, parameter := range configuration.Schema.Parameters {
+			parameters = append(parameters, map[string]interface{}{
+				"name":              parameter.Name,
+				"format":            parameter.Format,
+				"env_variable_name": parameter.EnvVariableName,
+				"required":          parameter.Required,
+			})
+		}
+		credentials := []map[string]interface{}{}
+		for _, credential := range configuration.Schema.Credentials {
+			credentials = append(credentials, map[string]interface{}{
+				"name":     credential.Name,
+				"format":   credential.Format,
+				"required": credential.Required,
+			})
+		}
+		configSchema = append(configSchema, map[string]interface{}{
+			"parameters":  parameters,
+			"credentials": credentials,
+		})
+	}
+	if err = d.Set("schema", configSchema); err != nil {
+		return fmt.Errorf("Error setting schema: %s", err)
+	}
+
+	return nil
+}
+
+func resourceIbmSmCustomCredentialsConfigurationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := strings.Split(d.Id(), "/")
+	region := id[0]
+	instanceId := id[1]
+	configName := id[2]
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	hasChange := false
+
+	patchVals := map[string]interface{}{}
+
+	if d.HasChange("api_key_ref") {
+		patchVals["api_key_ref"] = d.Get("api_key_ref").(string)
+		hasChange = true
+	}
+	if d.HasChange("code_engine") {
+		patchVals["code_engine"] = resourceIbmSmCustomCredentialsConfigurationMapToCodeEngine(d.Get("code_engine").([]interface{})[0].(map[string]interface{}))
+		hasChange = true
+	}
+	if d.HasChange("task_timeout") {
+		patchVals["task_timeout"] = d.Get("task_timeout").(string)
+		hasChange = true
+	}
+
+	if hasChange {
+		response, err := smRawRequest(context, secretsManagerClient, core.PATCH, "/api/v2/configurations/{name}",
+			map[string]string{"name": configName},
+			map[string]string{"X-Sm-Accept-Configuration-Type": customCredentialsConfigType},
+			patchVals, &customCredentialsConfiguration{})
+		if err != nil {
+			log.Printf("[DEBUG] UpdateConfigurationWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateConfigurationWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIbmSmCustomCredentialsConfigurationRead(context, d, meta)
+}
+
+func resourceIbmSmCustomCredentialsConfigurationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := strings.Split(d.Id(), "/")
+	region := id[0]
+	instanceId := id[1]
+	configName := id[2]
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	deleteConfigurationOptions := &secretsmanagerv2.DeleteConfigurationOptions{}
+
+	deleteConfigurationOptions.SetName(configName)
+
+	response, err := secretsManagerClient.DeleteConfigurationWithContext(context, deleteConfigurationOptions)
+	if err != nil {
+		log.Printf("[DEBUG] DeleteConfigurationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteConfigurationWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIbmSmCustomCredentialsConfigurationMapToConfigurationPrototype(d *schema.ResourceData) *customCredentialsConfiguration {
+	model := &customCredentialsConfiguration{}
+
+	model.ConfigType = core.StringPtr(customCredentialsConfigType)
+
+	if _, ok := d.GetOk("name"); ok {
+		model.Name = core.StringPtr(d.Get("name").(string))
+	}
+	if _, ok := d.GetOk("api_key_ref"); ok {
+		model.ApiKeyRef = core.StringPtr(d.Get("api_key_ref").(string))
+	}
+	if _, ok := d.GetOk("code_engine"); ok {
+		model.CodeEngine = resourceIbmSmCustomCredentialsConfigurationMapToCodeEngine(d.Get("code_engine").([]interface{})[0].(map[string]interface{}))
+	}
+	if _, ok := d.GetOk("task_timeout"); ok {
+		model.TaskTimeout = core.StringPtr(d.Get("task_timeout").(string))
+	}
+	return model
+}
+
+func resourceIbmSmCustomCredentialsConfigurationMapToCodeEngine(modelMap map[string]interface{}) *customCredentialsConfigurationEngine {
+	return &customCredentialsConfigurationEngine{
+		ProjectID: core.StringPtr(modelMap["project_id"].(string)),
+		JobName:   core.StringPtr(modelMap["job_name"].(string)),
+		Region:    core.StringPtr(modelMap["region"].(string)),
+	}
+}
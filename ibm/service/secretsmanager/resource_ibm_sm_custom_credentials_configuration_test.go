@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+var customCredentialsConfigurationName = "terraform-test-custom-credentials-configuration"
+
+func TestAccIbmSmCustomCredentialsConfigurationBasic(t *testing.T) {
+	resourceName := "ibm_sm_custom_credentials_configuration.sm_custom_credentials_configuration"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmCustomCredentialsConfigurationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmCustomCredentialsConfigurationConfigBasic("5m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "config_type", "custom_credentials_configuration"),
+					resource.TestCheckResourceAttr(resourceName, "task_timeout", "5m"),
+					resource.TestCheckResourceAttr(resourceName, "code_engine.0.job_name", acc.SecretsManagerCustomCredentialsCodeEngineJobName),
+					resource.TestCheckResourceAttrSet(resourceName, "schema.#"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIbmSmCustomCredentialsConfigurationConfigBasic("10m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "task_timeout", "10m"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmCustomCredentialsConfigurationConfigBasic(taskTimeout string) string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_custom_credentials_configuration" "sm_custom_credentials_configuration" {
+			instance_id   = "%s"
+			region        = "%s"
+			name          = "%s"
+			api_key_ref   = "%s"
+			task_timeout  = "%s"
+			code_engine {
+				project_id = "%s"
+				job_name   = "%s"
+				region     = "%s"
+			}
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, customCredentialsConfigurationName,
+		acc.SecretsManagerCustomCredentialsApiKeyRef, taskTimeout, acc.SecretsManagerCustomCredentialsCodeEngineProjectId,
+		acc.SecretsManagerCustomCredentialsCodeEngineJobName, acc.SecretsManagerCustomCredentialsCodeEngineRegion)
+}
+
+func testAccCheckIbmSmCustomCredentialsConfigurationDestroy(s *terraform.State) error {
+	secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return err
+	}
+
+	secretsManagerClient = getClientWithInstanceEndpointTest(secretsManagerClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_sm_custom_credentials_configuration" {
+			continue
+		}
+
+		getConfigurationOptions := &secretsmanagerv2.GetConfigurationOptions{}
+
+		id := strings.Split(rs.Primary.ID, "/")
+		configName := id[2]
+		getConfigurationOptions.SetName(configName)
+
+		// The SDK cannot unmarshal the configuration, only the status code is checked
+		_, response, err := secretsManagerClient.GetConfiguration(getConfigurationOptions)
+
+		if response != nil && response.StatusCode != 404 {
+			return fmt.Errorf("CustomCredentialsConfiguration still exists: %s", rs.Primary.ID)
+		} else if response == nil && err != nil {
+			return fmt.Errorf("Error checking for CustomCredentialsConfiguration (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,666 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+// The SDK does not model the custom credentials secret type yet, these follow the API
+type customCredentialsSecret struct {
+	ID                    *string                          `json:"id,omitempty"`
+	Name                  *string                          `json:"name,omitempty"`
+	Description           *string                          `json:"description,omitempty"`
+	Labels                []string                         `json:"labels,omitempty"`
+	CustomMetadata        map[string]interface{}           `json:"custom_metadata,omitempty"`
+	VersionCustomMetadata map[string]interface{}           `json:"version_custom_metadata,omitempty"`
+	SecretGroupID         *string                          `json:"secret_group_id,omitempty"`
+	SecretType            *string                          `json:"secret_type,omitempty"`
+	Configuration         *string                          `json:"configuration,omitempty"`
+	Parameters            map[string]interface{}           `json:"parameters,omitempty"`
+	Rotation              *customCredentialsRotationPolicy `json:"rotation,omitempty"`
+	CredentialsContent    map[string]interface{}           `json:"credentials_content,omitempty"`
+	CreatedBy             *string                          `json:"created_by,omitempty"`
+	CreatedAt             *strfmt.DateTime                 `json:"created_at,omitempty"`
+	UpdatedAt             *strfmt.DateTime                 `json:"updated_at,omitempty"`
+	Crn                   *string                          `json:"crn,omitempty"`
+	Downloaded            *bool                            `json:"downloaded,omitempty"`
+	LocksTotal            *int64                           `json:"locks_total,omitempty"`
+	State                 *int64                           `json:"state,omitempty"`
+	StateDescription      *string                          `json:"state_description,omitempty"`
+	VersionsTotal         *int64                           `json:"versions_total,omitempty"`
+	ExpirationDate        *strfmt.DateTime                 `json:"expiration_date,omitempty"`
+	NextRotationDate      *strfmt.DateTime                 `json:"next_rotation_date,omitempty"`
+}
+
+type customCredentialsRotationPolicy struct {
+	AutoRotate *bool   `json:"auto_rotate,omitempty"`
+	Interval   *int64  `json:"interval,omitempty"`
+	Unit       *string `json:"unit,omitempty"`
+}
+
+func ResourceIbmSmCustomCredentialsSecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmCustomCredentialsSecretCreate,
+		ReadContext:   resourceIbmSmCustomCredentialsSecretRead,
+		UpdateContext: resourceIbmSmCustomCredentialsSecretUpdate,
+		DeleteContext: resourceIbmSmCustomCredentialsSecretDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"custom_metadata": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Computed:    true,
+				Description: "The secret metadata that a user can customize.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An extended description of your secret.To protect your privacy, do not use personal data, such as your name or location, as a description for your secret group.",
+			},
+			"labels": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Labels that you can use to search for secrets in your instance.Up to 30 labels can be created.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A human-readable name to assign to your secret.To protect your privacy, do not use personal data, such as your name or location, as a name for your secret.",
+			},
+			"secret_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "A v4 UUID identifier, or `default` secret group.",
+			},
+			"secret_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A v4 UUID identifier.",
+			},
+			"secret_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The secret type.",
+			},
+			"version_custom_metadata": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "The secret version metadata that a user can customize.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"configuration": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the custom credentials configuration.",
+			},
+			"parameters": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "The values of the parameters that the configuration declares, keyed by parameter name. Integer and boolean parameters are converted to the format of the configuration schema.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"rotation": &schema.Schema{
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Computed:    true,
+				Description: "Determines whether Secrets Manager rotates your secrets automatically.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_rotate": &schema.Schema{
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Determines whether Secrets Manager rotates your secret automatically.Default is `false`. If `auto_rotate` is set to `true` the service rotates your secret based on the defined interval.",
+						},
+						"interval": &schema.Schema{
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Computed:         true,
+							Description:      "The length of the secret rotation time interval.",
+							DiffSuppressFunc: rotationAttributesDiffSuppress,
+						},
+						"unit": &schema.Schema{
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							Description:      "The units for the secret rotation time interval.",
+							DiffSuppressFunc: rotationAttributesDiffSuppress,
+						},
+					},
+				},
+			},
+			"credentials_content": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The credentials that the Code Engine job created, keyed by credential name.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"created_by": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier that is associated with the entity that created the secret.",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when a resource was created. The date format follows RFC 3339.",
+			},
+			"crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A CRN that uniquely identifies an IBM Cloud resource.",
+			},
+			"downloaded": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether the secret data that is associated with a secret version was retrieved in a call to the service API.",
+			},
+			"locks_total": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of locks of the secret.",
+			},
+			"state": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The secret state that is based on NIST SP 800-57. States are integers and correspond to the `Pre-activation = 0`, `Active = 1`,  `Suspended = 2`, `Deactivated = 3`, and `Destroyed = 5` values.",
+			},
+			"state_description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A text representation of the secret state.",
+			},
+			"updated_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when a resource was recently modified. The date format follows RFC 3339.",
+			},
+			"versions_total": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of versions of the secret.",
+			},
+			"expiration_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date a secret is expired. The date format follows RFC 3339.",
+			},
+			"next_rotation_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date that the secret is scheduled for automatic rotation.The service automatically creates a new version of the secret on its next rotation date. This field exists only for secrets that have an existing rotation policy.",
+			},
+		},
+	}
+}
+
+func resourceIbmSmCustomCredentialsSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	secretPrototypeModel, err := resourceIbmSmCustomCredentialsSecretMapToSecretPrototype(context, secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secret := &customCredentialsSecret{}
+	response, err := smRawRequest(context, secretsManagerClient, core.POST, "/api/v2/secrets", nil, nil, secretPrototypeModel, secret)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateSecretWithContext failed %s\n%s", err, response))
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *secret.ID))
+	d.Set("secret_id", *secret.ID)
+
+	// The credentials are created asynchronously by the Code Engine job
+	_, err = waitForIbmSmCustomCredentialsSecretCreate(context, secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for resource IbmSmCustomCredentialsSecret (%s) to be created: %s", d.Id(), err))
+	}
+
+	return resourceIbmSmCustomCredentialsSecretRead(context, d, meta)
+}
+
+func waitForIbmSmCustomCredentialsSecretCreate(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
+	id := strings.Split(d.Id(), "/")
+	secretId := id[2]
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pre_activation"},
+		Target:  []string{"active"},
+		Refresh: func() (interface{}, string, error) {
+			stateObj, response, err := getCustomCredentialsSecret(context, secretsManagerClient, "/api/v2/secrets/{id}/metadata", secretId)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return nil, "", fmt.Errorf("The instance %s does not exist anymore: %s\n%s", "getSecretOptions", err, response)
+				}
+				return nil, "", err
+			}
+			failStates := map[string]bool{"destroyed": true}
+			if failStates[*stateObj.StateDescription] {
+				return stateObj, *stateObj.StateDescription, fmt.Errorf("The instance %s failed: %s\n%s", "getSecretOptions", err, response)
+			}
+			return stateObj, *stateObj.StateDescription, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+// getCustomCredentialsSecret gets a custom credentials secret or its metadata, depending on the path
+func getCustomCredentialsSecret(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, path string, secretId string) (*customCredentialsSecret, *core.DetailedResponse, error) {
+	secret := &customCredentialsSecret{}
+	response, err := smRawRequest(context, secretsManagerClient, core.GET, path, map[string]string{"id": secretId}, nil, nil, secret)
+	if err != nil {
+		return nil, response, err
+	}
+	if secret.SecretType == nil || *secret.SecretType != CustomCredentialsSecretType {
+		return nil, response, fmt.Errorf("The secret %s is not a custom credentials secret", secretId)
+	}
+	return secret, response, nil
+}
+
+func resourceIbmSmCustomCredentialsSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := strings.Split(d.Id(), "/")
+	if len(id) != 3 {
+		return diag.Errorf("Wrong format of resource ID. To import a secret use the format `<region>/<instance_id>/<secret_id>`")
+	}
+	region := id[0]
+	instanceId := id[1]
+	secretId := id[2]
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	secret, response, err := getCustomCredentialsSecret(context, secretsManagerClient, "/api/v2/secrets/{id}", secretId)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("secret_id", secretId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_id: %s", err))
+	}
+	if err = d.Set("instance_id", instanceId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance_id: %s", err))
+	}
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = setCustomCredentialsSecretMetadata(d, secret); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("credentials_content", flattenCustomCredentialsValues(secret.CredentialsContent)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting credentials_content: %s", err))
+	}
+
+	// Call get version metadata API to get the current version_custom_metadata
+	versionMetadata := &customCredentialsSecret{}
+	response, err = smRawRequest(context, secretsManagerClient, core.GET, "/api/v2/secrets/{secret_id}/versions/{id}/metadata",
+		map[string]string{"secret_id": secretId, "id": "current"}, nil, nil, versionMetadata)
+	if err != nil {
+		log.Printf("[DEBUG] GetSecretVersionMetadataWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetSecretVersionMetadataWithContext failed %s\n%s", err, response))
+	}
+	if versionMetadata.VersionCustomMetadata != nil {
+		if err = d.Set("version_custom_metadata", versionMetadata.VersionCustomMetadata); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting version_custom_metadata: %s", err))
+		}
+	}
+
+	return nil
+}
+
+// setCustomCredentialsSecretMetadata sets the metadata attributes shared by the resource and the data sources
+func setCustomCredentialsSecretMetadata(d *schema.ResourceData, secret *customCredentialsSecret) error {
+	var err error
+	if err = d.Set("created_by", secret.CreatedBy); err != nil {
+		return fmt.Errorf("Error setting created_by: %s", err)
+	}
+	if err = d.Set("created_at", DateTimeToRFC3339(secret.CreatedAt)); err != nil {
+		return fmt.Errorf("Error setting created_at: %s", err)
+	}
+	if err = d.Set("crn", secret.Crn); err != nil {
+		return fmt.Errorf("Error setting crn: %s", err)
+	}
+	if secret.CustomMetadata != nil {
+		d.Set("custom_metadata", flex.Flatten(secret.CustomMetadata))
+	}
+	if err = d.Set("description", secret.Description); err != nil {
+		return fmt.Errorf("Error setting description: %s", err)
+	}
+	if err = d.Set("downloaded", secret.Downloaded); err != nil {
+		return fmt.Errorf("Error setting downloaded: %s", err)
+	}
+	if secret.Labels != nil {
+		if err = d.Set("labels", secret.Labels); err != nil {
+			return fmt.Errorf("Error setting labels: %s", err)
+		}
+	}
+	if err = d.Set("locks_total", flex.IntValue(secret.LocksTotal)); err != nil {
+		return fmt.Errorf("Error setting locks_total: %s", err)
+	}
+	if err = d.Set("name", secret.Name); err != nil {
+		return fmt.Errorf("Error setting name: %s", err)
+	}
+	if err = d.Set("secret_group_id", secret.SecretGroupID); err != nil {
+		return fmt.Errorf("Error setting secret_group_id: %s", err)
+	}
+	if err = d.Set("secret_type", secret.SecretType); err != nil {
+		return fmt.Errorf("Error setting secret_type: %s", err)
+	}
+	if err = d.Set("state", flex.IntValue(secret.State)); err != nil {
+		return fmt.Errorf("Error setting state: %s", err)
+	}
+	if err = d.Set("state_description", secret.StateDescription); err != nil {
+		return fmt.Errorf("Error setting state_description: %s", err)
+	}
+	if err = d.Set("updated_at", DateTimeToRFC3339(secret.UpdatedAt)); err != nil {
+		return fmt.Errorf("Error setting updated_at: %s", err)
+	}
+	if err = d.Set("versions_total", flex.IntValue(secret.VersionsTotal)); err != nil {
+		return fmt.Errorf("Error setting versions_total: %s", err)
+	}
+	if err = d.Set("expiration_date", DateTimeToRFC3339(secret.ExpirationDate)); err != nil {
+		return fmt.Errorf("Error setting expiration_date: %s", err)
+	}
+	if err = d.Set("next_rotation_date", DateTimeToRFC3339(secret.NextRotationDate)); err != nil {
+		return fmt.Errorf("Error setting next_rotation_date: %s", err)
+	}
+	if err = d.Set("configuration", secret.Configuration); err != nil {
+		return fmt.Errorf("Error setting configuration: %s", err)
+	}
+	if err = d.Set("parameters", flattenCustomCredentialsValues(secret.Parameters)); err != nil {
+		return fmt.Errorf("Error setting parameters: %s", err)
+	}
+	rotation := []map[string]interface{}{}
+	if secret.Rotation != nil {
+		rotationMap := map[string]interface{}{}
+		if secret.Rotation.AutoRotate != nil {
+			rotationMap["auto_rotate"] = *secret.Rotation.AutoRotate
+		}
+		if secret.Rotation.Interval != nil {
+			rotationMap["interval"] = flex.IntValue(secret.Rotation.Interval)
+		}
+		if secret.Rotation.Unit != nil {
+			rotationMap["unit"] = *secret.Rotation.Unit
+		}
+		rotation = append(rotation, rotationMap)
+	}
+	if err = d.Set("rotation", rotation); err != nil {
+		return fmt.Errorf("Error setting rotation: %s", err)
+	}
+	return nil
+}
+
+func resourceIbmSmCustomCredentialsSecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := strings.Split(d.Id(), "/")
+	region := id[0]
+	instanceId := id[1]
+	secretId := id[2]
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	hasChange := false
+
+	patchVals := map[string]interface{}{}
+
+	if d.HasChange("name") {
+		patchVals["name"] = d.Get("name").(string)
+		hasChange = true
+	}
+	if d.HasChange("description") {
+		patchVals["description"] = d.Get("description").(string)
+		hasChange = true
+	}
+	if d.HasChange("labels") {
+		labels := d.Get("labels").([]interface{})
+		labelsParsed := make([]string, len(labels))
+		for i, v := range labels {
+			labelsParsed[i] = fmt.Sprint(v)
+		}
+		patchVals["labels"] = labelsParsed
+		hasChange = true
+	}
+	if d.HasChange("custom_metadata") {
+		patchVals["custom_metadata"] = d.Get("custom_metadata").(map[string]interface{})
+		hasChange = true
+	}
+	if d.HasChange("rotation") {
+		patchVals["rotation"] = resourceIbmSmCustomCredentialsSecretMapToRotationPolicy(d.Get("rotation").([]interface{})[0].(map[string]interface{}))
+		hasChange = true
+	}
+	if d.HasChange("parameters") {
+		parameters, err := resourceIbmSmCustomCredentialsSecretMapToParameters(context, secretsManagerClient, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		patchVals["parameters"] = parameters
+		hasChange = true
+	}
+
+	if hasChange {
+		response, err := smRawRequest(context, secretsManagerClient, core.PATCH, "/api/v2/secrets/{id}/metadata",
+			map[string]string{"id": secretId}, nil, patchVals, &customCredentialsSecret{})
+		if err != nil {
+			log.Printf("[DEBUG] UpdateSecretMetadataWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateSecretMetadataWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	if d.HasChange("version_custom_metadata") {
+		// Apply change to version_custom_metadata in current version
+		versionPatch := map[string]interface{}{
+			"version_custom_metadata": d.Get("version_custom_metadata").(map[string]interface{}),
+		}
+		response, err := smRawRequest(context, secretsManagerClient, core.PATCH, "/api/v2/secrets/{secret_id}/versions/{id}/metadata",
+			map[string]string{"secret_id": secretId, "id": "current"}, nil, versionPatch, &customCredentialsSecret{})
+		if err != nil {
+			if hasChange {
+				// Call the read function to update the Terraform state with the change already applied to the metadata
+				resourceIbmSmCustomCredentialsSecretRead(context, d, meta)
+			}
+			log.Printf("[DEBUG] UpdateSecretVersionMetadataWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateSecretVersionMetadataWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIbmSmCustomCredentialsSecretRead(context, d, meta)
+}
+
+func resourceIbmSmCustomCredentialsSecretDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := strings.Split(d.Id(), "/")
+	region := id[0]
+	instanceId := id[1]
+	secretId := id[2]
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	deleteSecretOptions := &secretsmanagerv2.DeleteSecretOptions{}
+
+	deleteSecretOptions.SetID(secretId)
+
+	response, err := secretsManagerClient.DeleteSecretWithContext(context, deleteSecretOptions)
+	if err != nil {
+		log.Printf("[DEBUG] DeleteSecretWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteSecretWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIbmSmCustomCredentialsSecretMapToSecretPrototype(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (*customCredentialsSecret, error) {
+	model := &customCredentialsSecret{}
+	model.SecretType = core.StringPtr(CustomCredentialsSecretType)
+
+	if _, ok := d.GetOk("name"); ok {
+		model.Name = core.StringPtr(d.Get("name").(string))
+	}
+	if _, ok := d.GetOk("custom_metadata"); ok {
+		model.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
+	}
+	if _, ok := d.GetOk("description"); ok {
+		model.Description = core.StringPtr(d.Get("description").(string))
+	}
+	if _, ok := d.GetOk("labels"); ok {
+		labels := d.Get("labels").([]interface{})
+		labelsParsed := make([]string, len(labels))
+		for i, v := range labels {
+			labelsParsed[i] = fmt.Sprint(v)
+		}
+		model.Labels = labelsParsed
+	}
+	if _, ok := d.GetOk("secret_group_id"); ok {
+		model.SecretGroupID = core.StringPtr(d.Get("secret_group_id").(string))
+	}
+	if _, ok := d.GetOk("version_custom_metadata"); ok {
+		model.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
+	}
+	model.Configuration = core.StringPtr(d.Get("configuration").(string))
+	if _, ok := d.GetOk("parameters"); ok {
+		parameters, err := resourceIbmSmCustomCredentialsSecretMapToParameters(context, secretsManagerClient, d)
+		if err != nil {
+			return model, err
+		}
+		model.Parameters = parameters
+	}
+	if _, ok := d.GetOk("rotation"); ok {
+		model.Rotation = resourceIbmSmCustomCredentialsSecretMapToRotationPolicy(d.Get("rotation").([]interface{})[0].(map[string]interface{}))
+	}
+
+	return model, nil
+}
+
+// resourceIbmSmCustomCredentialsSecretMapToParameters converts the string parameters of the
+// configuration to the format that its schema declares, for example `required:true type:integer`
+func resourceIbmSmCustomCredentialsSecretMapToParameters(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (map[string]interface{}, error) {
+	configName := d.Get("configuration").(string)
+	configuration, response, err := getCustomCredentialsConfiguration(context, secretsManagerClient, configName)
+	if err != nil {
+		return nil, fmt.Errorf("GetConfigurationWithContext failed %s\n%s", err, response)
+	}
+
+	formats := map[string]string{}
+	if configuration.Schema != nil {
+		for _, parameter := range configuration.Schema.Parameters {
+			if parameter.Name != nil && parameter.Format != nil {
+				formats[*parameter.Name] = *parameter.Format
+			}
+		}
+	}
+
+	parameters := map[string]interface{}{}
+	for name, value := range d.Get("parameters").(map[string]interface{}) {
+		format, ok := formats[name]
+		if !ok {
+			return nil, fmt.Errorf("The parameter %q is not declared by the configuration %s", name, configName)
+		}
+		switch {
+		case strings.Contains(format, "type:integer"):
+			v, err := strconv.ParseInt(value.(string), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("The parameter %q must be an integer: %s", name, err)
+			}
+			parameters[name] = v
+		case strings.Contains(format, "type:boolean"):
+			v, err := strconv.ParseBool(value.(string))
+			if err != nil {
+				return nil, fmt.Errorf("The parameter %q must be a boolean: %s", name, err)
+			}
+			parameters[name] = v
+		default:
+			parameters[name] = value.(string)
+		}
+	}
+	return parameters, nil
+}
+
+func resourceIbmSmCustomCredentialsSecretMapToRotationPolicy(modelMap map[string]interface{}) *customCredentialsRotationPolicy {
+	model := &customCredentialsRotationPolicy{}
+	if modelMap["auto_rotate"] != nil {
+		model.AutoRotate = core.BoolPtr(modelMap["auto_rotate"].(bool))
+	}
+	if modelMap["interval"].(int) != 0 {
+		model.Interval = core.Int64Ptr(int64(modelMap["interval"].(int)))
+	}
+	if modelMap["unit"] != nil && modelMap["unit"].(string) != "" {
+		model.Unit = core.StringPtr(modelMap["unit"].(string))
+	}
+	return model
+}
+
+// flattenCustomCredentialsValues flattens parameters and credentials to strings, nested values are JSON encoded
+func flattenCustomCredentialsValues(values map[string]interface{}) map[string]interface{} {
+	flattened := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		switch v := v.(type) {
+		case string:
+			flattened[k] = v
+		case float64:
+			flattened[k] = strconv.FormatFloat(v, 'f', -1, 64)
+		case map[string]interface{}, []interface{}:
+			b, _ := json.Marshal(v)
+			flattened[k] = string(b)
+		default:
+			flattened[k] = fmt.Sprint(v)
+		}
+	}
+	return flattened
+}
@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+var customCredentialsSecretName = "terraform-test-custom-credentials-secret"
+var modifiedCustomCredentialsSecretName = "modified-terraform-test-custom-credentials-secret"
+
+func TestAccIbmSmCustomCredentialsSecretBasic(t *testing.T) {
+	resourceName := "ibm_sm_custom_credentials_secret.sm_custom_credentials_secret"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmCustomCredentialsSecretDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: customCredentialsSecretConfig(customCredentialsSecretName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "secret_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "crn"),
+					resource.TestCheckResourceAttr(resourceName, "secret_type", "custom_credentials"),
+					resource.TestCheckResourceAttr(resourceName, "state", "1"),
+					resource.TestCheckResourceAttr(resourceName, "versions_total", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration", customCredentialsConfigurationName),
+					resource.TestCheckResourceAttrSet(resourceName, "credentials_content.%"),
+				),
+			},
+			resource.TestStep{
+				Config: customCredentialsSecretConfig(modifiedCustomCredentialsSecretName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", modifiedCustomCredentialsSecretName),
+					resource.TestCheckResourceAttr(resourceName, "rotation.0.auto_rotate", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rotation.0.interval", "30"),
+					resource.TestCheckResourceAttr(resourceName, "rotation.0.unit", "day"),
+					resource.TestCheckResourceAttrSet(resourceName, "next_rotation_date"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func customCredentialsSecretConfig(name string, autoRotate bool) string {
+	return testAccCheckIbmSmCustomCredentialsConfigurationConfigBasic("5m") + fmt.Sprintf(`
+		resource "ibm_sm_custom_credentials_secret" "sm_custom_credentials_secret" {
+			instance_id     = "%s"
+			region          = "%s"
+			name            = "%s"
+			description     = "Extended description for this secret."
+			labels          = ["my-label"]
+			secret_group_id = "default"
+			configuration   = ibm_sm_custom_credentials_configuration.sm_custom_credentials_configuration.name
+			rotation {
+				auto_rotate = %t
+				interval    = 30
+				unit        = "day"
+			}
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, name, autoRotate)
+}
+
+func testAccCheckIbmSmCustomCredentialsSecretDestroy(s *terraform.State) error {
+	secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return err
+	}
+
+	secretsManagerClient = getClientWithInstanceEndpointTest(secretsManagerClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_sm_custom_credentials_secret" {
+			continue
+		}
+
+		getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+
+		id := strings.Split(rs.Primary.ID, "/")
+		secretId := id[2]
+		getSecretOptions.SetID(secretId)
+
+		// The SDK cannot unmarshal the secret, only the status code is checked
+		_, response, err := secretsManagerClient.GetSecret(getSecretOptions)
+
+		if response != nil && response.StatusCode != 404 {
+			return fmt.Errorf("CustomCredentialsSecret still exists: %s", rs.Primary.ID)
+		} else if response == nil && err != nil {
+			return fmt.Errorf("Error checking for CustomCredentialsSecret (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -106,9 +106,10 @@ func ResourceIbmSmImportedCertificate() *schema.Resource {
 				Description: "(Optional) The PEM-encoded intermediate certificate to associate with the root certificate.",
 			},
 			"private_key": &schema.Schema{
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"private_key_wo"},
 				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
 					if removeNewLineFromCertificate(oldValue) == removeNewLineFromCertificate(newValue) {
 						return true
@@ -117,6 +118,21 @@ func ResourceIbmSmImportedCertificate() *schema.Resource {
 				},
 				Description: "(Optional) The PEM-encoded private key to associate with the certificate.",
 			},
+			"private_key_wo": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"private_key"},
+				RequiredWith:  []string{"private_key_wo_version"},
+				Description:   "(Optional) The PEM-encoded private key to associate with the certificate, as a write-only value that is never stored in the Terraform plan or state. Requires Terraform 1.11 or later.",
+			},
+			"private_key_wo_version": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"private_key_wo"},
+				Description:  "The version of the write-only private key. Increment it to create a new secret version from the current `private_key_wo` value.",
+			},
 			"common_name": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -414,8 +430,11 @@ func resourceIbmSmImportedCertificateRead(context context.Context, d *schema.Res
 	if err = d.Set("intermediate", secret.Intermediate); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting intermediate: %s", err))
 	}
-	if err = d.Set("private_key", secret.PrivateKey); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting private_key: %s", err))
+	// The private key is not kept in the state when it is set through the write-only argument
+	if _, ok := d.GetOk("private_key_wo_version"); !ok {
+		if err = d.Set("private_key", secret.PrivateKey); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting private_key: %s", err))
+		}
 	}
 
 	// Call get version metadata API to get the current version_custom_metadata
@@ -492,14 +511,18 @@ func resourceIbmSmImportedCertificateUpdate(context context.Context, d *schema.R
 	}
 
 	// Apply change in secret data (if changed)
-	if d.HasChange("certificate") || d.HasChange("intermediate") || d.HasChange("private_key") {
+	if d.HasChange("certificate") || d.HasChange("intermediate") || d.HasChange("private_key") || d.HasChange("private_key_wo_version") {
 		versionModel := &secretsmanagerv2.ImportedCertificateVersionPrototype{}
 		versionModel.Certificate = core.StringPtr(d.Get("certificate").(string))
 		if _, ok := d.GetOk("intermediate"); ok {
 			versionModel.Intermediate = core.StringPtr(formatCertificate(d.Get("intermediate").(string)))
 		}
-		if _, ok := d.GetOk("private_key"); ok {
-			versionModel.PrivateKey = core.StringPtr(formatCertificate(d.Get("private_key").(string)))
+		privateKey, err := getImportedCertificatePrivateKey(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if privateKey != "" {
+			versionModel.PrivateKey = core.StringPtr(formatCertificate(privateKey))
 		}
 		if _, ok := d.GetOk("version_custom_metadata"); ok {
 			versionModel.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
@@ -606,8 +629,12 @@ func resourceIbmSmImportedCertificateMapToSecretPrototype(d *schema.ResourceData
 		model.Intermediate = core.StringPtr(formatCertificate(d.Get("intermediate").(string)))
 	}
 
-	if _, ok := d.GetOk("private_key"); ok {
-		model.PrivateKey = core.StringPtr(formatCertificate(d.Get("private_key").(string)))
+	privateKey, err := getImportedCertificatePrivateKey(d)
+	if err != nil {
+		return nil, err
+	}
+	if privateKey != "" {
+		model.PrivateKey = core.StringPtr(formatCertificate(privateKey))
 	}
 
 	return model, nil
@@ -677,3 +704,12 @@ func formatCertificate(originalCert string) string {
 	}
 	return certParsed
 }
+
+// getImportedCertificatePrivateKey returns the private key from either the private_key argument
+// or its write-only counterpart
+func getImportedCertificatePrivateKey(d *schema.ResourceData) (string, error) {
+	if _, ok := d.GetOk("private_key_wo_version"); ok {
+		return getWriteOnlyString(d, "private_key_wo")
+	}
+	return d.Get("private_key").(string), nil
+}
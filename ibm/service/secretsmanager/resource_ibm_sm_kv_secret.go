@@ -5,6 +5,7 @@ package secretsmanager
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -59,11 +60,26 @@ func ResourceIbmSmKvSecret() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"data": &schema.Schema{
-				Type:        schema.TypeMap,
-				Required:    true,
-				Sensitive:   true,
-				Description: "The payload data of a key-value secret.",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"data", "data_wo"},
+				Description:  "The payload data of a key-value secret.",
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+			"data_wo": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				RequiredWith: []string{"data_wo_version"},
+				Description:  "The JSON encoded payload data of a key-value secret, as a write-only value that is never stored in the Terraform plan or state. Requires Terraform 1.11 or later.",
+			},
+			"data_wo_version": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"data_wo"},
+				Description:  "The version of the write-only payload data. Increment it to create a new secret version from the current `data_wo` value.",
 			},
 			"custom_metadata": &schema.Schema{
 				Type:        schema.TypeMap,
@@ -290,7 +306,8 @@ func resourceIbmSmKvSecretRead(context context.Context, d *schema.ResourceData,
 			return diag.FromErr(fmt.Errorf("Error setting labels: %s", err))
 		}
 	}
-	if secret.Data != nil {
+	// The data is not kept in the state when it is set through the write-only argument
+	if _, ok := d.GetOk("data_wo_version"); !ok && secret.Data != nil {
 		d.Set("data", secret.Data)
 	}
 
@@ -368,9 +385,13 @@ func resourceIbmSmKvSecretUpdate(context context.Context, d *schema.ResourceData
 	}
 
 	// Apply change in secret data (if changed)
-	if d.HasChange("data") {
+	if d.HasChange("data") || d.HasChange("data_wo_version") {
 		versionModel := &secretsmanagerv2.KVSecretVersionPrototype{}
-		versionModel.Data = d.Get("data").(map[string]interface{})
+		data, err := getKvSecretData(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		versionModel.Data = data
 		if _, ok := d.GetOk("version_custom_metadata"); ok {
 			versionModel.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
 		}
@@ -463,8 +484,12 @@ func resourceIbmSmKvSecretMapToSecretPrototype(d *schema.ResourceData) (*secrets
 		}
 		model.Labels = labelsParsed
 	}
-	if _, ok := d.GetOk("data"); ok {
-		model.Data = d.Get("data").(map[string]interface{})
+	data, err := getKvSecretData(d)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > 0 {
+		model.Data = data
 	}
 	if _, ok := d.GetOk("custom_metadata"); ok {
 		model.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
@@ -474,3 +499,20 @@ func resourceIbmSmKvSecretMapToSecretPrototype(d *schema.ResourceData) (*secrets
 	}
 	return model, nil
 }
+
+// getKvSecretData returns the secret data from either the data argument or the JSON encoded
+// write-only data
+func getKvSecretData(d *schema.ResourceData) (map[string]interface{}, error) {
+	if _, ok := d.GetOk("data_wo_version"); ok {
+		dataJson, err := getWriteOnlyString(d, "data_wo")
+		if err != nil {
+			return nil, err
+		}
+		data := map[string]interface{}{}
+		if err = json.Unmarshal([]byte(dataJson), &data); err != nil {
+			return nil, fmt.Errorf("Error parsing data_wo: %s", err)
+		}
+		return data, nil
+	}
+	return d.Get("data").(map[string]interface{}), nil
+}
@@ -0,0 +1,776 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+const (
+	smSecretReplicaSourceCrnKey     = "replicated_from_secret_crn"
+	smSecretReplicaSourceVersionKey = "replicated_from_version_id"
+)
+
+// smSecretReplicaSecret is the metadata that is kept in sync between the source secret and its replicas
+type smSecretReplicaSecret struct {
+	secretType     string
+	crn            string
+	name           string
+	description    string
+	labels         []string
+	customMetadata map[string]interface{}
+}
+
+// smSecretReplicaVersion is a version of the source secret or of a replica. The secret data is only
+// set for the versions of the source secret.
+type smSecretReplicaVersion struct {
+	id                    string
+	versionCustomMetadata map[string]interface{}
+	payload               *string
+	data                  map[string]interface{}
+	username              *string
+	password              *string
+	certificate           *string
+	intermediate          *string
+	privateKey            *string
+}
+
+func ResourceIbmSmSecretReplica() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmSecretReplicaCreate,
+		ReadContext:   resourceIbmSmSecretReplicaRead,
+		UpdateContext: resourceIbmSmSecretReplicaUpdate,
+		DeleteContext: resourceIbmSmSecretReplicaDelete,
+		CustomizeDiff: resourceIbmSmSecretReplicaCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIbmSmSecretReplicaImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the source secret. Supported secret types are arbitrary, kv, username_password and imported_cert.",
+			},
+			"targets": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The Secrets Manager instances that the source secret is replicated to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the target Secrets Manager instance.",
+						},
+						"region": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The region of the target Secrets Manager instance.",
+						},
+						"endpoint_type": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "public or private.",
+						},
+						"secret_group_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "default",
+							Description: "The ID of the secret group of the replica in the target instance.",
+						},
+						"secret_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the replica in the target instance.",
+						},
+						"version_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the current version of the replica.",
+						},
+						"source_version_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the version of the source secret that the current version of the replica was copied from.",
+						},
+					},
+				},
+			},
+			"secret_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The secret type of the source secret.",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the source secret.",
+			},
+			"crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the source secret.",
+			},
+			"source_version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the current version of the source secret.",
+			},
+			"in_sync": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether all the replicas have the current version, labels and custom metadata of the source secret.",
+			},
+		},
+	}
+}
+
+// resourceIbmSmSecretReplicaCustomizeDiff plans an update when a replica drifted from the source secret,
+// so that the next apply copies the latest version and metadata again
+func resourceIbmSmSecretReplicaCustomizeDiff(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.Get("in_sync").(bool) {
+		return d.SetNew("in_sync", true)
+	}
+	return nil
+}
+
+// resourceIbmSmSecretReplicaImport imports the replicas of a secret from an ID in the format
+// `<region>/<instance_id>/<secret_id>/<target_region>:<target_instance_id>:<replica_id>[/...]`, since
+// the replicas cannot be looked up from the source secret
+func resourceIbmSmSecretReplicaImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return nil, err
+	}
+
+	id := strings.Split(d.Id(), "/")
+	if len(id) < 4 {
+		return nil, fmt.Errorf("Wrong format of import ID. The ID should be in the format `<region>/<instance_id>/<secret_id>/<target_region>:<target_instance_id>:<replica_id>`, with one segment per target")
+	}
+
+	targets := make([]interface{}, 0, len(id)-3)
+	for _, t := range id[3:] {
+		parts := strings.Split(t, ":")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("Wrong format of import target %s. The target should be in the format `<target_region>:<target_instance_id>:<replica_id>`", t)
+		}
+		target := map[string]interface{}{
+			"region":      parts[0],
+			"instance_id": parts[1],
+			"secret_id":   parts[2],
+		}
+		targetClient := getSmSecretReplicaTargetClient(secretsManagerClient, target)
+		getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
+		getSecretMetadataOptions.SetID(parts[2])
+		replicaIntf, response, err := targetClient.GetSecretMetadataWithContext(context, getSecretMetadataOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetSecretMetadataWithContext failed %s\n%s", err, response)
+			return nil, fmt.Errorf("GetSecretMetadataWithContext failed for the replica in instance %s: %s\n%s", parts[1], err, response)
+		}
+		target["secret_group_id"] = smSecretReplicaSecretGroupId(replicaIntf)
+		targets = append(targets, target)
+	}
+
+	d.SetId(strings.Join(id[:3], "/"))
+	if err = d.Set("targets", targets); err != nil {
+		return nil, fmt.Errorf("Error setting targets: %s", err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceIbmSmSecretReplicaCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretId := d.Get("secret_id").(string)
+	sourceClient := getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	source, version, response, err := getSmSecretReplicaSource(context, sourceClient, secretId, true)
+	if err != nil {
+		log.Printf("[DEBUG] GetSecretVersionWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetSecretVersionWithContext failed %s\n%s", err, response))
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, secretId))
+
+	targets := d.Get("targets").([]interface{})
+	for i, t := range targets {
+		target := t.(map[string]interface{})
+		targetClient := getSmSecretReplicaTargetClient(secretsManagerClient, target)
+		replicaId, response, err := createSmSecretReplica(context, targetClient, target["secret_group_id"].(string), source, version)
+		if err != nil {
+			// Keep the replicas that were already created in the state, so that they are deleted with the resource
+			d.Set("targets", targets)
+			log.Printf("[DEBUG] CreateSecretWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("CreateSecretWithContext failed for the replica in instance %s: %s\n%s", target["instance_id"], err, response))
+		}
+		target["secret_id"] = replicaId
+		targets[i] = target
+	}
+	if err = d.Set("targets", targets); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting targets: %s", err))
+	}
+
+	return resourceIbmSmSecretReplicaRead(context, d, meta)
+}
+
+func resourceIbmSmSecretReplicaRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := strings.Split(d.Id(), "/")
+	if len(id) != 3 {
+		return diag.Errorf("Wrong format of resource ID. The ID should be in the format `<region>/<instance_id>/<secret_id>`")
+	}
+	region := id[0]
+	instanceId := id[1]
+	secretId := id[2]
+	sourceClient := getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	source, version, response, err := getSmSecretReplicaSource(context, sourceClient, secretId, false)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetSecretMetadataWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetSecretMetadataWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("secret_id", secretId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_id: %s", err))
+	}
+	if err = d.Set("instance_id", instanceId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance_id: %s", err))
+	}
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = d.Set("secret_type", source.secretType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_type: %s", err))
+	}
+	if err = d.Set("name", source.name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("crn", source.crn); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting crn: %s", err))
+	}
+	if err = d.Set("source_version_id", version.id); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting source_version_id: %s", err))
+	}
+
+	inSync := true
+	targets := d.Get("targets").([]interface{})
+	for i, t := range targets {
+		target := t.(map[string]interface{})
+		replicaId, _ := target["secret_id"].(string)
+		if replicaId == "" {
+			inSync = false
+			continue
+		}
+		targetClient := getSmSecretReplicaTargetClient(secretsManagerClient, target)
+		replica, replicaVersion, response, err := getSmSecretReplica(context, targetClient, replicaId)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				log.Printf("[WARN] The replica %s of secret %s was deleted from instance %s", replicaId, secretId, target["instance_id"])
+				target["secret_id"] = ""
+				target["version_id"] = ""
+				target["source_version_id"] = ""
+				targets[i] = target
+				inSync = false
+				continue
+			}
+			log.Printf("[DEBUG] GetSecretMetadataWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("GetSecretMetadataWithContext failed for the replica in instance %s: %s\n%s", target["instance_id"], err, response))
+		}
+
+		target["version_id"] = replicaVersion.id
+		target["source_version_id"] = smSecretReplicaSourceVersionId(replicaVersion)
+		targets[i] = target
+
+		if target["source_version_id"] != version.id || !smSecretReplicaMetadataEqual(source, replica) {
+			log.Printf("[DEBUG] The replica %s of secret %s in instance %s is not in sync", replicaId, secretId, target["instance_id"])
+			inSync = false
+		}
+	}
+	if err = d.Set("targets", targets); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting targets: %s", err))
+	}
+	if err = d.Set("in_sync", inSync); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting in_sync: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmSmSecretReplicaUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := strings.Split(d.Id(), "/")
+	region := id[0]
+	instanceId := id[1]
+	secretId := id[2]
+	sourceClient := getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	source, version, response, err := getSmSecretReplicaSource(context, sourceClient, secretId, true)
+	if err != nil {
+		log.Printf("[DEBUG] GetSecretVersionWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetSecretVersionWithContext failed %s\n%s", err, response))
+	}
+
+	// The targets are matched by instance, so that adding or removing a target only creates or
+	// deletes its own replica
+	oldTargets, newTargets := d.GetChange("targets")
+	existing := map[string]map[string]interface{}{}
+	for _, t := range oldTargets.([]interface{}) {
+		target := t.(map[string]interface{})
+		existing[target["instance_id"].(string)] = target
+	}
+	targets := newTargets.([]interface{})
+	for i, t := range targets {
+		target := t.(map[string]interface{})
+		target["secret_id"] = ""
+		target["version_id"] = ""
+		target["source_version_id"] = ""
+		if old, ok := existing[target["instance_id"].(string)]; ok {
+			// A replica cannot be moved to another secret group, it is created again in the new one
+			if old["secret_group_id"] == target["secret_group_id"] {
+				target["secret_id"] = old["secret_id"]
+				delete(existing, target["instance_id"].(string))
+			}
+		}
+		targets[i] = target
+	}
+
+	// remaining returns the targets whose replicas exist at this point, for the state to track them
+	// when the update fails
+	remaining := func() []interface{} {
+		result := []interface{}{}
+		for _, t := range targets {
+			if t.(map[string]interface{})["secret_id"] != "" {
+				result = append(result, t)
+			}
+		}
+		for _, old := range existing {
+			result = append(result, old)
+		}
+		return result
+	}
+
+	for instance, old := range existing {
+		replicaId, _ := old["secret_id"].(string)
+		if replicaId != "" {
+			response, err = deleteSmSecretReplica(context, getSmSecretReplicaTargetClient(secretsManagerClient, old), replicaId)
+			if err != nil {
+				d.Set("targets", remaining())
+				log.Printf("[DEBUG] DeleteSecretWithContext failed %s\n%s", err, response)
+				return diag.FromErr(fmt.Errorf("DeleteSecretWithContext failed for the replica in instance %s: %s\n%s", instance, err, response))
+			}
+		}
+		delete(existing, instance)
+	}
+
+	for i, t := range targets {
+		target := t.(map[string]interface{})
+		targetClient := getSmSecretReplicaTargetClient(secretsManagerClient, target)
+		replicaId, _ := target["secret_id"].(string)
+		if replicaId != "" {
+			response, err = syncSmSecretReplica(context, targetClient, replicaId, source, version)
+			if err == nil {
+				continue
+			}
+			if response == nil || response.StatusCode != 404 {
+				d.Set("targets", remaining())
+				log.Printf("[DEBUG] UpdateSecretMetadataWithContext failed %s\n%s", err, response)
+				return diag.FromErr(fmt.Errorf("UpdateSecretMetadataWithContext failed for the replica in instance %s: %s\n%s", target["instance_id"], err, response))
+			}
+			target["secret_id"] = ""
+		}
+
+		// The replica is new or does not exist anymore, create it
+		replicaId, response, err = createSmSecretReplica(context, targetClient, target["secret_group_id"].(string), source, version)
+		if err != nil {
+			d.Set("targets", remaining())
+			log.Printf("[DEBUG] CreateSecretWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("CreateSecretWithContext failed for the replica in instance %s: %s\n%s", target["instance_id"], err, response))
+		}
+		target["secret_id"] = replicaId
+		targets[i] = target
+	}
+	if err = d.Set("targets", targets); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting targets: %s", err))
+	}
+
+	return resourceIbmSmSecretReplicaRead(context, d, meta)
+}
+
+func resourceIbmSmSecretReplicaDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The source secret is not affected, only its replicas are deleted
+	for _, t := range d.Get("targets").([]interface{}) {
+		target := t.(map[string]interface{})
+		replicaId, _ := target["secret_id"].(string)
+		if replicaId == "" {
+			continue
+		}
+		response, err := deleteSmSecretReplica(context, getSmSecretReplicaTargetClient(secretsManagerClient, target), replicaId)
+		if err != nil {
+			log.Printf("[DEBUG] DeleteSecretWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("DeleteSecretWithContext failed for the replica in instance %s: %s\n%s", target["instance_id"], err, response))
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// getSmSecretReplicaTargetClient returns a client for the instance of a replica, defaulting the region
+// and the endpoint type to the ones of the provider configuration
+func getSmSecretReplicaTargetClient(originalClient *secretsmanagerv2.SecretsManagerV2, target map[string]interface{}) *secretsmanagerv2.SecretsManagerV2 {
+	region, _ := target["region"].(string)
+	if region == "" {
+		region = getDefaultRegion(originalClient)
+		target["region"] = region
+	}
+	endpointType, _ := target["endpoint_type"].(string)
+	if endpointType == "" {
+		endpointType = getDefaultEndpointType(originalClient)
+	}
+	return getClientWithInstanceEndpoint(originalClient, target["instance_id"].(string), region, endpointType)
+}
+
+// getSmSecretReplicaSource gets the metadata of the source secret and its current version, including the
+// secret data of the version when withData is set
+func getSmSecretReplicaSource(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, secretId string, withData bool) (*smSecretReplicaSecret, *smSecretReplicaVersion, *core.DetailedResponse, error) {
+	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
+	getSecretMetadataOptions.SetID(secretId)
+	secretIntf, response, err := secretsManagerClient.GetSecretMetadataWithContext(context, getSecretMetadataOptions)
+	if err != nil {
+		return nil, nil, response, err
+	}
+	secret, err := smSecretReplicaSecretFromMetadata(secretId, secretIntf)
+	if err != nil {
+		return nil, nil, response, err
+	}
+
+	if !withData {
+		version, response, err := getSmSecretReplicaVersionMetadata(context, secretsManagerClient, secretId)
+		return secret, version, response, err
+	}
+
+	getSecretVersionOptions := &secretsmanagerv2.GetSecretVersionOptions{}
+	getSecretVersionOptions.SetSecretID(secretId)
+	getSecretVersionOptions.SetID("current")
+	versionIntf, response, err := secretsManagerClient.GetSecretVersionWithContext(context, getSecretVersionOptions)
+	if err != nil {
+		return nil, nil, response, err
+	}
+	version := &smSecretReplicaVersion{}
+	switch v := versionIntf.(type) {
+	case *secretsmanagerv2.ArbitrarySecretVersion:
+		version.id, version.versionCustomMetadata = *v.ID, v.VersionCustomMetadata
+		version.payload = v.Payload
+	case *secretsmanagerv2.KVSecretVersion:
+		version.id, version.versionCustomMetadata = *v.ID, v.VersionCustomMetadata
+		version.data = v.Data
+	case *secretsmanagerv2.UsernamePasswordSecretVersion:
+		version.id, version.versionCustomMetadata = *v.ID, v.VersionCustomMetadata
+		version.username = v.Username
+		version.password = v.Password
+	case *secretsmanagerv2.ImportedCertificateVersion:
+		version.id, version.versionCustomMetadata = *v.ID, v.VersionCustomMetadata
+		version.certificate = v.Certificate
+		version.intermediate = v.Intermediate
+		version.privateKey = v.PrivateKey
+	default:
+		return nil, nil, response, fmt.Errorf("Unexpected version of secret %s", secretId)
+	}
+	return secret, version, response, nil
+}
+
+// getSmSecretReplica gets the metadata of a replica and of its current version
+func getSmSecretReplica(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, replicaId string) (*smSecretReplicaSecret, *smSecretReplicaVersion, *core.DetailedResponse, error) {
+	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
+	getSecretMetadataOptions.SetID(replicaId)
+	replicaIntf, response, err := secretsManagerClient.GetSecretMetadataWithContext(context, getSecretMetadataOptions)
+	if err != nil {
+		return nil, nil, response, err
+	}
+	replica, err := smSecretReplicaSecretFromMetadata(replicaId, replicaIntf)
+	if err != nil {
+		return nil, nil, response, err
+	}
+	version, response, err := getSmSecretReplicaVersionMetadata(context, secretsManagerClient, replicaId)
+	if err != nil {
+		return nil, nil, response, err
+	}
+	return replica, version, response, nil
+}
+
+// getSmSecretReplicaVersionMetadata gets the ID and the version custom metadata of the current version of a secret
+func getSmSecretReplicaVersionMetadata(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, secretId string) (*smSecretReplicaVersion, *core.DetailedResponse, error) {
+	getSecretVersionMetadataOptions := &secretsmanagerv2.GetSecretVersionMetadataOptions{}
+	getSecretVersionMetadataOptions.SetSecretID(secretId)
+	getSecretVersionMetadataOptions.SetID("current")
+	versionIntf, response, err := secretsManagerClient.GetSecretVersionMetadataWithContext(context, getSecretVersionMetadataOptions)
+	if err != nil {
+		return nil, response, err
+	}
+	switch v := versionIntf.(type) {
+	case *secretsmanagerv2.ArbitrarySecretVersionMetadata:
+		return &smSecretReplicaVersion{id: *v.ID, versionCustomMetadata: v.VersionCustomMetadata}, response, nil
+	case *secretsmanagerv2.KVSecretVersionMetadata:
+		return &smSecretReplicaVersion{id: *v.ID, versionCustomMetadata: v.VersionCustomMetadata}, response, nil
+	case *secretsmanagerv2.UsernamePasswordSecretVersionMetadata:
+		return &smSecretReplicaVersion{id: *v.ID, versionCustomMetadata: v.VersionCustomMetadata}, response, nil
+	case *secretsmanagerv2.ImportedCertificateVersionMetadata:
+		return &smSecretReplicaVersion{id: *v.ID, versionCustomMetadata: v.VersionCustomMetadata}, response, nil
+	}
+	return nil, response, fmt.Errorf("Unexpected version metadata of secret %s", secretId)
+}
+
+// smSecretReplicaSecretFromMetadata returns the replicated metadata of a secret, or an error when the
+// secret type cannot be replicated
+func smSecretReplicaSecretFromMetadata(secretId string, secretIntf secretsmanagerv2.SecretMetadataIntf) (*smSecretReplicaSecret, error) {
+	switch s := secretIntf.(type) {
+	case *secretsmanagerv2.ArbitrarySecretMetadata:
+		return newSmSecretReplicaSecret(s.SecretType, s.Crn, s.Name, s.Description, s.Labels, s.CustomMetadata), nil
+	case *secretsmanagerv2.KVSecretMetadata:
+		return newSmSecretReplicaSecret(s.SecretType, s.Crn, s.Name, s.Description, s.Labels, s.CustomMetadata), nil
+	case *secretsmanagerv2.UsernamePasswordSecretMetadata:
+		return newSmSecretReplicaSecret(s.SecretType, s.Crn, s.Name, s.Description, s.Labels, s.CustomMetadata), nil
+	case *secretsmanagerv2.ImportedCertificateMetadata:
+		return newSmSecretReplicaSecret(s.SecretType, s.Crn, s.Name, s.Description, s.Labels, s.CustomMetadata), nil
+	}
+	return nil, fmt.Errorf("The secret %s cannot be replicated. Supported secret types are %s, %s, %s and %s",
+		secretId, ArbitrarySecretType, KvSecretType, UsernamePasswordSecretType, ImportedCertSecretType)
+}
+
+func newSmSecretReplicaSecret(secretType, crn, name, description *string, labels []string, customMetadata map[string]interface{}) *smSecretReplicaSecret {
+	secret := &smSecretReplicaSecret{
+		labels:         labels,
+		customMetadata: customMetadata,
+	}
+	if secretType != nil {
+		secret.secretType = *secretType
+	}
+	if crn != nil {
+		secret.crn = *crn
+	}
+	if name != nil {
+		secret.name = *name
+	}
+	if description != nil {
+		secret.description = *description
+	}
+	return secret
+}
+
+// smSecretReplicaSecretGroupId returns the secret group of a replica
+func smSecretReplicaSecretGroupId(secretIntf secretsmanagerv2.SecretMetadataIntf) string {
+	var secretGroupId *string
+	switch s := secretIntf.(type) {
+	case *secretsmanagerv2.ArbitrarySecretMetadata:
+		secretGroupId = s.SecretGroupID
+	case *secretsmanagerv2.KVSecretMetadata:
+		secretGroupId = s.SecretGroupID
+	case *secretsmanagerv2.UsernamePasswordSecretMetadata:
+		secretGroupId = s.SecretGroupID
+	case *secretsmanagerv2.ImportedCertificateMetadata:
+		secretGroupId = s.SecretGroupID
+	}
+	if secretGroupId == nil {
+		return "default"
+	}
+	return *secretGroupId
+}
+
+// smSecretReplicaSourceVersionId returns the ID of the version of the source secret that a version of a
+// replica was copied from
+func smSecretReplicaSourceVersionId(version *smSecretReplicaVersion) string {
+	sourceVersionId, _ := version.versionCustomMetadata[smSecretReplicaSourceVersionKey].(string)
+	return sourceVersionId
+}
+
+// createSmSecretReplica creates a replica of the source secret in the secret group of the target instance
+func createSmSecretReplica(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, secretGroupId string, source *smSecretReplicaSecret, version *smSecretReplicaVersion) (string, *core.DetailedResponse, error) {
+	secretPrototype := &secretsmanagerv2.SecretPrototype{
+		SecretType:            core.StringPtr(source.secretType),
+		SecretGroupID:         core.StringPtr(secretGroupId),
+		Name:                  core.StringPtr(source.name),
+		Labels:                source.labels,
+		CustomMetadata:        source.customMetadata,
+		VersionCustomMetadata: smSecretReplicaVersionCustomMetadata(source, version),
+		Payload:               version.payload,
+		Data:                  version.data,
+		Username:              version.username,
+		Password:              version.password,
+		Certificate:           version.certificate,
+		Intermediate:          version.intermediate,
+		PrivateKey:            version.privateKey,
+	}
+	if source.description != "" {
+		secretPrototype.Description = core.StringPtr(source.description)
+	}
+
+	createSecretOptions := &secretsmanagerv2.CreateSecretOptions{}
+	createSecretOptions.SetSecretPrototype(secretPrototype)
+	replicaIntf, response, err := secretsManagerClient.CreateSecretWithContext(context, createSecretOptions)
+	if err != nil {
+		return "", response, err
+	}
+	var replicaId *string
+	switch r := replicaIntf.(type) {
+	case *secretsmanagerv2.ArbitrarySecret:
+		replicaId = r.ID
+	case *secretsmanagerv2.KVSecret:
+		replicaId = r.ID
+	case *secretsmanagerv2.UsernamePasswordSecret:
+		replicaId = r.ID
+	case *secretsmanagerv2.ImportedCertificate:
+		replicaId = r.ID
+	}
+	if replicaId == nil {
+		return "", response, fmt.Errorf("Unexpected response when creating the replica of secret %s", source.crn)
+	}
+	return *replicaId, response, nil
+}
+
+// syncSmSecretReplica copies the current version of the source secret to the replica, unless the replica
+// already has it, and updates the metadata of the replica
+func syncSmSecretReplica(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, replicaId string, source *smSecretReplicaSecret, version *smSecretReplicaVersion) (*core.DetailedResponse, error) {
+	replica, replicaVersion, response, err := getSmSecretReplica(context, secretsManagerClient, replicaId)
+	if err != nil {
+		return response, err
+	}
+
+	if smSecretReplicaSourceVersionId(replicaVersion) != version.id {
+		createSecretVersionOptions := &secretsmanagerv2.CreateSecretVersionOptions{}
+		createSecretVersionOptions.SetSecretID(replicaId)
+		createSecretVersionOptions.SetSecretVersionPrototype(smSecretReplicaVersionPrototype(source, version))
+		_, response, err = secretsManagerClient.CreateSecretVersionWithContext(context, createSecretVersionOptions)
+		if err != nil {
+			return response, err
+		}
+	}
+
+	if !smSecretReplicaMetadataEqual(source, replica) {
+		patchVals := &secretsmanagerv2.SecretMetadataPatch{
+			Name:           core.StringPtr(source.name),
+			Description:    core.StringPtr(source.description),
+			Labels:         source.labels,
+			CustomMetadata: source.customMetadata,
+		}
+		patch, err := patchVals.AsPatch()
+		if err != nil {
+			return response, err
+		}
+		// The empty values are omitted from the patch, they are sent explicitly to clear the replica
+		if len(source.labels) == 0 {
+			patch["labels"] = []string{}
+		}
+		if len(source.customMetadata) == 0 {
+			patch["custom_metadata"] = map[string]interface{}{}
+		}
+		updateSecretMetadataOptions := &secretsmanagerv2.UpdateSecretMetadataOptions{}
+		updateSecretMetadataOptions.SetID(replicaId)
+		updateSecretMetadataOptions.SetSecretMetadataPatch(patch)
+		_, response, err = secretsManagerClient.UpdateSecretMetadataWithContext(context, updateSecretMetadataOptions)
+		if err != nil {
+			return response, err
+		}
+	}
+	return response, nil
+}
+
+// deleteSmSecretReplica deletes a replica, a replica that does not exist anymore is not an error
+func deleteSmSecretReplica(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, replicaId string) (*core.DetailedResponse, error) {
+	deleteSecretOptions := &secretsmanagerv2.DeleteSecretOptions{}
+	deleteSecretOptions.SetID(replicaId)
+	response, err := secretsManagerClient.DeleteSecretWithContext(context, deleteSecretOptions)
+	if err != nil && response != nil && response.StatusCode == 404 {
+		return response, nil
+	}
+	return response, err
+}
+
+// smSecretReplicaVersionPrototype returns a new version of a replica with the secret data of the source version
+func smSecretReplicaVersionPrototype(source *smSecretReplicaSecret, version *smSecretReplicaVersion) secretsmanagerv2.SecretVersionPrototypeIntf {
+	versionCustomMetadata := smSecretReplicaVersionCustomMetadata(source, version)
+	switch source.secretType {
+	case KvSecretType:
+		return &secretsmanagerv2.KVSecretVersionPrototype{
+			Data:                  version.data,
+			VersionCustomMetadata: versionCustomMetadata,
+		}
+	case UsernamePasswordSecretType:
+		return &secretsmanagerv2.UsernamePasswordSecretVersionPrototype{
+			Password:              version.password,
+			VersionCustomMetadata: versionCustomMetadata,
+		}
+	case ImportedCertSecretType:
+		return &secretsmanagerv2.ImportedCertificateVersionPrototype{
+			Certificate:           version.certificate,
+			Intermediate:          version.intermediate,
+			PrivateKey:            version.privateKey,
+			VersionCustomMetadata: versionCustomMetadata,
+		}
+	}
+	return &secretsmanagerv2.ArbitrarySecretVersionPrototype{
+		Payload:               version.payload,
+		VersionCustomMetadata: versionCustomMetadata,
+	}
+}
+
+// smSecretReplicaVersionCustomMetadata returns the version custom metadata that records where the
+// version of a replica was copied from
+func smSecretReplicaVersionCustomMetadata(source *smSecretReplicaSecret, version *smSecretReplicaVersion) map[string]interface{} {
+	return map[string]interface{}{
+		smSecretReplicaSourceCrnKey:     source.crn,
+		smSecretReplicaSourceVersionKey: version.id,
+	}
+}
+
+// smSecretReplicaMetadataEqual reports whether the replica has the name, description, labels and custom
+// metadata of the source secret
+func smSecretReplicaMetadataEqual(source *smSecretReplicaSecret, replica *smSecretReplicaSecret) bool {
+	if source.name != replica.name || source.description != replica.description {
+		return false
+	}
+	if (len(source.labels) > 0 || len(replica.labels) > 0) && !reflect.DeepEqual(source.labels, replica.labels) {
+		return false
+	}
+	if (len(source.customMetadata) > 0 || len(replica.customMetadata) > 0) && !reflect.DeepEqual(source.customMetadata, replica.customMetadata) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+var secretReplicaSourceName = "terraform-test-replica-source"
+
+func TestAccIbmSmSecretReplicaBasic(t *testing.T) {
+	resourceName := "ibm_sm_secret_replica.sm_secret_replica"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmSecretReplicaDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: secretReplicaConfig(kvSecretData, label),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secret_type", "kv"),
+					resource.TestCheckResourceAttr(resourceName, "name", secretReplicaSourceName),
+					resource.TestCheckResourceAttr(resourceName, "in_sync", "true"),
+					resource.TestCheckResourceAttr(resourceName, "targets.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "targets.0.secret_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "targets.0.version_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "targets.0.source_version_id", resourceName, "source_version_id"),
+				),
+			},
+			resource.TestStep{
+				// The replica drifts from the new version of the source secret
+				Config:             secretReplicaConfig(modifiedKvSecretData, modifiedLabel),
+				ExpectNonEmptyPlan: true,
+			},
+			resource.TestStep{
+				// The new version of the source secret is copied to the replica on the next apply
+				Config: secretReplicaConfig(modifiedKvSecretData, modifiedLabel),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "in_sync", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "targets.0.source_version_id", resourceName, "source_version_id"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccIbmSmSecretReplicaImportStateId(resourceName),
+			},
+		},
+	})
+}
+
+func testAccIbmSmSecretReplicaImportStateId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s/%s:%s:%s", rs.Primary.ID, rs.Primary.Attributes["targets.0.region"],
+			rs.Primary.Attributes["targets.0.instance_id"], rs.Primary.Attributes["targets.0.secret_id"]), nil
+	}
+}
+
+func secretReplicaConfig(data string, label string) string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_kv_secret" "sm_kv_secret_source" {
+			instance_id   = "%s"
+			region        = "%s"
+			name          = "%s"
+			labels        = ["%s"]
+			data          = %s
+		}
+
+		resource "ibm_sm_secret_replica" "sm_secret_replica" {
+			instance_id = "%s"
+			region      = "%s"
+			secret_id   = ibm_sm_kv_secret.sm_kv_secret_source.secret_id
+			targets {
+				instance_id = "%s"
+				region      = "%s"
+			}
+			depends_on = [ibm_sm_kv_secret.sm_kv_secret_source]
+		}`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, secretReplicaSourceName, label, data,
+		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
+		acc.SecretsManagerReplicaInstanceID, acc.SecretsManagerReplicaInstanceRegion)
+}
+
+func testAccCheckIbmSmSecretReplicaDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_sm_secret_replica" {
+			continue
+		}
+		return fmt.Errorf("ibm_sm_secret_replica still exists: %s", rs.Primary.ID)
+	}
+	return nil
+}
@@ -121,11 +121,27 @@ func ResourceIbmSmUsernamePasswordSecret() *schema.Resource {
 				Description: "The username that is assigned to the secret.",
 			},
 			"password": &schema.Schema{
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Sensitive:   true,
-				Description: "The password that is assigned to the secret.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password_wo"},
+				Description:   "The password that is assigned to the secret.",
+			},
+			"password_wo": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"password"},
+				RequiredWith:  []string{"password_wo_version"},
+				Description:   "The password that is assigned to the secret, as a write-only value that is never stored in the Terraform plan or state. Requires Terraform 1.11 or later.",
+			},
+			"password_wo_version": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
+				Description:  "The version of the write-only password. Increment it to create a new secret version from the current `password_wo` value.",
 			},
 			"password_generation_policy": &schema.Schema{
 				Type:        schema.TypeList,
@@ -386,8 +402,11 @@ func resourceIbmSmUsernamePasswordSecretRead(context context.Context, d *schema.
 	if err = d.Set("username", secret.Username); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting username: %s", err))
 	}
-	if err = d.Set("password", secret.Password); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting password: %s", err))
+	// The password is not kept in the state when it is set through the write-only argument
+	if _, ok := d.GetOk("password_wo_version"); !ok {
+		if err = d.Set("password", secret.Password); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting password: %s", err))
+		}
 	}
 
 	passwordPolicyMap, err := passwordGenerationPolicyToMap(secret.PasswordGenerationPolicy)
@@ -505,9 +524,13 @@ func resourceIbmSmUsernamePasswordSecretUpdate(context context.Context, d *schem
 	}
 
 	// Apply change in payload (if changed)
-	if d.HasChange("password") {
+	if d.HasChange("password") || d.HasChange("password_wo_version") {
 		versionModel := &secretsmanagerv2.UsernamePasswordSecretVersionPrototype{}
-		versionModel.Password = core.StringPtr(d.Get("password").(string))
+		password, err := getUsernamePasswordSecretPassword(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		versionModel.Password = core.StringPtr(password)
 		if _, ok := d.GetOk("version_custom_metadata"); ok {
 			versionModel.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
 		}
@@ -618,8 +641,12 @@ func resourceIbmSmUsernamePasswordSecretMapToSecretPrototype(d *schema.ResourceD
 	if _, ok := d.GetOk("username"); ok {
 		model.Username = core.StringPtr(d.Get("username").(string))
 	}
-	if _, ok := d.GetOk("password"); ok {
-		model.Password = core.StringPtr(d.Get("password").(string))
+	password, err := getUsernamePasswordSecretPassword(d)
+	if err != nil {
+		return model, err
+	}
+	if password != "" {
+		model.Password = core.StringPtr(password)
 	}
 	if _, ok := d.GetOk("rotation"); ok {
 		RotationModel, err := resourceIbmSmUsernamePasswordSecretMapToRotationPolicy(d.Get("rotation").([]interface{})[0].(map[string]interface{}))
@@ -751,3 +778,12 @@ func mapToPasswordGenerationPolicyPatch(modelMap map[string]interface{}) (*secre
 	}
 	return model, nil
 }
+
+// getUsernamePasswordSecretPassword returns the password from either the password argument or
+// its write-only counterpart
+func getUsernamePasswordSecretPassword(d *schema.ResourceData) (string, error) {
+	if _, ok := d.GetOk("password_wo_version"); ok {
+		return getWriteOnlyString(d, "password_wo")
+	}
+	return d.Get("password").(string), nil
+}
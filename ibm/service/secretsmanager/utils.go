@@ -8,6 +8,7 @@ import (
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
@@ -26,6 +27,7 @@ const (
 	ImportedCertSecretType       = "imported_cert"
 	PublicCertSecretType         = "public_cert"
 	PrivateCertSecretType        = "private_cert"
+	CustomCredentialsSecretType  = "custom_credentials"
 )
 
 func getRegion(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) string {
@@ -33,27 +35,36 @@ func getRegion(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.Reso
 	if ok {
 		return d.Get("region").(string)
 	} else {
-		// extract region from base URL (provider config)
-		// base url is like that : "https://<private.>secrets-manager.<region>.<rest of domain>"
-		baseUrl := originalClient.Service.GetServiceURL()
-		u := strings.Replace(baseUrl, "private.", "", 1)
-		return strings.Split(u, ".")[1]
+		return getDefaultRegion(originalClient)
 	}
 }
 
+// extract region from base URL (provider config)
+func getDefaultRegion(originalClient *secretsmanagerv2.SecretsManagerV2) string {
+	// base url is like that : "https://<private.>secrets-manager.<region>.<rest of domain>"
+	baseUrl := originalClient.Service.GetServiceURL()
+	u := strings.Replace(baseUrl, "private.", "", 1)
+	return strings.Split(u, ".")[1]
+}
+
 // Clone the base secrets manager client and set the API endpoint per the instance
 func getEndpointType(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) string {
 	_, ok := d.GetOk("endpoint_type")
 	if ok {
 		return d.Get("endpoint_type").(string)
 	} else {
-		baseUrl := originalClient.Service.GetServiceURL()
+		return getDefaultEndpointType(originalClient)
+	}
+}
 
-		if strings.Contains(baseUrl, "private.") {
-			return "private"
-		} else {
-			return "public"
-		}
+// extract endpoint type from base URL (provider config)
+func getDefaultEndpointType(originalClient *secretsmanagerv2.SecretsManagerV2) string {
+	baseUrl := originalClient.Service.GetServiceURL()
+
+	if strings.Contains(baseUrl, "private.") {
+		return "private"
+	} else {
+		return "public"
 	}
 }
 
@@ -193,3 +204,100 @@ func secretVersionMetadataAsPatchFunction(secretVersionMetadataPatch *secretsman
 	}
 	return
 }
+
+// smRawRequest sends a request to the Secrets Manager API, for the custom credentials secrets and
+// configurations only. The typed client rejects the responses of the types that the SDK does not
+// model yet, the other secret types must use the typed client.
+func smRawRequest(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, method string, path string, pathParams map[string]string, headers map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	_, err := builder.ResolveRequestURL(secretsManagerClient.Service.Options.URL, path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	for headerName, headerValue := range headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+	if body != nil {
+		if method == core.PATCH {
+			builder.AddHeader("Content-Type", "application/merge-patch+json")
+		} else {
+			builder.AddHeader("Content-Type", "application/json")
+		}
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return secretsManagerClient.Service.Request(request, result)
+}
+
+// getWriteOnlyString returns the value of a write-only string attribute. Write-only values are
+// never persisted, so they are only available in the raw configuration of the current operation.
+func getWriteOnlyString(d *schema.ResourceData, key string) (string, error) {
+	value, diags := d.GetRawConfigAt(cty.GetAttrPath(key))
+	if diags.HasError() {
+		return "", fmt.Errorf("Error reading %s: %v", key, diags)
+	}
+	if value.IsNull() || !value.IsKnown() || !value.Type().Equals(cty.String) {
+		return "", nil
+	}
+	return value.AsString(), nil
+}
+
+// CreateSecretVersionWithValue creates a new version of an arbitrary or key-value secret, for the resources
+// of other services that hand off the credentials they create to Secrets Manager. The payload is used for
+// arbitrary secrets and the data for key-value secrets. It returns the ID of the new version.
+func CreateSecretVersionWithValue(context context.Context, meta interface{}, instanceId string, region string, endpointType string, secretId string, payload string, data map[string]interface{}) (string, error) {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return "", err
+	}
+	if region == "" {
+		region = getDefaultRegion(secretsManagerClient)
+	}
+	if endpointType == "" {
+		endpointType = getDefaultEndpointType(secretsManagerClient)
+	}
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, endpointType)
+
+	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
+	getSecretMetadataOptions.SetID(secretId)
+	secretIntf, response, err := secretsManagerClient.GetSecretMetadataWithContext(context, getSecretMetadataOptions)
+	if err != nil {
+		return "", fmt.Errorf("GetSecretMetadataWithContext failed %s\n%s", err, response)
+	}
+
+	createSecretVersionOptions := &secretsmanagerv2.CreateSecretVersionOptions{}
+	createSecretVersionOptions.SetSecretID(secretId)
+	switch secretIntf.(type) {
+	case *secretsmanagerv2.ArbitrarySecretMetadata:
+		createSecretVersionOptions.SetSecretVersionPrototype(&secretsmanagerv2.ArbitrarySecretVersionPrototype{
+			Payload: core.StringPtr(payload),
+		})
+	case *secretsmanagerv2.KVSecretMetadata:
+		createSecretVersionOptions.SetSecretVersionPrototype(&secretsmanagerv2.KVSecretVersionPrototype{
+			Data: data,
+		})
+	default:
+		return "", fmt.Errorf("The secret %s is not supported, only %s and %s secrets are supported", secretId, ArbitrarySecretType, KvSecretType)
+	}
+
+	versionIntf, response, err := secretsManagerClient.CreateSecretVersionWithContext(context, createSecretVersionOptions)
+	if err != nil {
+		return "", fmt.Errorf("CreateSecretVersionWithContext failed %s\n%s", err, response)
+	}
+	switch version := versionIntf.(type) {
+	case *secretsmanagerv2.ArbitrarySecretVersion:
+		return *version.ID, nil
+	case *secretsmanagerv2.KVSecretVersion:
+		return *version.ID, nil
+	}
+	return "", fmt.Errorf("Unexpected response when creating a version of secret %s", secretId)
+}